@@ -18,6 +18,7 @@ import (
 	"gitlab.com/gitlab-org/cli/cmd/gen-docs/urlwrapper"
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/commands"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
@@ -48,6 +49,12 @@ func main() {
 
 	glabCli := commands.NewCmdRoot(&factory{io: iostreams.New()})
 	glabCli.DisableAutoGenTag = true
+	// Extensions installed on this machine are not part of glab.
+	for _, cmd := range glabCli.Commands() {
+		if cmd.Annotations[extensionutils.Annotation] != "" {
+			glabCli.RemoveCommand(cmd)
+		}
+	}
 	if *manpage {
 		if err := genManPage(glabCli, *path); err != nil {
 			fatal(err)
@@ -51,6 +51,14 @@ func gitLabColorScheme(lightDarkFunc lipgloss.LightDarkFunc) fang.ColorScheme {
 }
 
 func main() {
+	// Select the profile before reading the configuration: the factory
+	// resolves the repository with the credentials of the profile.
+	if len(os.Args) > 1 {
+		if profile := cmdutils.ProfileFromArgs(os.Args[1:]); profile != "" {
+			_ = os.Setenv(config.ProfileEnv, profile)
+		}
+	}
+
 	// Initialize configuration
 	cfg, err := config.Init()
 	if err != nil {
@@ -273,6 +273,13 @@ func WithUserAgent(userAgent string) ClientOption {
 
 // NewClientFromConfig initializes the global api with the config data
 func NewClientFromConfig(repoHost string, cfg config.Config, isGraphQL bool, userAgent string) (*Client, error) {
+	if profile, _ := config.ActiveProfile(cfg); profile != "" {
+		var notFound *config.ProfileNotFoundError
+		if _, err := config.GetProfile(cfg, profile); errors.As(err, &notFound) {
+			return nil, err
+		}
+	}
+
 	apiHost, _ := cfg.Get(repoHost, "api_host")
 	if apiHost == "" {
 		apiHost = repoHost
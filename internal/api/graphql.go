@@ -0,0 +1,31 @@
+package api
+
+import (
+	"errors"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// GraphQL runs a GraphQL query and decodes its data into data. GraphQL errors
+// are returned as an error, even when the request itself succeeded.
+func GraphQL(client *gitlab.Client, query string, variables map[string]any, data any) error {
+	resp := struct {
+		Data   any `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}{Data: data}
+
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: query, Variables: variables}, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+	return nil
+}
@@ -94,6 +94,8 @@ func (f *DefaultFactory) RepoOverride(repo string) error {
 		return err // return the error if repo was overridden.
 	}
 	f.cachedBaseRepo = baseRepo
+	// The repository configuration of the working directory is for another repository.
+	config.SkipRepoConfig(f.config)
 	return nil
 }
 
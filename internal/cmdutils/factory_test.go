@@ -52,6 +52,23 @@ func TestFactory_ResolveToGitLabComByDefault(t *testing.T) {
 	assert.Equal(t, "gitlab.com", f.defaultHostname)
 }
 
+func TestFactory_RepoOverrideSkipsRepoConfig(t *testing.T) {
+	// GIVEN
+	cfg := config.NewFromString(heredoc.Doc(`
+		repo:
+		  mr_target_branch: develop
+	`))
+	f := NewFactory(nil, false, cfg, api.BuildInfo{})
+
+	// WHEN
+	require.NoError(t, f.RepoOverride("OWNER/REPO"))
+
+	// THEN
+	value, err := cfg.Get("", "mr_target_branch")
+	require.NoError(t, err)
+	assert.Empty(t, value)
+}
+
 func TestFactory_GitLabClientUsesCorrectHost(t *testing.T) {
 	// GIVEN
 	tests := []struct {
@@ -0,0 +1,31 @@
+package cmdutils
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// AddGlobalProfileFlag adds the --profile flag to a command and its children.
+// The flag must take effect before the configuration is read, so main reads
+// it with ProfileFromArgs instead of after parsing.
+func AddGlobalProfileFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("profile", "", "Use a configuration profile. Overrides GLAB_PROFILE and the profile set with 'glab profile use'.")
+}
+
+// ProfileFromArgs returns the value of the --profile flag in command-line
+// arguments, or an empty string if it is not set.
+func ProfileFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if value, ok := strings.CutPrefix(arg, "--profile="); ok {
+			return value
+		}
+		if arg == "--profile" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,26 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileFromArgs(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"mr", "list"}, want: ""},
+		{args: []string{"--profile", "bot", "mr", "list"}, want: "bot"},
+		{args: []string{"mr", "list", "--profile=work"}, want: "work"},
+		{args: []string{"mr", "list", "--profile"}, want: ""},
+		{args: []string{"api", "projects", "--", "--profile", "bot"}, want: ""},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, ProfileFromArgs(tc.args), tc.args)
+	}
+}
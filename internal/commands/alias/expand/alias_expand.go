@@ -31,6 +31,9 @@ func ExpandAlias(cfg config.Config, args []string, findShFunc func() (string, er
 	}
 
 	expansion, ok := aliases.Get(args[1])
+	if !ok {
+		expansion, ok = config.RepoAlias(cfg, args[1])
+	}
 	if !ok {
 		return expanded, false, nil
 	}
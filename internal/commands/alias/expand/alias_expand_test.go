@@ -22,6 +22,10 @@ func TestExpandAlias(t *testing.T) {
 		  co: mr checkout
 		  il: issue list --author="$1" --label="$2"
 		  ia: issue list --author="$1" --assignee="$1"
+		repo:
+		  aliases:
+		    co: mr checkout --detach
+		    review: mr list --reviewer=@me
 	`))
 
 	type args struct {
@@ -125,6 +129,26 @@ func TestExpandAlias(t *testing.T) {
 			wantIsShell:  false,
 			wantErr:      nil,
 		},
+		{
+			name: "repository alias",
+			args: args{
+				config: cfg,
+				argv:   []string{"glab", "review", "--draft"},
+			},
+			wantExpanded: []string{"mr", "list", "--reviewer=@me", "--draft"},
+			wantIsShell:  false,
+			wantErr:      nil,
+		},
+		{
+			name: "user alias overrides repository alias",
+			args: args{
+				config: cfg,
+				argv:   []string{"glab", "co", "123"},
+			},
+			wantExpanded: []string{"mr", "checkout", "123"},
+			wantIsShell:  false,
+			wantErr:      nil,
+		},
 		{
 			name: "dollar in expansion",
 			args: args{
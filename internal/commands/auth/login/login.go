@@ -43,6 +43,7 @@ type LoginOptions struct {
 	GitProtocol string
 
 	UseKeyring bool
+	Device     bool
 }
 
 var opts *LoginOptions
@@ -70,6 +71,11 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 			When running in interactive mode inside a Git repository, %[1]sglab%[1]s will automatically detect
 			GitLab instances from your Git remotes and present them as options, saving you from having to
 			manually type the hostname.
+
+			The web sign-in opens a browser that must reach a callback server on %[1]slocalhost%[1]s.
+			Where that is not possible, like in SSH sessions and containers, use %[1]s--device%[1]s: %[1]sglab%[1]s
+			prints a code to enter on any device with a browser, and waits for you to authorize it.
+			Tokens from both flows are stored and refreshed the same way.
 		`, "`"),
 		Example: heredoc.Docf(`
 			# Start interactive setup
@@ -90,13 +96,20 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 
 			# Non-interactive CI/CD setup
 			$ glab auth login --hostname $CI_SERVER_HOST --job-token $CI_JOB_TOKEN
+
+			# Sign in over SSH, or in a container, with a code entered in a browser on another device
+			$ glab auth login --device
 		`, "`"),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !opts.IO.PromptEnabled() && !tokenStdin && opts.Token == "" && opts.JobToken == "" {
-				return &cmdutils.FlagError{Err: errors.New("'--stdin', '--token', or '--job-token' required when not running interactively.")}
+			if !opts.IO.PromptEnabled() && !tokenStdin && opts.Token == "" && opts.JobToken == "" && !opts.Device {
+				return &cmdutils.FlagError{Err: errors.New("'--stdin', '--token', '--job-token', or '--device' required when not running interactively.")}
+			}
+
+			if opts.Device && (opts.Token != "" || opts.JobToken != "" || tokenStdin) {
+				return &cmdutils.FlagError{Err: errors.New("'--device' signs in without a token. You cannot use it with '--token', '--stdin', or '--job-token'.")}
 			}
 
 			if opts.JobToken != "" && (opts.Token != "" || tokenStdin) {
@@ -147,6 +160,7 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.JobToken, "job-token", "j", "", "CI job token.")
 	cmd.Flags().BoolVar(&tokenStdin, "stdin", false, "Read token from standard input.")
 	cmd.Flags().BoolVar(&opts.UseKeyring, "use-keyring", false, "Store token in your operating system's keyring.")
+	cmd.Flags().BoolVar(&opts.Device, "device", false, "Sign in with a code entered in a browser on any device, instead of a browser on this machine.")
 	cmd.Flags().StringVarP(&opts.ApiHost, "api-host", "a", "", "API host url.")
 	cmd.Flags().StringVarP(&opts.ApiProtocol, "api-protocol", "p", "", "API protocol: https, http")
 	cmd.Flags().StringVarP(&opts.GitProtocol, "git-protocol", "g", "", "Git protocol: ssh, https, http")
@@ -410,11 +424,17 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 		containerRegistryDomains string
 	)
 
+	if opts.Device {
+		loginType = promptLoginTypeDevice
+	}
+
 	if opts.Interactive {
-		loginTypeOptions := []string{promptLoginTypeToken, promptLoginTypeWeb}
-		err := opts.IO.Select(ctx, &loginType, "How would you like to sign in?", loginTypeOptions)
-		if err != nil {
-			return fmt.Errorf("could not get sign-in type: %w", err)
+		if loginType == "" {
+			loginTypeOptions := []string{promptLoginTypeToken, promptLoginTypeWeb, promptLoginTypeDevice}
+			err := opts.IO.Select(ctx, &loginType, "How would you like to sign in?", loginTypeOptions)
+			if err != nil {
+				return fmt.Errorf("could not get sign-in type: %w", err)
+			}
 		}
 
 		containerRegistryDomains = defaultContainerRegistryDomainsString(hostname)
@@ -422,7 +442,7 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			Title("What domains does this host use for the container registry and image dependency proxy?").
 			Value(&containerRegistryDomains).
 			Placeholder(defaultContainerRegistryDomainsString(hostname))
-		err = opts.IO.Run(ctx, containerRegistryInput)
+		err := opts.IO.Run(ctx, containerRegistryInput)
 		if err != nil {
 			return fmt.Errorf("could not get container registry domains: %w", err)
 		}
@@ -441,7 +461,11 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			return err
 		}
 
-		token, err = oauth2.StartFlow(ctx, cfg, opts.IO.StdErr, client.HTTPClient(), hostname)
+		if loginType == promptLoginTypeDevice {
+			token, err = oauth2.StartDeviceFlow(ctx, cfg, opts.IO.StdErr, client.HTTPClient(), hostname)
+		} else {
+			token, err = oauth2.StartFlow(ctx, cfg, opts.IO.StdErr, client.HTTPClient(), hostname)
+		}
 		if err != nil {
 			return err
 		}
@@ -523,6 +547,20 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 		}
 
 		fmt.Fprintf(opts.IO.StdErr, "%s Configured API protocol.\n", c.GreenCheck())
+	} else {
+		if opts.GitProtocol != "" {
+			err = cfg.Set(hostname, "git_protocol", opts.GitProtocol)
+			if err != nil {
+				return err
+			}
+		}
+
+		if opts.ApiProtocol != "" {
+			err = cfg.Set(hostname, "api_protocol", opts.ApiProtocol)
+			if err != nil {
+				return err
+			}
+		}
 	}
 	apiClient, err := opts.apiClient(hostname)
 	if err != nil {
@@ -4,12 +4,18 @@ package login
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zalando/go-keyring"
 
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
@@ -162,6 +168,19 @@ func Test_NewCmdLogin(t *testing.T) {
 			wantsErr: true,
 			err:      "specify one of '--token' or '--stdin'. You cannot use both flags at the same time",
 		},
+		{
+			name:     "device and token",
+			cli:      "--device --token xxxx",
+			wantsErr: true,
+			err:      "'--device' signs in without a token. You cannot use it with '--token', '--stdin', or '--job-token'.",
+		},
+		{
+			name:     "device and stdin",
+			cli:      "--device --stdin",
+			stdin:    "abc123",
+			wantsErr: true,
+			err:      "'--device' signs in without a token. You cannot use it with '--token', '--stdin', or '--job-token'.",
+		},
 		{
 			name: "no keyring, token",
 			cli:  "--token glpat-123",
@@ -357,3 +376,101 @@ func Test_keyringLogin(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "glpat-1234", token)
 }
+
+func TestLoginDevice_nonTTY(t *testing.T) {
+	tests := []struct {
+		name string
+		// tokenStatus and tokenResponse are the response to the polls after
+		// the first one, which is always pending.
+		tokenStatus   int
+		tokenResponse string
+		wantErr       string
+	}{
+		{
+			name:          "authorized",
+			tokenStatus:   http.StatusOK,
+			tokenResponse: `{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "Bearer", "expires_in": 7200}`,
+		},
+		{
+			name:          "denied",
+			tokenStatus:   http.StatusBadRequest,
+			tokenResponse: `{"error": "access_denied"}`,
+			wantErr:       "the authorization was denied. Sign in again to get a new code.",
+		},
+		{
+			name:          "expired",
+			tokenStatus:   http.StatusBadRequest,
+			tokenResponse: `{"error": "expired_token"}`,
+			wantErr:       "the code expired before it was entered. Sign in again to get a new code.",
+		},
+		{
+			name:          "server error",
+			tokenStatus:   http.StatusInternalServerError,
+			tokenResponse: `{"error": "server_error"}`,
+			wantErr:       "device authorization failed",
+		},
+	}
+
+	keyring.MockInit()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+			var polls int
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/oauth/authorize_device":
+					fmt.Fprint(w, `{
+						"device_code": "device-code",
+						"user_code": "ABCD-1234",
+						"verification_uri": "https://gitlab.example.com/oauth/device",
+						"expires_in": 300,
+						"interval": 1
+					}`)
+				case "/oauth/token":
+					polls++
+					if polls == 1 {
+						w.WriteHeader(http.StatusBadRequest)
+						fmt.Fprint(w, `{"error": "authorization_pending"}`)
+						return
+					}
+					w.WriteHeader(tc.tokenStatus)
+					fmt.Fprint(w, tc.tokenResponse)
+				case "/api/v4/user":
+					fmt.Fprint(w, `{"id": 1, "username": "alice"}`)
+				default:
+					t.Errorf("unexpected request: %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			hostname := strings.TrimPrefix(server.URL, "https://")
+			cfg := config.NewBlankConfig()
+			require.NoError(t, cfg.Set(hostname, "client_id", "321"))
+
+			ios, _, stdout, stderr := cmdtest.TestIOStreams()
+			f := cmdtest.NewTestFactory(ios,
+				cmdtest.WithConfig(cfg),
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, server.Client(), "", hostname)),
+			)
+
+			_, err := cmdtest.ExecuteCommand(NewCmdLogin(f), "--device --hostname "+hostname, stdout, stderr)
+
+			assert.Contains(t, stderr.String(), "First, copy your one-time code: ABCD-1234\n")
+			assert.Contains(t, stderr.String(), "Then open https://gitlab.example.com/oauth/device in a browser on any device, and enter the code.\n")
+			assert.Equal(t, 2, polls)
+
+			token, _ := cfg.Get(hostname, "token")
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.Empty(t, token)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "access-token", token)
+			assert.Contains(t, stderr.String(), "Logged in as alice")
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	pipeListCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/list"
 	pipeRetryCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/retry"
 	pipeRunCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/run"
+	pipeRunLocalCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/run_local"
 	pipeRunTrigCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/run_trig"
 	pipeStatusCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/status"
 	ciTraceCmd "gitlab.com/gitlab-org/cli/internal/commands/ci/trace"
@@ -53,6 +54,7 @@ func NewCmdCI(f cmdutils.Factory) *cobra.Command {
 	ciCmd.AddCommand(pipeRunCmd.NewCmdRun(f))
 	ciCmd.AddCommand(jobPlayCmd.NewCmdTrigger(f))
 	ciCmd.AddCommand(pipeRunTrigCmd.NewCmdRunTrig(f))
+	ciCmd.AddCommand(pipeRunLocalCmd.NewCmdRunLocal(f))
 	ciCmd.AddCommand(jobArtifactCmd.NewCmdRun(f))
 	ciCmd.AddCommand(pipeGetCmd.NewCmdGet(f))
 	ciCmd.AddCommand(ciConfigCmd.NewCmdConfig(f))
@@ -15,6 +15,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cronexpr"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/tableprinter"
@@ -134,9 +135,9 @@ func getMRForBranch(client *gitlab.Client, repoName, branch string, ios *iostrea
 func DisplaySchedules(i *iostreams.IOStreams, s []*gitlab.PipelineSchedule, projectID string) string {
 	if len(s) > 0 {
 		table := tableprinter.NewTablePrinter()
-		table.AddRow("ID", "Description", "Cron", "Owner", "Active")
+		table.AddRow("ID", "Description", "Cron", "Owner", "Active", "Next run")
 		for _, schedule := range s {
-			table.AddRow(schedule.ID, schedule.Description, schedule.Cron, schedule.Owner.Username, schedule.Active)
+			table.AddRow(schedule.ID, schedule.Description, schedule.Cron, schedule.Owner.Username, schedule.Active, nextScheduleRun(schedule))
 		}
 
 		return table.Render()
@@ -146,6 +147,33 @@ func DisplaySchedules(i *iostreams.IOStreams, s []*gitlab.PipelineSchedule, proj
 	return ""
 }
 
+// nextScheduleRun returns when an active schedule runs next, in its timezone.
+// It falls back to computing the next run from the cron pattern when the API doesn't return it.
+func nextScheduleRun(schedule *gitlab.PipelineSchedule) string {
+	if !schedule.Active {
+		return "-"
+	}
+
+	loc, err := cronexpr.LoadLocation(schedule.CronTimezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	if schedule.NextRunAt != nil {
+		return schedule.NextRunAt.In(loc).Format(cronexpr.TimeLayout)
+	}
+
+	expr, err := cronexpr.Parse(schedule.Cron)
+	if err != nil {
+		return "-"
+	}
+	next := expr.Next(time.Now().In(loc))
+	if next.IsZero() {
+		return "-"
+	}
+	return next.Format(cronexpr.TimeLayout)
+}
+
 func DisplayMultiplePipelines(s *iostreams.IOStreams, p []*gitlab.PipelineInfo, projectID string) string {
 	c := s.Color()
 
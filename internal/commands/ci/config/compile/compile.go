@@ -1,6 +1,7 @@
 package compile
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -70,25 +71,35 @@ func compileRun(f cmdutils.Factory, path string) error {
 		return fmt.Errorf("reading CI/CD configuration at %s: %w", path, err)
 	}
 
+	mergedYaml, err := MergedYaml(client, project.ID, string(content))
+	if err != nil {
+		return fmt.Errorf("could not compile %s: %w", path, err)
+	}
+
+	fmt.Print(mergedYaml)
+
+	return nil
+}
+
+// MergedYaml returns the CI/CD configuration in content with all includes and
+// extends expanded, as computed by the project's CI Lint API.
+func MergedYaml(client *gitlab.Client, projectID int64, content string) (string, error) {
 	compiledResult, _, err := client.Validate.ProjectNamespaceLint(
-		project.ID,
+		projectID,
 		&gitlab.ProjectNamespaceLintOptions{
-			Content:     gitlab.Ptr(string(content)),
+			Content:     gitlab.Ptr(content),
 			DryRun:      gitlab.Ptr(false),
 			Ref:         gitlab.Ptr(""),
 			IncludeJobs: gitlab.Ptr(false),
 		},
 	)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if !compiledResult.Valid {
-		errorsStr := strings.Join(compiledResult.Errors, ", ")
-		return fmt.Errorf("could not compile %s: %s", path, errorsStr)
+		return "", errors.New(strings.Join(compiledResult.Errors, ", "))
 	}
 
-	fmt.Print(compiledResult.MergedYaml)
-
-	return nil
+	return compiledResult.MergedYaml, nil
 }
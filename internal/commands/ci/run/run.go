@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -14,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/ci/ciutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 	"gitlab.com/gitlab-org/cli/internal/utils"
@@ -192,6 +194,31 @@ func resolvePipelineVars(cmd *cobra.Command) ([]*gitlab.PipelineVariableOptions,
 	return pipelineVars, nil
 }
 
+// configPipelineVars returns the variables of the ci_variables configuration,
+// except the ones that the flags set.
+func configPipelineVars(cfg config.Config, flagVars []*gitlab.PipelineVariableOptions) ([]*gitlab.PipelineVariableOptions, error) {
+	value, _ := cfg.Get("", "ci_variables")
+	values, err := config.SplitList(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ci_variables configuration %q: %w", value, err)
+	}
+
+	var pipelineVars []*gitlab.PipelineVariableOptions
+	for _, v := range values {
+		pvar, err := extractEnvVar(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ci_variables configuration %q. Expected format KEY:VALUE.", v)
+		}
+		overridden := slices.ContainsFunc(flagVars, func(flagVar *gitlab.PipelineVariableOptions) bool {
+			return *flagVar.Key == *pvar.Key
+		})
+		if !overridden {
+			pipelineVars = append(pipelineVars, pvar)
+		}
+	}
+	return pipelineVars, nil
+}
+
 func NewCmdRun(f cmdutils.Factory) *cobra.Command {
 	openInBrowser := false
 	mr := false
@@ -250,6 +277,14 @@ If used with merge request pipelines, the command fails with a message like ` +
 				return err
 			}
 
+			if !mr {
+				configVars, err := configPipelineVars(f.Config(), pipelineVars)
+				if err != nil {
+					return err
+				}
+				pipelineVars = append(configVars, pipelineVars...)
+			}
+
 			pipelineInputs, err := cmdutils.PipelineInputsFromFlags(cmd)
 			if err != nil {
 				return err
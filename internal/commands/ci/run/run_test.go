@@ -16,6 +16,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/run"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
@@ -29,8 +30,9 @@ type responseJSON struct {
 
 func TestCIRun(t *testing.T) {
 	tests := []struct {
-		name string
-		cli  string
+		name   string
+		cli    string
+		config string
 
 		expectedPOSTBody string
 		expectedOut      string
@@ -84,6 +86,13 @@ func TestCIRun(t *testing.T) {
 			expectedPOSTBody: `"ref":"main","variables":[{"key":"FOO","value":"bar","variable_type":"env_var"},{"key":"BAR","value":"xxx","variable_type":"env_var"}]`,
 			expectedOut:      "Created pipeline (id: 123), status: created, ref: main, weburl: https://gitlab.com/OWNER/REPO/-/pipelines/123\n",
 		},
+		{
+			name:             "when running `ci run` with variables from the configuration",
+			cli:              "-b main --variables FOO:bar",
+			config:           "repo:\n  ci_variables: DEPLOY_ENV:staging,FOO:default\n",
+			expectedPOSTBody: `"ref":"main","variables":[{"key":"DEPLOY_ENV","value":"staging","variable_type":"env_var"},{"key":"FOO","value":"bar","variable_type":"env_var"}]`,
+			expectedOut:      "Created pipeline (id: 123), status: created, ref: main, weburl: https://gitlab.com/OWNER/REPO/-/pipelines/123\n",
+		},
 		{
 			name:             "when running `ci run` with untyped input",
 			cli:              "-b main -i key1:val1 --input key2:val2",
@@ -125,6 +134,7 @@ func TestCIRun(t *testing.T) {
 			execFunc := cmdtest.SetupCmdForTest(t, NewCmdRun, true,
 				cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", glinstance.DefaultHostname).Lab()),
 				cmdtest.WithBranch("custom-branch-123"),
+				cmdtest.WithConfig(config.NewFromString(tc.config)),
 			)
 			restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
 				return &test.OutputStub{}
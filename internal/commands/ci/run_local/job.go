@@ -0,0 +1,202 @@
+package run_local
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// reservedKeywords are top-level keys of a CI/CD configuration that
+// do not define a job.
+var reservedKeywords = []string{
+	"default",
+	"include",
+	"stages",
+	"variables",
+	"workflow",
+	"image",
+	"services",
+	"cache",
+	"before_script",
+	"after_script",
+	"spec",
+}
+
+// ciJob is the subset of a job definition that is required to run it locally.
+type ciJob struct {
+	Name         string
+	Stage        string
+	Image        string
+	Entrypoint   []string
+	Environment  string
+	Variables    map[string]string
+	BeforeScript []string
+	Script       []string
+	AfterScript  []string
+}
+
+// parseJob looks up the job called name in the merged CI/CD configuration
+// and resolves the image, scripts and variables it inherits from the
+// global defaults.
+func parseJob(mergedYaml, name string) (*ciJob, error) {
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(mergedYaml), &config); err != nil {
+		return nil, fmt.Errorf("parsing CI/CD configuration: %w", err)
+	}
+
+	if strings.HasPrefix(name, ".") || slices.Contains(reservedKeywords, name) {
+		return nil, fmt.Errorf("%q is not a job", name)
+	}
+
+	raw, ok := config[name].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("job %q not found in the CI/CD configuration", name)
+	}
+
+	defaults, _ := config["default"].(map[string]any)
+	lookup := func(key string) (any, bool) {
+		if v, ok := raw[key]; ok {
+			return v, true
+		}
+		if v, ok := defaults[key]; ok {
+			return v, true
+		}
+		v, ok := config[key]
+		return v, ok
+	}
+
+	job := &ciJob{
+		Name:      name,
+		Stage:     "test",
+		Variables: map[string]string{},
+	}
+
+	if stage, ok := raw["stage"].(string); ok {
+		job.Stage = stage
+	}
+
+	if image, ok := lookup("image"); ok {
+		switch v := image.(type) {
+		case string:
+			job.Image = v
+		case map[string]any:
+			job.Image, _ = v["name"].(string)
+			job.Entrypoint = toStrings(v["entrypoint"])
+		}
+	}
+
+	switch env := raw["environment"].(type) {
+	case string:
+		job.Environment = env
+	case map[string]any:
+		job.Environment, _ = env["name"].(string)
+	}
+
+	// Job variables take precedence over global variables with the same name,
+	// unless the job disables inheritance of global variables.
+	if inheritsGlobalVariables(raw) {
+		globals, _ := config["variables"].(map[string]any)
+		for k, v := range globals {
+			job.Variables[k] = variableValue(v)
+		}
+	}
+	if vars, ok := raw["variables"].(map[string]any); ok {
+		for k, v := range vars {
+			job.Variables[k] = variableValue(v)
+		}
+	}
+
+	if v, ok := lookup("before_script"); ok {
+		job.BeforeScript = toStrings(v)
+	}
+	job.Script = toStrings(raw["script"])
+	if v, ok := lookup("after_script"); ok {
+		job.AfterScript = toStrings(v)
+	}
+
+	if len(job.Script) == 0 {
+		return nil, fmt.Errorf("job %q has no script to run", name)
+	}
+
+	return job, nil
+}
+
+func inheritsGlobalVariables(raw map[string]any) bool {
+	inherit, ok := raw["inherit"].(map[string]any)
+	if !ok {
+		return true
+	}
+	if v, ok := inherit["variables"].(bool); ok {
+		return v
+	}
+	return true
+}
+
+// variableValue returns the value of a variable defined either as a plain
+// value or with the expanded `value`/`description` syntax.
+func variableValue(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		v = m["value"]
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// toStrings flattens script entries, which can be a single string or
+// nested lists of strings after `!reference` tags have been resolved.
+func toStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var res []string
+		for _, item := range v {
+			res = append(res, toStrings(item)...)
+		}
+		return res
+	}
+	return nil
+}
+
+// shellScript builds a POSIX shell script that runs the job the same way a
+// runner does: each command is echoed before it runs, `before_script` and
+// `script` stop at the first failure, and `after_script` always runs.
+func (j *ciJob) shellScript(colorEnabled bool) string {
+	var sb strings.Builder
+
+	sb.WriteString("(\nset -e\n")
+	for _, line := range append(slices.Clone(j.BeforeScript), j.Script...) {
+		writeCommand(&sb, line, colorEnabled)
+	}
+	sb.WriteString(")\n")
+	sb.WriteString("glab_job_status=$?\n")
+
+	if len(j.AfterScript) > 0 {
+		sb.WriteString("(\n")
+		for _, line := range j.AfterScript {
+			writeCommand(&sb, line, colorEnabled)
+		}
+		sb.WriteString(")\n")
+	}
+
+	sb.WriteString("exit $glab_job_status\n")
+	return sb.String()
+}
+
+func writeCommand(sb *strings.Builder, line string, colorEnabled bool) {
+	echo := "$ " + line
+	if colorEnabled {
+		echo = "\x1b[32;1m" + echo + "\x1b[0;m"
+	}
+	fmt.Fprintf(sb, "printf '%%s\\n' %s\n", shellQuote(echo))
+	sb.WriteString(line)
+	sb.WriteString("\n")
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,146 @@
+//go:build !integration
+
+package run_local
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mergedYaml = `
+default:
+  image: golang:1.25
+  before_script:
+    - echo default before
+variables:
+  GLOBAL: global
+  OVERRIDDEN: global
+stages:
+  - build
+  - test
+.template:
+  script: echo template
+build:
+  stage: build
+  image:
+    name: alpine:3
+    entrypoint: [""]
+  variables:
+    OVERRIDDEN: job
+    EXPANDED:
+      value: expanded
+      description: An expanded variable.
+  script:
+    - echo build
+    - - echo nested
+  after_script: echo cleanup
+  environment:
+    name: production
+test:
+  inherit:
+    variables: false
+  script: go test ./...
+no_script:
+  stage: test
+`
+
+func Test_parseJob(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     string
+		want    *ciJob
+		wantErr string
+	}{
+		{
+			name: "job with own image, variables and scripts",
+			job:  "build",
+			want: &ciJob{
+				Name:        "build",
+				Stage:       "build",
+				Image:       "alpine:3",
+				Entrypoint:  []string{""},
+				Environment: "production",
+				Variables: map[string]string{
+					"GLOBAL":     "global",
+					"OVERRIDDEN": "job",
+					"EXPANDED":   "expanded",
+				},
+				BeforeScript: []string{"echo default before"},
+				Script:       []string{"echo build", "echo nested"},
+				AfterScript:  []string{"echo cleanup"},
+			},
+		},
+		{
+			name: "job inheriting defaults",
+			job:  "test",
+			want: &ciJob{
+				Name:         "test",
+				Stage:        "test",
+				Image:        "golang:1.25",
+				Variables:    map[string]string{},
+				BeforeScript: []string{"echo default before"},
+				Script:       []string{"go test ./..."},
+			},
+		},
+		{
+			name:    "hidden job",
+			job:     ".template",
+			wantErr: `".template" is not a job`,
+		},
+		{
+			name:    "reserved keyword",
+			job:     "variables",
+			wantErr: `"variables" is not a job`,
+		},
+		{
+			name:    "unknown job",
+			job:     "deploy",
+			wantErr: `job "deploy" not found in the CI/CD configuration`,
+		},
+		{
+			name:    "job without script",
+			job:     "no_script",
+			wantErr: `job "no_script" has no script to run`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseJob(mergedYaml, tt.job)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_shellScript(t *testing.T) {
+	job := &ciJob{
+		BeforeScript: []string{"echo before"},
+		Script:       []string{"echo 'it works'"},
+		AfterScript:  []string{"echo after"},
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+		(
+		set -e
+		printf '%s\n' '$ echo before'
+		echo before
+		printf '%s\n' '$ echo '\''it works'\'''
+		echo 'it works'
+		)
+		glab_job_status=$?
+		(
+		printf '%s\n' '$ echo after'
+		echo after
+		)
+		exit $glab_job_status
+	`), job.shellScript(false))
+}
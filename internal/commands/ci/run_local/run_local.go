@@ -0,0 +1,363 @@
+package run_local
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/ci/config/compile"
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/export"
+	"gitlab.com/gitlab-org/cli/internal/execext"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+const buildsDir = "/builds"
+
+var supportedRuntimes = []string{"docker", "podman"}
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	branch       func() (string, error)
+
+	jobName            string
+	path               string
+	runtime            string
+	image              string
+	variables          []string
+	noProjectVariables bool
+	pull               bool
+}
+
+func NewCmdRunLocal(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		branch:       f.Branch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "run-local <job> [flags]",
+		Short: "Run a CI/CD job locally in a container.",
+		Long: heredoc.Doc(`
+			Run a job from the CI/CD configuration in a local Docker or Podman container.
+
+			The job definition is taken from the fully expanded configuration, as returned
+			by 'glab ci config compile'. The job's script runs in the job's image, with the
+			root of the repository mounted as the project directory.
+
+			Predefined CI/CD variables and the project's CI/CD variables, as returned by
+			'glab variable export', are available to the job. Variables scoped to an
+			environment are only available to jobs of this environment: jobs without
+			'environment' only get the variables of all environments. Services, caches,
+			artifacts, and rules are not supported.
+		`),
+		Args: cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+			# Run the 'test' job from .gitlab-ci.yml in the current directory
+			$ glab ci run-local test
+
+			# Run a job with Podman, from a different configuration file
+			$ glab ci run-local lint --runtime podman --file path/to/.gitlab-ci.yml
+
+			# Override a variable and do not load the project's CI/CD variables
+			$ glab ci run-local build --variable GOFLAGS=-mod=mod --no-project-variables
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(args); err != nil {
+				return err
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.path, "file", "f", ".gitlab-ci.yml", "Path to the CI/CD configuration file.")
+	fl.StringVar(&opts.runtime, "runtime", "", "Container runtime to use: docker, podman. (default: first one found in PATH)")
+	fl.StringVar(&opts.image, "image", "", "Override the image of the job.")
+	fl.StringSliceVar(&opts.variables, "variable", []string{}, "Set a variable for the job, in the format KEY=VALUE. Can be repeated.")
+	fl.BoolVar(&opts.noProjectVariables, "no-project-variables", false, "Do not load the project's CI/CD variables.")
+	fl.BoolVar(&opts.pull, "pull", false, "Always pull the image before running the job.")
+
+	return cmd
+}
+
+func (o *options) complete(args []string) error {
+	o.jobName = args[0]
+
+	if o.runtime == "" {
+		for _, runtime := range supportedRuntimes {
+			if _, err := execext.LookPath(runtime); err == nil {
+				o.runtime = runtime
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.runtime == "" {
+		return errors.New("no container runtime found. Install Docker or Podman, or set one with --runtime.")
+	}
+
+	for _, v := range o.variables {
+		if !strings.Contains(v, "=") {
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid variable %q. Expected format KEY=VALUE.", v)}
+		}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return fmt.Errorf("You must be in a GitLab project repository for this action: %w", err)
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return fmt.Errorf("You must be in a GitLab project repository for this action: %w", err)
+	}
+
+	content, err := os.ReadFile(o.path)
+	if err != nil {
+		return fmt.Errorf("reading CI/CD configuration at %s: %w", o.path, err)
+	}
+
+	mergedYaml, err := compile.MergedYaml(client, project.ID, string(content))
+	if err != nil {
+		return fmt.Errorf("could not compile %s: %w", o.path, err)
+	}
+
+	job, err := parseJob(mergedYaml, o.jobName)
+	if err != nil {
+		return err
+	}
+	if o.image != "" {
+		job.Image = o.image
+		job.Entrypoint = nil
+	}
+	if job.Image == "" {
+		return fmt.Errorf("job %q has no image. Set one with --image.", job.Name)
+	}
+
+	repoDir, err := git.ToplevelDir()
+	if err != nil {
+		return fmt.Errorf("could not find the root of the repository: %w", err)
+	}
+
+	projectDir := path.Join(buildsDir, project.PathWithNamespace)
+	env := predefinedVariables(repo, project, job, projectDir, o.currentBranch(), headSHA())
+
+	for k, v := range job.Variables {
+		env[k] = v
+	}
+
+	tmpDir, err := os.MkdirTemp("", "glab-ci-run-local-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	tmpMount := projectDir + ".tmp"
+
+	if !o.noProjectVariables {
+		variables, err := environmentVariables(client, project.ID, job.Environment)
+		if err != nil {
+			return fmt.Errorf("could not get the project's CI/CD variables: %w", err)
+		}
+
+		// Variables with a specific environment scope take precedence
+		// over variables with a wildcard scope.
+		sort.SliceStable(variables, func(i, j int) bool {
+			return strings.Contains(variables[i].EnvironmentScope, "*") && !strings.Contains(variables[j].EnvironmentScope, "*")
+		})
+		for _, v := range variables {
+			if v.VariableType == gitlab.FileVariableType {
+				if err := os.WriteFile(filepath.Join(tmpDir, v.Key), []byte(v.Value), 0o600); err != nil {
+					return err
+				}
+				env[v.Key] = path.Join(tmpMount, v.Key)
+				continue
+			}
+			env[v.Key] = v.Value
+		}
+	}
+
+	for _, v := range o.variables {
+		key, value, _ := strings.Cut(v, "=")
+		env[key] = value
+	}
+
+	args := o.containerArgs(job, env, repoDir, projectDir, tmpDir, tmpMount)
+
+	cmd := exec.Command(o.runtime, args...)
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Stdin = o.io.In
+	cmd.Stdout = o.io.StdOut
+	cmd.Stderr = o.io.StdErr
+
+	fmt.Fprintf(o.io.StdErr, "Running job %s in %s with %s...\n", job.Name, job.Image, o.runtime)
+
+	err = run.PrepareCmd(cmd).Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		fmt.Fprintf(o.io.StdErr, "%s Job %s failed with exit code %d.\n", o.io.Color().FailedIcon(), job.Name, exitErr.ExitCode())
+		return cmdutils.SilentError
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Job %s succeeded.\n", o.io.Color().GreenCheck(), job.Name)
+	return nil
+}
+
+// containerArgs returns the arguments for the container runtime.
+// Variable values are not part of the arguments, so they don't leak into
+// the process list: they are passed through the environment of the runtime
+// process instead.
+func (o *options) containerArgs(job *ciJob, env map[string]string, repoDir, projectDir, tmpDir, tmpMount string) []string {
+	args := []string{"run", "--rm"}
+	if o.io.IsInputTTY() && o.io.IsOutputTTY() {
+		args = append(args, "--interactive", "--tty")
+	}
+	if o.pull {
+		args = append(args, "--pull", "always")
+	}
+
+	args = append(args,
+		"--volume", repoDir+":"+projectDir,
+		"--volume", tmpDir+":"+tmpMount+":ro",
+		"--workdir", projectDir,
+	)
+
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, "--env", k)
+	}
+
+	if len(job.Entrypoint) > 0 {
+		args = append(args, "--entrypoint", job.Entrypoint[0])
+	}
+
+	args = append(args, job.Image)
+	if len(job.Entrypoint) > 1 {
+		args = append(args, job.Entrypoint[1:]...)
+	}
+
+	return append(args, "sh", "-c", job.shellScript(o.io.ColorEnabled()))
+}
+
+func (o *options) currentBranch() string {
+	branch, err := o.branch()
+	if err != nil {
+		return ""
+	}
+	return branch
+}
+
+func headSHA() string {
+	out, err := run.PrepareCmd(git.GitCommand("rev-parse", "HEAD")).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// environmentVariables returns the project variables that a job of an
+// environment gets. Jobs without an environment only get the variables of
+// all environments, like in pipelines, and never environment-scoped secrets.
+func environmentVariables(client *gitlab.Client, projectID int64, environment string) ([]*gitlab.ProjectVariable, error) {
+	if environment == "" {
+		variables, err := export.ProjectVariablesForScope(client, projectID, "*")
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(variables, func(v *gitlab.ProjectVariable) bool {
+			return v.EnvironmentScope != "*"
+		}), nil
+	}
+	return export.ProjectVariablesForScope(client, projectID, environment)
+}
+
+// predefinedVariables returns the subset of the predefined CI/CD variables
+// that can be derived without a pipeline.
+func predefinedVariables(repo glrepo.Interface, project *gitlab.Project, job *ciJob, projectDir, branch, sha string) map[string]string {
+	serverURL := "https://" + repo.RepoHost()
+
+	env := map[string]string{
+		"CI":                   "true",
+		"GITLAB_CI":            "true",
+		"CI_BUILDS_DIR":        buildsDir,
+		"CI_PROJECT_DIR":       projectDir,
+		"CI_PROJECT_ID":        strconv.FormatInt(project.ID, 10),
+		"CI_PROJECT_NAME":      project.Path,
+		"CI_PROJECT_TITLE":     project.Name,
+		"CI_PROJECT_PATH":      project.PathWithNamespace,
+		"CI_PROJECT_NAMESPACE": path.Dir(project.PathWithNamespace),
+		"CI_PROJECT_URL":       project.WebURL,
+		"CI_DEFAULT_BRANCH":    project.DefaultBranch,
+		"CI_SERVER_HOST":       repo.RepoHost(),
+		"CI_SERVER_URL":        serverURL,
+		"CI_API_V4_URL":        serverURL + "/api/v4",
+		"CI_JOB_NAME":          job.Name,
+		"CI_JOB_STAGE":         job.Stage,
+		"CI_JOB_IMAGE":         job.Image,
+	}
+
+	if branch != "" {
+		env["CI_COMMIT_BRANCH"] = branch
+		env["CI_COMMIT_REF_NAME"] = branch
+	}
+	if sha != "" {
+		env["CI_COMMIT_SHA"] = sha
+		env["CI_COMMIT_SHORT_SHA"] = sha[:min(8, len(sha))]
+	}
+	if job.Environment != "" {
+		env["CI_ENVIRONMENT_NAME"] = job.Environment
+	}
+
+	return env
+}
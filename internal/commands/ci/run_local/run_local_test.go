@@ -0,0 +1,151 @@
+//go:build !integration
+
+package run_local
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func TestCIRunLocal(t *testing.T) {
+	tests := []struct {
+		name            string
+		cli             string
+		variables       []*gitlab.ProjectVariable
+		wantArgs        []string
+		wantEnv         []string
+		wantNotEnv      []string
+		wantErr         string
+		skipVariableAPI bool
+	}{
+		{
+			name: "runs the job with project variables",
+			cli:  "build --runtime docker",
+			variables: []*gitlab.ProjectVariable{
+				{Key: "SECRET", Value: "wildcard", EnvironmentScope: "*"},
+				{Key: "SECRET", Value: "production", EnvironmentScope: "production"},
+				{Key: "KUBECONFIG", Value: "config", EnvironmentScope: "*", VariableType: gitlab.FileVariableType},
+				{Key: "STAGING_TOKEN", Value: "staging", EnvironmentScope: "staging"},
+			},
+			wantArgs: []string{
+				"docker", "run", "--rm",
+				"--workdir", "/builds/OWNER/REPO",
+				"--env", "SECRET",
+				"--entrypoint", "",
+				"alpine:3",
+				"sh", "-c",
+			},
+			wantEnv: []string{
+				"CI=true",
+				"CI_JOB_NAME=build",
+				"CI_PROJECT_PATH=OWNER/REPO",
+				"CI_COMMIT_BRANCH=feature",
+				"CI_COMMIT_SHA=0123456789abcdef",
+				"CI_ENVIRONMENT_NAME=production",
+				"OVERRIDDEN=job",
+				"SECRET=production",
+				"KUBECONFIG=/builds/OWNER/REPO.tmp/KUBECONFIG",
+			},
+			wantNotEnv: []string{"STAGING_TOKEN=staging"},
+		},
+		{
+			name: "jobs without environment only get variables of all environments",
+			cli:  "test --runtime docker",
+			variables: []*gitlab.ProjectVariable{
+				{Key: "SECRET", Value: "wildcard", EnvironmentScope: "*"},
+				{Key: "DEPLOY_TOKEN", Value: "production", EnvironmentScope: "production"},
+				{Key: "REVIEW_TOKEN", Value: "review", EnvironmentScope: "review/*"},
+			},
+			wantArgs:   []string{"docker", "run", "--rm", "golang:1.25", "sh", "-c"},
+			wantEnv:    []string{"SECRET=wildcard"},
+			wantNotEnv: []string{"DEPLOY_TOKEN=production", "REVIEW_TOKEN=review"},
+		},
+		{
+			name:            "variables from flags take precedence",
+			cli:             "test --runtime podman --no-project-variables --image busybox --variable GLOBAL=flag",
+			skipVariableAPI: true,
+			wantArgs:        []string{"podman", "run", "--rm", "busybox", "sh", "-c"},
+			wantEnv:         []string{"GLOBAL=flag", "CI_JOB_IMAGE=busybox"},
+			wantNotEnv:      []string{"SECRET=production"},
+		},
+		{
+			name:    "invalid variable flag",
+			cli:     "test --runtime docker --variable GLOBAL",
+			wantErr: `invalid variable "GLOBAL". Expected format KEY=VALUE.`,
+		},
+		{
+			name:            "unknown job",
+			cli:             "deploy --runtime docker",
+			skipVariableAPI: true,
+			wantErr:         `job "deploy" not found in the CI/CD configuration`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), ".gitlab-ci.yml")
+			require.NoError(t, os.WriteFile(configPath, []byte(mergedYaml), 0o600))
+
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+				Return(&gitlab.Project{ID: 123, Path: "REPO", PathWithNamespace: "OWNER/REPO"}, nil, nil).
+				MaxTimes(1)
+			tc.MockValidate.EXPECT().ProjectNamespaceLint(int64(123), gomock.Any()).
+				Return(&gitlab.ProjectLintResult{Valid: true, MergedYaml: mergedYaml}, nil, nil).
+				MaxTimes(1)
+			if !tt.skipVariableAPI {
+				tc.MockProjectVariables.EXPECT().ListVariables(int64(123), gomock.Any(), gomock.Any()).
+					Return(tt.variables, &gitlab.Response{}, nil).
+					MaxTimes(1)
+			}
+
+			var containerCmd *exec.Cmd
+			restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+				switch filepath.Base(cmd.Args[0]) {
+				case "git":
+					if cmd.Args[1] == "rev-parse" && cmd.Args[2] == "HEAD" {
+						return &test.OutputStub{Out: []byte("0123456789abcdef\n")}
+					}
+					return &test.OutputStub{Out: []byte("/home/user/repo\n")}
+				default:
+					containerCmd = cmd
+					return &test.OutputStub{}
+				}
+			})
+			t.Cleanup(restoreCmd)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdRunLocal, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithBranch("feature"),
+			)
+
+			out, err := exec(tt.cli + " --file " + configPath)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, containerCmd)
+
+			assert.Subset(t, containerCmd.Args, tt.wantArgs)
+			assert.Subset(t, containerCmd.Env, tt.wantEnv)
+			for _, env := range tt.wantNotEnv {
+				assert.NotContains(t, containerCmd.Env, env)
+			}
+			assert.Contains(t, out.ErrBuf.String(), "succeeded.")
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -31,6 +32,40 @@ Current respected settings:
 - host: If unset, defaults to %[1]shttps://gitlab.com%[1]s.
 - token: Your GitLab access token. Defaults to environment variables.
 - visual: Takes precedence over 'editor'. If unset, uses the default editor. Override with environment variable $VISUAL.
+
+Defaults for commands, which their flags override:
+
+- mr_target_branch: Target branch of new merge requests. If unset, uses the default branch of the repository.
+- mr_reviewers: Comma-separated usernames of the reviewers of new merge requests.
+- mr_labels: Comma-separated labels of new merge requests.
+- mr_squash: If true, new merge requests squash their commits when merged.
+- mr_template: Merge request template to use, instead of prompting for one, when creating merge requests interactively.
+- ci_variables: Comma-separated variables of pipelines created with 'glab ci run', in the <key>:<value> format.
+
+Settings are read from, in order of precedence:
+
+1. Environment variables.
+1. The local configuration of the repository, in %[1]s.git/glab-cli/config.yml%[1]s.
+1. The global configuration, in %[1]s~/.config/glab-cli/config.yml%[1]s.
+1. The configuration committed to the repository, in %[1]s.glab.yml%[1]s at its root.
+1. The system-wide configuration, in %[1]s$XDG_CONFIG_DIRS/glab-cli/config.yml%[1]s, if there is no global configuration.
+
+Teams can commit a %[1]s.glab.yml%[1]s file to share defaults. It can only hold the command defaults,
+as values or YAML lists, and aliases. Your own settings and aliases take precedence, and shell aliases
+are ignored. Commands that run on another repository, with %[1]s--repo%[1]s, ignore it:
+
+%[1]s%[1]s%[1]syaml
+mr_target_branch: develop
+mr_reviewers: [alice, bob]
+mr_squash: true
+ci_variables:
+  DEPLOY_ENV: staging
+aliases:
+  review: mr list --reviewer=@me
+%[1]s%[1]s%[1]s
+
+Run %[1]sglab config get --show-source <key>%[1]s to see where a value comes from, and
+%[1]sglab config doctor%[1]s to check the configuration for problems.
 `, "`"),
 		Aliases: []string{"conf"},
 	}
@@ -40,12 +75,14 @@ Current respected settings:
 	configCmd.AddCommand(NewCmdConfigGet(f))
 	configCmd.AddCommand(NewCmdConfigSet(f))
 	configCmd.AddCommand(NewCmdConfigEdit(f))
+	configCmd.AddCommand(NewCmdConfigDoctor(f))
 
 	return configCmd
 }
 
 func NewCmdConfigGet(f cmdutils.Factory) *cobra.Command {
 	var hostname string
+	var showSource bool
 
 	cmd := &cobra.Command{
 		Use:   "get <key>",
@@ -57,6 +94,10 @@ func NewCmdConfigGet(f cmdutils.Factory) *cobra.Command {
 
   		$ glab config get glamour_style
   		> notty
+
+  		$ glab config get mr_target_branch --show-source
+  		> develop
+  		> Source: repository configuration (/home/user/project/.glab.yml)
 		`),
 		Args: cobra.ExactArgs(1),
 		Annotations: map[string]string{
@@ -65,7 +106,7 @@ func NewCmdConfigGet(f cmdutils.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg := f.Config()
 
-			val, err := cfg.Get(hostname, args[0])
+			val, source, err := cfg.GetWithSource(hostname, args[0], true)
 			if err != nil {
 				return err
 			}
@@ -73,16 +114,37 @@ func NewCmdConfigGet(f cmdutils.Factory) *cobra.Command {
 			if val != "" {
 				fmt.Fprintf(f.IO().StdOut, "%s\n", val)
 			}
+			if showSource {
+				fmt.Fprintf(f.IO().StdOut, "Source: %s\n", describeSource(source))
+			}
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&hostname, "host", "", "", "Get per-host setting.")
-	cmd.Flags().BoolP("global", "g", false, "Read from global config file (~/.config/glab-cli/config.yml). (default checks 'Environment variables → Local → Global')")
+	cmd.Flags().BoolVar(&showSource, "show-source", false, "Print where the value comes from: an environment variable, a configuration file, the keyring, or the default.")
+	cmd.Flags().BoolP("global", "g", false, "Read from global config file (~/.config/glab-cli/config.yml). (default checks 'Environment variables → Local → Global → Repository')")
 
 	return cmd
 }
 
+func describeSource(source string) string {
+	switch {
+	case source == config.DefaultSource:
+		return "default value"
+	case source == "keyring":
+		return "operating system keyring"
+	case source == config.ConfigFile():
+		return fmt.Sprintf("global configuration (%s)", source)
+	case source == config.LocalConfigFile():
+		return fmt.Sprintf("local configuration (%s)", source)
+	case filepath.Base(source) == config.RepoConfigFileName:
+		return fmt.Sprintf("repository configuration (%s)", source)
+	default:
+		return fmt.Sprintf("environment variable %s", source)
+	}
+}
+
 func NewCmdConfigSet(f cmdutils.Factory) *cobra.Command {
 	var hostname string
 	var isGlobal bool
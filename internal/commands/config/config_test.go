@@ -116,6 +116,35 @@ func TestConfigGet(t *testing.T) {
 	}
 }
 
+func TestConfigGet_showSource(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	t.Setenv("GLAMOUR_STYLE", "")
+	t.Setenv("GITLAB_HOST", "gitlab.example.com")
+
+	cfg := config.NewFromString("editor: vim\nmr_labels: backend\nrepo:\n  mr_target_branch: develop\n  mr_labels: frontend\n")
+	tests := []struct {
+		key    string
+		stdout string
+	}{
+		{key: "mr_target_branch", stdout: "develop\nSource: repository configuration (.glab.yml)\n"},
+		{key: "editor", stdout: "vim\nSource: global configuration (" + config.ConfigFile() + ")\n"},
+		{key: "mr_labels", stdout: "backend\nSource: global configuration (" + config.ConfigFile() + ")\n"},
+		{key: "host", stdout: "gitlab.example.com\nSource: environment variable GITLAB_HOST\n"},
+		{key: "glamour_style", stdout: "dark\nSource: default value\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			io, _, stdout, stderr := cmdtest.TestIOStreams()
+			f := cmdtest.NewTestFactory(io, cmdtest.WithConfig(cfg))
+
+			output, err := cmdtest.ExecuteCommand(NewCmdConfigGet(f), tt.key+" --show-source", stdout, stderr)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.stdout, output.String())
+		})
+	}
+}
+
 func TestConfigSet(t *testing.T) {
 	tests := []struct {
 		name      string
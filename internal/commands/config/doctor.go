@@ -0,0 +1,302 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// tokenExpiryWarning is how long before a token expires the doctor warns about it.
+const tokenExpiryWarning = 7 * 24 * time.Hour
+
+type doctorOptions struct {
+	io        *iostreams.IOStreams
+	config    func() config.Config
+	apiClient func(repoHost string) (*api.Client, error)
+
+	offline bool
+
+	root     *cobra.Command
+	sections int
+	problems int
+}
+
+func NewCmdConfigDoctor(f cmdutils.Factory) *cobra.Command {
+	opts := &doctorOptions{
+		io:        f.IO(),
+		config:    f.Config,
+		apiClient: f.ApiClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the glab configuration for problems.",
+		Long: heredoc.Docf(`Check the glab configuration for problems, and suggest how to fix them.
+
+The doctor checks:
+
+- The global, local, and repository configuration files, and the aliases file, for unknown
+  settings, like typos, and invalid values.
+- The permissions of the files that can hold tokens, which must only be readable by you.
+- That the configured hosts are reachable, and accept their tokens.
+- That tokens are not expired, or about to expire.
+- That aliases run existing commands, and are not hidden by commands with the same name.
+
+Use %[1]s--offline%[1]s to skip the checks that connect to the hosts.
+The command exits with a non-zero status if it finds problems.
+`, "`"),
+		Example: heredoc.Doc(`
+			$ glab config doctor
+			$ glab config doctor --offline
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.root = cmd.Root()
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.offline, "offline", false, "Skip the checks that connect to the hosts.")
+
+	return cmd
+}
+
+func (o *doctorOptions) run() error {
+	cfg := o.config()
+	c := o.io.Color()
+
+	o.section("Configuration files")
+	globalFile, err := config.SearchConfigFile()
+	if err != nil {
+		globalFile = config.ConfigFile()
+	}
+	o.checkFile(globalFile, true, config.ValidateConfig, "glab config edit")
+	o.checkFile(config.AliasesConfigFile(), true, config.ValidateAliasesConfig, "glab alias list")
+	o.checkFile(config.LocalConfigFile(), true, config.ValidateLocalConfig, "glab config edit --local")
+	if repoFile := config.RepoConfigFile(); repoFile != "" {
+		o.checkFile(repoFile, false, config.ValidateRepoConfig, "")
+	}
+
+	o.section("Hosts")
+	o.checkHosts(cfg)
+
+	o.section("Aliases")
+	o.checkAliases(cfg)
+
+	fmt.Fprintln(o.io.StdOut)
+	if o.problems == 0 {
+		fmt.Fprintf(o.io.StdOut, "%s No problems found.\n", c.GreenCheck())
+		return nil
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s found.\n", c.FailedIcon(), utils.Pluralize(o.problems, "problem"))
+	return cmdutils.SilentError
+}
+
+func (o *doctorOptions) section(title string) {
+	if o.sections > 0 {
+		fmt.Fprintln(o.io.StdOut)
+	}
+	o.sections++
+	fmt.Fprintln(o.io.StdOut, o.io.Color().Bold(title))
+}
+
+func (o *doctorOptions) ok(format string, a ...any) {
+	fmt.Fprintf(o.io.StdOut, "  %s %s\n", o.io.Color().GreenCheck(), fmt.Sprintf(format, a...))
+}
+
+func (o *doctorOptions) warn(message, fix string) {
+	fmt.Fprintf(o.io.StdOut, "  %s %s\n", o.io.Color().WarnIcon(), message)
+	o.printFix(fix)
+}
+
+func (o *doctorOptions) fail(message, fix string) {
+	o.problems++
+	fmt.Fprintf(o.io.StdOut, "  %s %s\n", o.io.Color().FailedIcon(), message)
+	o.printFix(fix)
+}
+
+func (o *doctorOptions) printFix(fix string) {
+	if fix != "" {
+		fmt.Fprintf(o.io.StdOut, "    %s\n", o.io.Color().Gray(fix))
+	}
+}
+
+// checkFile checks the permissions and the settings of a configuration file,
+// if it exists. Files committed to repositories can't hold tokens, and are
+// not checked for permissions.
+func (o *doctorOptions) checkFile(file string, private bool, validate func(string, []byte) ([]config.Problem, error), editCommand string) {
+	stat, err := os.Stat(file)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			o.fail(fmt.Sprintf("%s: %s", file, err), "")
+		}
+		return
+	}
+
+	problems := o.problems
+	if private && !config.HasSecurePerms(stat.Mode().Perm()) {
+		o.fail(fmt.Sprintf("%s has the permissions %o, but glab requires 600.", file, stat.Mode().Perm()),
+			fmt.Sprintf("Run 'chmod 600 %s'.", file))
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		o.fail(fmt.Sprintf("%s: %s", file, err), "")
+		return
+	}
+	fileProblems, err := validate(file, data)
+	if err != nil {
+		fix := ""
+		if editCommand != "" {
+			fix = fmt.Sprintf("Run '%s' to fix it.", editCommand)
+		}
+		o.fail(err.Error(), fix)
+		return
+	}
+	for _, p := range fileProblems {
+		o.fail(p.String(), p.Fix)
+	}
+
+	if o.problems == problems {
+		o.ok("%s", file)
+	}
+}
+
+func (o *doctorOptions) checkHosts(cfg config.Config) {
+	hosts, err := cfg.Hosts()
+	if err != nil || len(hosts) == 0 {
+		o.warn("No hosts are configured.", "Run 'glab auth login' to sign in.")
+		return
+	}
+
+	for _, host := range hosts {
+		loginFix := fmt.Sprintf("Run 'glab auth login --hostname %s' to sign in again.", host)
+
+		if isOAuth2, _ := cfg.Get(host, "is_oauth2"); isOAuth2 == "true" {
+			expiry, _ := cfg.Get(host, "oauth2_expiry_date")
+			refreshToken, _ := cfg.Get(host, "oauth2_refresh_token")
+			if expiresAt, err := time.Parse(time.RFC822, expiry); err == nil && expiresAt.Before(time.Now()) && refreshToken == "" {
+				o.fail(fmt.Sprintf("%s: the OAuth token expired on %s, and can't be refreshed.", host, expiresAt.Format(time.DateOnly)), loginFix)
+				continue
+			}
+		}
+
+		if o.offline {
+			o.ok("%s: skipped the connection checks.", host)
+			continue
+		}
+
+		client, err := o.apiClient(host)
+		if err != nil {
+			o.fail(fmt.Sprintf("%s: failed to create an API client: %s", host, err), "")
+			continue
+		}
+
+		user, resp, err := client.Lab().Users.CurrentUser()
+		if err != nil {
+			switch {
+			case resp == nil:
+				apiProtocol, _ := cfg.Get(host, "api_protocol")
+				apiHost, _ := cfg.Get(host, "api_host")
+				if apiHost == "" {
+					apiHost = host
+				}
+				o.fail(fmt.Sprintf("%s is unreachable: %s", host, err),
+					fmt.Sprintf("Check your network connection, and that glab connects to the right URL: %s://%s.", apiProtocol, apiHost))
+			case resp.StatusCode == http.StatusUnauthorized:
+				o.fail(fmt.Sprintf("%s rejected the token, which is expired, revoked, or invalid.", host), loginFix)
+			default:
+				o.fail(fmt.Sprintf("%s: API call failed: %s", host, err), "")
+			}
+			continue
+		}
+
+		o.ok("%s: logged in as %s.", host, user.Username)
+		o.checkTokenExpiry(client, host)
+	}
+}
+
+// checkTokenExpiry warns about personal access tokens that expire soon.
+// Other kinds of tokens can't read their own expiration date.
+func (o *doctorOptions) checkTokenExpiry(client *api.Client, host string) {
+	token, _, err := client.Lab().PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil || token.ExpiresAt == nil {
+		return
+	}
+
+	expiresAt := time.Time(*token.ExpiresAt)
+	if time.Until(expiresAt) < tokenExpiryWarning {
+		o.warn(fmt.Sprintf("%s: the token %q expires on %s.", host, token.Name, expiresAt.Format(time.DateOnly)),
+			fmt.Sprintf("Run 'glab token rotate --user @me %s' to get a new token, and 'glab auth login --hostname %s' to use it.", token.Name, host))
+	}
+}
+
+func (o *doctorOptions) checkAliases(cfg config.Config) {
+	aliasCfg, err := cfg.Aliases()
+	if err != nil {
+		o.fail(fmt.Sprintf("failed to read the aliases: %s", err), "")
+		return
+	}
+
+	aliases := aliasCfg.All()
+	if len(aliases) == 0 {
+		o.ok("No aliases are defined.")
+		return
+	}
+
+	problems := o.problems
+	names := slices.Sorted(maps.Keys(aliases))
+	for _, name := range names {
+		expansion := aliases[name]
+
+		if cmd, _, err := o.root.Find([]string{name}); err == nil && cmd != o.root {
+			o.fail(fmt.Sprintf("the alias %q never runs, because the command %q has the same name.", name, cmd.CommandPath()),
+				fmt.Sprintf("Run 'glab alias delete %s', and 'glab alias set' to define it with another name.", name))
+			continue
+		}
+
+		if strings.HasPrefix(expansion, "!") {
+			continue
+		}
+		args, err := shlex.Split(expansion)
+		if err != nil || len(args) == 0 {
+			o.fail(fmt.Sprintf("the alias %q has an invalid expansion: %q.", name, expansion),
+				fmt.Sprintf("Run 'glab alias set %s <expansion>' to fix it.", name))
+			continue
+		}
+		if cmd, _, err := o.root.Find(args[:1]); err != nil || cmd == o.root {
+			fix := fmt.Sprintf("Run 'glab alias set %s <expansion>' to fix it.", name)
+			if suggestions := o.root.SuggestionsFor(args[0]); len(suggestions) > 0 {
+				fix = fmt.Sprintf("Did you mean %q? %s", suggestions[0], fix)
+			}
+			o.fail(fmt.Sprintf("the alias %q runs %q, which is not a glab command.", name, args[0]), fix)
+		}
+	}
+
+	if o.problems == problems {
+		if len(aliases) == 1 {
+			o.ok("1 alias checked.")
+		} else {
+			o.ok("%d aliases checked.", len(aliases))
+		}
+	}
+}
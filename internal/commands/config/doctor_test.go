@@ -0,0 +1,180 @@
+//go:build !integration
+
+package config
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setupDoctorFiles(t *testing.T, files map[string]string, perms map[string]os.FileMode) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("GLAB_CONFIG_DIR", dir)
+	for name, content := range files {
+		perm := os.FileMode(0o600)
+		if p, ok := perms[name]; ok {
+			perm = p
+		}
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), perm))
+		require.NoError(t, os.Chmod(path, perm))
+	}
+
+	localConfigFile, repoConfigFile := config.LocalConfigFile, config.RepoConfigFile
+	t.Cleanup(func() {
+		config.LocalConfigFile, config.RepoConfigFile = localConfigFile, repoConfigFile
+	})
+	config.LocalConfigFile = func() string { return filepath.Join(dir, "local.yml") }
+	config.RepoConfigFile = func() string { return filepath.Join(dir, ".glab.yml") }
+
+	return dir
+}
+
+func runDoctor(t *testing.T, cfg config.Config, client *gitlab.Client, args string) (string, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	f := cmdtest.NewTestFactory(ios,
+		cmdtest.WithConfig(cfg),
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(client))),
+	)
+
+	root := &cobra.Command{Use: "glab"}
+	mr := &cobra.Command{Use: "mr"}
+	mr.AddCommand(&cobra.Command{Use: "checkout", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(mr, NewCmdConfig(f))
+
+	out, err := cmdtest.ExecuteCommand(root, "config doctor "+args, stdout, stderr)
+	return out.String(), err
+}
+
+func TestConfigDoctor(t *testing.T) {
+	dir := setupDoctorFiles(t, map[string]string{
+		"config.yml": heredoc.Doc(`
+			git_protocl: https
+			hosts:
+			  gitlab.com:
+			    token: glpat-xxx
+			  gitlab.example.com:
+			    token: glpat-yyy
+			  oauth.example.com:
+			    is_oauth2: "true"
+			    oauth2_expiry_date: 01 Jan 25 00:00 UTC
+		`),
+		"aliases.yml": "co: mr checkout\n",
+		"local.yml":   "mr_squash: often\n",
+		".glab.yml":   "mr_target_branch: develop\n",
+	}, map[string]os.FileMode{"aliases.yml": 0o644})
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    token: glpat-xxx
+		  gitlab.example.com:
+		    token: glpat-yyy
+		  oauth.example.com:
+		    is_oauth2: "true"
+		    oauth2_expiry_date: 01 Jan 25 00:00 UTC
+		aliases:
+		  co: mr checkout
+		  mr: mr list
+		  pl: pipline list
+		  sh: "!echo hello"
+	`))
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "alice"}, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}, nil)
+	tc.MockUsers.EXPECT().CurrentUser().Return(nil, nil, errors.New("dial tcp: lookup gitlab.example.com: no such host"))
+	tc.MockPersonalAccessTokens.EXPECT().GetSinglePersonalAccessToken().Return(&gitlab.PersonalAccessToken{
+		Name:      "laptop",
+		ExpiresAt: gitlab.Ptr(gitlab.ISOTime(time.Now().Add(48 * time.Hour))),
+	}, nil, nil)
+
+	out, err := runDoctor(t, cfg, tc.Client, "")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	expires := time.Now().Add(48 * time.Hour).Format(time.DateOnly)
+	assert.Equal(t, heredoc.Docf(`
+		Configuration files
+		  x %[1]s/config.yml:1: unknown setting "git_protocl".
+		    Did you mean "git_protocol"?
+		  x %[1]s/aliases.yml has the permissions 644, but glab requires 600.
+		    Run 'chmod 600 %[1]s/aliases.yml'.
+		  x %[1]s/local.yml:1: invalid value "often" for mr_squash.
+		    Use true or false.
+		  ✓ %[1]s/.glab.yml
+
+		Hosts
+		  ✓ gitlab.com: logged in as alice.
+		  ! gitlab.com: the token "laptop" expires on %[2]s.
+		    Run 'glab token rotate --user @me laptop' to get a new token, and 'glab auth login --hostname gitlab.com' to use it.
+		  x gitlab.example.com is unreachable: dial tcp: lookup gitlab.example.com: no such host
+		    Check your network connection, and that glab connects to the right URL: https://gitlab.example.com.
+		  x oauth.example.com: the OAuth token expired on 2025-01-01, and can't be refreshed.
+		    Run 'glab auth login --hostname oauth.example.com' to sign in again.
+
+		Aliases
+		  x the alias "mr" never runs, because the command "glab mr" has the same name.
+		    Run 'glab alias delete mr', and 'glab alias set' to define it with another name.
+		  x the alias "pl" runs "pipline", which is not a glab command.
+		    Run 'glab alias set pl <expansion>' to fix it.
+
+		x 7 problems found.
+	`, dir, expires), out)
+}
+
+func TestConfigDoctor_noProblems(t *testing.T) {
+	setupDoctorFiles(t, map[string]string{
+		"config.yml": "git_protocol: ssh\n",
+	}, nil)
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    token: glpat-xxx
+		aliases:
+		  co: mr checkout
+	`))
+
+	out, err := runDoctor(t, cfg, gitlabtesting.NewTestClient(t).Client, "--offline")
+	require.NoError(t, err)
+	assert.Contains(t, out, "gitlab.com: skipped the connection checks.")
+	assert.Contains(t, out, "1 alias checked.")
+	assert.Contains(t, out, "✓ No problems found.")
+}
+
+func TestConfigDoctor_rejectedToken(t *testing.T) {
+	setupDoctorFiles(t, nil, nil)
+
+	cfg := config.NewFromString(heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    token: glpat-xxx
+	`))
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockUsers.EXPECT().CurrentUser().Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusUnauthorized}}, errors.New("401 Unauthorized"))
+
+	out, err := runDoctor(t, cfg, tc.Client, "")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, out, "x gitlab.com rejected the token, which is expired, revoked, or invalid.\n    Run 'glab auth login --hostname gitlab.com' to sign in again.")
+}
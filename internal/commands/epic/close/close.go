@@ -0,0 +1,81 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group string
+	iids  []int64
+}
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "close <epic>... [flags]",
+		Short: `Close epics.`,
+		Example: heredoc.Doc(`
+			$ glab epic close 12
+			$ glab epic close 12 13 --group gitlab-org
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				iid, err := epicutils.ParseIID(arg)
+				if err != nil {
+					return err
+				}
+				opts.iids = append(opts.iids, iid)
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epics. Defaults to the namespace of the current project.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	for _, iid := range o.iids {
+		epic, _, err := client.Epics.UpdateEpic(group, iid, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to close epic &%d.", iid))
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Closed epic &%d: %s\n", o.io.Color().RedCheck(), epic.IID, epic.Title)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build !integration
+
+package close
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdClose(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func TestEpicClose(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	for _, iid := range []string{"12", "13"} {
+		fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/groups/gitlab-org/epics/"+iid,
+			expectBody(t, `{"state_event": "close"}`,
+				httpmock.NewStringResponse(http.StatusOK, `{"iid": `+iid+`, "title": "Epic `+iid+`", "state": "closed"}`)))
+	}
+
+	output, err := runCommand(t, fakeHTTP, "12 &13 --group gitlab-org")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Closed epic &12: Epic 12\n✓ Closed epic &13: Epic 13\n", output.String())
+}
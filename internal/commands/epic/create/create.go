@@ -0,0 +1,125 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	title        string
+	description  string
+	labels       []string
+	parent       string
+	confidential bool
+	startDate    string
+	dueDate      string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an epic.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab epic create --title "Improve onboarding" --label roadmap
+			$ glab epic create --group gitlab-org --title "Sign-up flow" --parent 12 --due-date 2025-12-31
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the namespace of the current project.")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the epic.")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the epic.")
+	cmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels to the epic.")
+	cmd.Flags().StringVar(&opts.parent, "parent", "", "IID of the parent epic.")
+	cmd.Flags().BoolVarP(&opts.confidential, "confidential", "c", false, "Make the epic confidential.")
+	cmd.Flags().StringVar(&opts.startDate, "start-date", "", "Start date of the epic, in the YYYY-MM-DD format.")
+	cmd.Flags().StringVar(&opts.dueDate, "due-date", "", "Due date of the epic, in the YYYY-MM-DD format.")
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	createOpts := &gitlab.CreateEpicOptions{
+		Title: gitlab.Ptr(o.title),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.labels) > 0 {
+		createOpts.Labels = gitlab.Ptr(gitlab.LabelOptions(o.labels))
+	}
+	if o.confidential {
+		createOpts.Confidential = gitlab.Ptr(true)
+	}
+	if o.startDate != "" {
+		date, err := epicutils.ParseDate("start-date", o.startDate)
+		if err != nil {
+			return err
+		}
+		createOpts.StartDateIsFixed = gitlab.Ptr(true)
+		createOpts.StartDateFixed = date
+	}
+	if o.dueDate != "" {
+		date, err := epicutils.ParseDate("due-date", o.dueDate)
+		if err != nil {
+			return err
+		}
+		createOpts.DueDateIsFixed = gitlab.Ptr(true)
+		createOpts.DueDateFixed = date
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if o.parent != "" {
+		parentID, err := epicutils.ParentID(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+		createOpts.ParentID = gitlab.Ptr(parentID)
+	}
+
+	epic, _, err := client.Epics.CreateEpic(group, createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create the epic.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Created epic &%d: %s\n%s\n", o.io.Color().GreenCheck(), epic.IID, epic.Title, epic.WebURL)
+	return nil
+}
@@ -0,0 +1,64 @@
+//go:build !integration
+
+package create
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdCreate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func TestEpicCreate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/3",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 300, "iid": 3}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/groups/OWNER/epics",
+		expectBody(t, `{
+			"title": "Sign-up flow", "labels": "roadmap,ux", "parent_id": 300,
+			"due_date_is_fixed": true, "due_date_fixed": "2025-12-31"
+		}`, httpmock.NewStringResponse(http.StatusCreated, `{
+			"id": 400, "iid": 14, "title": "Sign-up flow", "web_url": "https://gitlab.com/groups/OWNER/-/epics/14"
+		}`)))
+
+	output, err := runCommand(t, fakeHTTP, `--title "Sign-up flow" --label roadmap,ux --parent 3 --due-date 2025-12-31`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created epic &14: Sign-up flow\nhttps://gitlab.com/groups/OWNER/-/epics/14\n", output.String())
+}
+
+func TestEpicCreate_invalidDate(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `--title Epic --start-date tomorrow`)
+	assert.EqualError(t, err, `invalid --start-date "tomorrow". Use the YYYY-MM-DD format.`)
+}
@@ -0,0 +1,41 @@
+package epic
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	epicCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/close"
+	epicCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/create"
+	epicListCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/list"
+	epicUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/update"
+	epicViewCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/view"
+)
+
+func NewCmdEpic(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epic <command> [flags]",
+		Short: `Work with GitLab epics.`,
+		Long: heredoc.Docf(`
+			Work with the epics of a group.
+
+			Epics are identified by their IID, like %[1]s12%[1]s or %[1]s&12%[1]s. The group is set
+			with %[1]s--group%[1]s, and defaults to the namespace of the current project.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab epic list --group gitlab-org
+			$ glab epic view 12
+			$ glab epic create --title "Improve onboarding" --parent 3
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(epicListCmd.NewCmdList(f))
+	cmd.AddCommand(epicViewCmd.NewCmdView(f))
+	cmd.AddCommand(epicCreateCmd.NewCmdCreate(f))
+	cmd.AddCommand(epicUpdateCmd.NewCmdUpdate(f))
+	cmd.AddCommand(epicCloseCmd.NewCmdClose(f))
+
+	return cmd
+}
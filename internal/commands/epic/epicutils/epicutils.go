@@ -0,0 +1,165 @@
+package epicutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// Group returns the group of the epics: the group flag if set, or else the
+// namespace of the current project.
+func Group(group string, baseRepo func() (glrepo.Interface, error)) (string, error) {
+	if group != "" {
+		return group, nil
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return "", fmt.Errorf("no group given, and could not determine the current project: %w. Use --group to select the group.", err)
+	}
+	return repo.RepoOwner(), nil
+}
+
+// ParseIID parses an epic IID, with or without the & prefix.
+func ParseIID(arg string) (int64, error) {
+	iid, err := strconv.ParseInt(strings.TrimPrefix(arg, "&"), 10, 64)
+	if err != nil || iid <= 0 {
+		return 0, cmdutils.FlagError{Err: fmt.Errorf("invalid epic %q. Use the epic IID, like 12 or &12.", arg)}
+	}
+	return iid, nil
+}
+
+// EpicState returns the reference of an epic, colored by its state.
+func EpicState(c *iostreams.ColorPalette, e *gitlab.Epic) string {
+	if e.State == "opened" {
+		return c.Green(fmt.Sprintf("&%d", e.IID))
+	}
+	return c.Red(fmt.Sprintf("&%d", e.IID))
+}
+
+func DisplayEpicList(streams *iostreams.IOStreams, epics []*gitlab.Epic) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(streams.IsOutputTTY())
+
+	if len(epics) > 0 {
+		table.AddRow("IID", "ID", "Title", "Labels", "Created at")
+	}
+
+	for _, epic := range epics {
+		table.AddCell(streams.Hyperlink(EpicState(c, epic), epic.WebURL))
+		table.AddCell(epic.ID)
+		table.AddCell(epic.Title)
+
+		if len(epic.Labels) > 0 {
+			table.AddCellf("(%s)", c.Cyan(strings.Join(epic.Labels, ", ")))
+		} else {
+			table.AddCell("")
+		}
+
+		if epic.CreatedAt != nil {
+			table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(*epic.CreatedAt)))
+		} else {
+			table.AddCell("")
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// Tree returns the child epics and issues of an epic, recursively.
+func Tree(client *gitlab.Client, c *iostreams.ColorPalette, epic *gitlab.Epic) (*utils.TreeNode, error) {
+	return tree(client, c, epic, map[int64]bool{})
+}
+
+func tree(client *gitlab.Client, c *iostreams.ColorPalette, epic *gitlab.Epic, visited map[int64]bool) (*utils.TreeNode, error) {
+	visited[epic.ID] = true
+	node := &utils.TreeNode{Label: epicLabel(c, epic)}
+
+	children, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+		return client.Epics.GetEpicLinks(epic.GroupID, epic.IID, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the child epics of &%d: %w", epic.IID, err)
+	}
+	for _, child := range children {
+		if visited[child.ID] {
+			continue
+		}
+		childNode, err := tree(client, c, child, visited)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+
+	issues, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.EpicIssues.ListEpicIssues(epic.GroupID, epic.IID, &gitlab.ListOptions{PerPage: api.MaxPerPage}, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the issues of &%d: %w", epic.IID, err)
+	}
+	for _, issue := range issues {
+		node.Children = append(node.Children, &utils.TreeNode{Label: issueLabel(c, issue)})
+	}
+
+	return node, nil
+}
+
+func epicLabel(c *iostreams.ColorPalette, e *gitlab.Epic) string {
+	label := fmt.Sprintf("%s %s", EpicState(c, e), e.Title)
+	if e.State != "opened" {
+		label += c.Gray(" (" + e.State + ")")
+	}
+	return label
+}
+
+func issueLabel(c *iostreams.ColorPalette, i *gitlab.Issue) string {
+	ref := fmt.Sprintf("#%d", i.IID)
+	if i.References != nil && i.References.Full != "" {
+		ref = i.References.Full
+	}
+
+	color := c.Green
+	if i.State != "opened" {
+		color = c.Red
+	}
+	label := fmt.Sprintf("%s %s", color(ref), i.Title)
+	if i.State != "opened" {
+		label += c.Gray(" (" + i.State + ")")
+	}
+	return label
+}
+
+// ParseDate parses the date of a flag in the YYYY-MM-DD format.
+func ParseDate(flag, value string) (*gitlab.ISOTime, error) {
+	date, err := gitlab.ParseISOTime(value)
+	if err != nil {
+		return nil, cmdutils.FlagError{Err: fmt.Errorf("invalid --%s %q. Use the YYYY-MM-DD format.", flag, value)}
+	}
+	return &date, nil
+}
+
+// ParentID returns the ID of a parent epic, given as an IID.
+func ParentID(client *gitlab.Client, group, parent string) (int64, error) {
+	iid, err := ParseIID(parent)
+	if err != nil {
+		return 0, err
+	}
+
+	epic, _, err := client.Epics.GetEpic(group, iid)
+	if err != nil {
+		return 0, cmdutils.WrapError(err, fmt.Sprintf("failed to get the parent epic &%d.", iid))
+	}
+	return epic.ID, nil
+}
@@ -0,0 +1,83 @@
+//go:build !integration
+
+package epicutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+func TestParseIID(t *testing.T) {
+	iid, err := ParseIID("12")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), iid)
+
+	iid, err = ParseIID("&7")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), iid)
+
+	for _, arg := range []string{"", "abc", "#12", "0", "-3"} {
+		_, err := ParseIID(arg)
+		assert.EqualError(t, err, `invalid epic "`+arg+`". Use the epic IID, like 12 or &12.`)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	date, err := ParseDate("due-date", "2025-12-31")
+	require.NoError(t, err)
+	assert.Equal(t, "2025-12-31", date.String())
+
+	_, err = ParseDate("due-date", "31/12/2025")
+	assert.EqualError(t, err, `invalid --due-date "31/12/2025". Use the YYYY-MM-DD format.`)
+}
+
+func TestTree(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/1/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 20, "iid": 2, "group_id": 1, "title": "Child", "state": "opened"},
+			{"id": 10, "iid": 1, "group_id": 1, "title": "Cycle", "state": "opened"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/2/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/2/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 105, "iid": 5, "title": "Closed issue", "state": "closed", "references": {"full": "OWNER/REPO#5"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/1/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 103, "iid": 3, "title": "Issue", "state": "opened"}]`))
+
+	client := cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", "gitlab.com").Lab()
+	ios, _, _, _ := cmdtest.TestIOStreams()
+
+	tree, err := Tree(client, ios.Color(), &gitlab.Epic{ID: 10, IID: 1, GroupID: 1, Title: "Root", State: "opened"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "&1 Root", tree.Label)
+	assert.Equal(t, `├── &2 Child
+│   └── OWNER/REPO#5 Closed issue (closed)
+└── #3 Issue
+`, utils.RenderTree(tree))
+}
+
+func TestGroup(t *testing.T) {
+	group, err := Group("gitlab-org", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab-org", group)
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	group, err = Group("", cmdtest.NewTestFactory(ios).BaseRepo)
+	require.NoError(t, err)
+	assert.Equal(t, "OWNER", group)
+}
@@ -0,0 +1,135 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	state        string
+	labels       []string
+	author       string
+	search       string
+	page         int
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the epics of a group.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			# List the open epics of the group of the current project
+			$ glab epic list
+
+			# List the closed epics of a group with a label
+			$ glab epic list --group gitlab-org --state closed --label roadmap
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epics. Defaults to the namespace of the current project.")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "opened", "Filter epics by state: opened, closed, all.")
+	cmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Filter epics by labels.")
+	cmd.Flags().StringVarP(&opts.author, "author", "a", "", "Filter epics by author username.")
+	cmd.Flags().StringVar(&opts.search, "search", "", "Search epics by title and description.")
+	cmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	cmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	switch o.state {
+	case "opened", "closed", "all":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid state %q. Use one of: opened, closed, all.", o.state)}
+	}
+	if o.outputFormat != "text" && o.outputFormat != "json" {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", o.outputFormat)}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		State:       gitlab.Ptr(o.state),
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = gitlab.Ptr(gitlab.LabelOptions(o.labels))
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	if o.author != "" {
+		author, err := api.UserByName(client, o.author)
+		if err != nil {
+			return err
+		}
+		listOpts.AuthorID = gitlab.Ptr(author.ID)
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics(group, listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list epics.")
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(epics)
+	}
+
+	title := utils.NewListTitle("epic")
+	title.RepoName = group
+	title.Page = o.page
+	title.CurrentPageTotal = len(epics)
+
+	fmt.Fprintf(o.io.StdOut, "%s\n%s\n", title.Describe(), epicutils.DisplayEpicList(o.io, epics))
+	return nil
+}
@@ -0,0 +1,65 @@
+//go:build !integration
+
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestEpicList(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "closed", req.URL.Query().Get("state"))
+			assert.Equal(t, "roadmap", req.URL.Query().Get("labels"))
+			return httpmock.NewStringResponse(http.StatusOK, `[
+				{"id": 100, "iid": 12, "title": "Improve onboarding", "state": "closed", "labels": ["roadmap"], "web_url": "https://gitlab.com/groups/OWNER/-/epics/12"}
+			]`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, "--state closed --label roadmap")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "Showing 1 epic on OWNER. (Page 1)")
+	assert.Contains(t, output.String(), "&12\t100\tImprove onboarding\t(roadmap)")
+	assert.Empty(t, output.Stderr())
+}
+
+func TestEpicList_group(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/gitlab-org/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "--group gitlab-org")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "No epics available on gitlab-org.")
+}
+
+func TestEpicList_invalidState(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "--state merged")
+	assert.EqualError(t, err, `invalid state "merged". Use one of: opened, closed, all.`)
+}
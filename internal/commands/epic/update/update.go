@@ -0,0 +1,133 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	iid          int64
+	title        string
+	description  string
+	addLabels    []string
+	removeLabels []string
+	parent       string
+	startDate    string
+	dueDate      string
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update <epic> [flags]",
+		Short: `Update an epic.`,
+		Example: heredoc.Doc(`
+			$ glab epic update 12 --title "Improve onboarding" --label roadmap --unlabel draft
+			$ glab epic update 12 --parent 3
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if opts.iid, err = epicutils.ParseIID(args[0]); err != nil {
+				return err
+			}
+			return opts.run(cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the namespace of the current project.")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the epic.")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the epic.")
+	cmd.Flags().StringSliceVarP(&opts.addLabels, "label", "l", []string{}, "Add labels to the epic.")
+	cmd.Flags().StringSliceVarP(&opts.removeLabels, "unlabel", "u", []string{}, "Remove labels from the epic.")
+	cmd.Flags().StringVar(&opts.parent, "parent", "", "IID of the parent epic.")
+	cmd.Flags().StringVar(&opts.startDate, "start-date", "", "Start date of the epic, in the YYYY-MM-DD format.")
+	cmd.Flags().StringVar(&opts.dueDate, "due-date", "", "Due date of the epic, in the YYYY-MM-DD format.")
+
+	return cmd
+}
+
+func (o *options) run(cmd *cobra.Command) error {
+	updateOpts := &gitlab.UpdateEpicOptions{}
+	if cmd.Flags().Changed("title") {
+		updateOpts.Title = gitlab.Ptr(o.title)
+	}
+	if cmd.Flags().Changed("description") {
+		updateOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.addLabels) > 0 {
+		updateOpts.AddLabels = gitlab.Ptr(gitlab.LabelOptions(o.addLabels))
+	}
+	if len(o.removeLabels) > 0 {
+		updateOpts.RemoveLabels = gitlab.Ptr(gitlab.LabelOptions(o.removeLabels))
+	}
+	if o.startDate != "" {
+		date, err := epicutils.ParseDate("start-date", o.startDate)
+		if err != nil {
+			return err
+		}
+		updateOpts.StartDateIsFixed = gitlab.Ptr(true)
+		updateOpts.StartDateFixed = date
+	}
+	if o.dueDate != "" {
+		date, err := epicutils.ParseDate("due-date", o.dueDate)
+		if err != nil {
+			return err
+		}
+		updateOpts.DueDateIsFixed = gitlab.Ptr(true)
+		updateOpts.DueDateFixed = date
+	}
+	if *updateOpts == (gitlab.UpdateEpicOptions{}) && o.parent == "" {
+		return cmdutils.FlagError{Err: errors.New("nothing to update. Set at least one flag.")}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if o.parent != "" {
+		parentID, err := epicutils.ParentID(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+		updateOpts.ParentID = gitlab.Ptr(parentID)
+	}
+
+	epic, _, err := client.Epics.UpdateEpic(group, o.iid, updateOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to update epic &%d.", o.iid))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated epic &%d: %s\n%s\n", o.io.Color().GreenCheck(), epic.IID, epic.Title, epic.WebURL)
+	return nil
+}
@@ -0,0 +1,60 @@
+//go:build !integration
+
+package update
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdUpdate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func TestEpicUpdate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/groups/OWNER/epics/12",
+		expectBody(t, `{"title": "Onboarding", "add_labels": "roadmap", "remove_labels": "draft"}`,
+			httpmock.NewStringResponse(http.StatusOK, `{
+				"iid": 12, "title": "Onboarding", "web_url": "https://gitlab.com/groups/OWNER/-/epics/12"
+			}`)))
+
+	output, err := runCommand(t, fakeHTTP, "12 --title Onboarding --label roadmap --unlabel draft")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Updated epic &12: Onboarding\nhttps://gitlab.com/groups/OWNER/-/epics/12\n", output.String())
+}
+
+func TestEpicUpdate_nothingToUpdate(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "12")
+	assert.EqualError(t, err, "nothing to update. Set at least one flag.")
+}
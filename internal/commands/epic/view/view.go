@@ -0,0 +1,134 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	iid          int64
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "view <epic> [flags]",
+		Short:   `Display an epic, with its child epics and issues as a tree.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab epic view 12
+			$ glab epic view &12 --group gitlab-org
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if opts.iid, err = epicutils.ParseIID(args[0]); err != nil {
+				return err
+			}
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the namespace of the current project.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	group, err := epicutils.Group(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	epic, _, err := client.Epics.GetEpic(group, o.iid)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get epic &%d.", o.iid))
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(epic)
+	}
+
+	c := o.io.Color()
+	tree, err := epicutils.Tree(client, c, epic)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s\n", epicutils.EpicState(c, epic), c.Bold(epic.Title))
+
+	author := ""
+	if epic.Author != nil {
+		author = " by @" + epic.Author.Username
+	}
+	created := ""
+	if epic.CreatedAt != nil {
+		created = " " + utils.TimeToPrettyTimeAgo(*epic.CreatedAt)
+	}
+	state := "Open"
+	if epic.State != "opened" {
+		state = "Closed"
+	}
+	fmt.Fprintf(&sb, "%s%s%s\n", state, author, c.Gray(created))
+
+	if len(epic.Labels) > 0 {
+		fmt.Fprintf(&sb, "Labels: %s\n", c.Cyan(strings.Join(epic.Labels, ", ")))
+	}
+	if epic.StartDate != nil {
+		fmt.Fprintf(&sb, "Start date: %s\n", utils.FormatDueDate(epic.StartDate))
+	}
+	if epic.DueDate != nil {
+		fmt.Fprintf(&sb, "Due date: %s\n", utils.FormatDueDate(epic.DueDate))
+	}
+
+	if epic.Description != "" {
+		description, _ := utils.RenderMarkdown(epic.Description, o.io.BackgroundColor())
+		fmt.Fprintf(&sb, "\n%s\n", strings.TrimSpace(description))
+	}
+
+	if len(tree.Children) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n%s", c.Bold("Children"), utils.RenderTree(tree))
+	}
+
+	fmt.Fprintf(&sb, "\n%s\n", c.Gray("View this epic on GitLab: "+epic.WebURL))
+
+	fmt.Fprint(o.io.StdOut, sb.String())
+	return nil
+}
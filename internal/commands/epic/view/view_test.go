@@ -0,0 +1,60 @@
+//go:build !integration
+
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdView(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestEpicView(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 100, "iid": 12, "group_id": 1, "title": "Improve onboarding", "state": "opened",
+			"author": {"username": "alice"}, "labels": ["roadmap"], "due_date": "2025-12-31",
+			"web_url": "https://gitlab.com/groups/OWNER/-/epics/12"
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/12/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 101, "iid": 13, "group_id": 1, "title": "Sign-up flow", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/13/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/13/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 201, "iid": 4, "title": "Add a form", "state": "opened", "references": {"full": "OWNER/REPO#4"}}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/1/epics/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "&12")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "&12 Improve onboarding\nOpen by @alice")
+	assert.Contains(t, out, "Labels: roadmap\n")
+	assert.Contains(t, out, "Children\n└── &13 Sign-up flow\n    └── OWNER/REPO#4 Add a form\n")
+	assert.Contains(t, out, "View this epic on GitLab: https://gitlab.com/groups/OWNER/-/epics/12")
+}
+
+func TestEpicView_invalidEpic(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "abc")
+	assert.EqualError(t, err, `invalid epic "abc". Use the epic IID, like 12 or &12.`)
+}
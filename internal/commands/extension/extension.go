@@ -0,0 +1,46 @@
+package extension
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	installCmd "gitlab.com/gitlab-org/cli/internal/commands/extension/install"
+	listCmd "gitlab.com/gitlab-org/cli/internal/commands/extension/list"
+	removeCmd "gitlab.com/gitlab-org/cli/internal/commands/extension/remove"
+	upgradeCmd "gitlab.com/gitlab-org/cli/internal/commands/extension/upgrade"
+)
+
+func NewCmdExtension(f cmdutils.Factory) *cobra.Command {
+	extensionCmd := &cobra.Command{
+		Use:     "extension <command> [flags]",
+		Short:   `Install, list, upgrade, and remove glab extensions.`,
+		Aliases: []string{"extensions", "ext"},
+		Long: heredoc.Docf(`
+			Extensions are executables that add commands to glab. An extension named
+			%[1]sfoo%[1]s runs as %[1]sglab foo%[1]s, unless a built-in command has the same name.
+
+			Extensions are installed from GitLab projects named %[1]sglab-<name>%[1]s:
+
+			- If the latest release of the project has an asset for your platform, like
+			  %[1]sglab-foo_linux_amd64%[1]s, glab downloads it.
+			- Otherwise, glab clones the repository, which must contain a %[1]sglab-<name>%[1]s
+			  executable at its root.
+
+			glab runs extensions with these environment variables:
+
+			- %[1]sGITLAB_HOST%[1]s: the GitLab host of the current repository, or the default host.
+			- %[1]sGITLAB_TOKEN%[1]s: the token for this host, if you are authenticated.
+			- %[1]sGLAB_REPO%[1]s: the full path of the current repository, if any.
+
+			Extensions are installed in the %[1]sextensions%[1]s directory of the glab
+			configuration directory.
+		`, "`"),
+	}
+
+	extensionCmd.AddCommand(installCmd.NewCmdInstall(f))
+	extensionCmd.AddCommand(listCmd.NewCmdList(f))
+	extensionCmd.AddCommand(upgradeCmd.NewCmdUpgrade(f))
+	extensionCmd.AddCommand(removeCmd.NewCmdRemove(f))
+	return extensionCmd
+}
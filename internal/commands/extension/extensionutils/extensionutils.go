@@ -0,0 +1,418 @@
+package extensionutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+const (
+	// Prefix is the prefix of the names of extension projects and executables.
+	Prefix = "glab-"
+
+	// Annotation marks the commands that run an extension.
+	Annotation = "extension"
+
+	// manifestExt is the extension of the manifests of the extensions, which
+	// are stored next to their directories, outside of Git work trees.
+	manifestExt = ".yml"
+)
+
+// Kind is how an extension is installed.
+type Kind string
+
+const (
+	// KindGit is an extension cloned from a Git repository, with a
+	// glab-<name> executable at its root.
+	KindGit Kind = "git"
+	// KindBinary is an extension downloaded from a release asset.
+	KindBinary Kind = "binary"
+)
+
+// ErrNotInstalled is returned for extensions that are not installed.
+var ErrNotInstalled = errors.New("extension is not installed")
+
+// Extension is an installed extension.
+type Extension struct {
+	Name string `yaml:"name"`
+	Kind Kind   `yaml:"kind"`
+	Host string `yaml:"host"`
+	// Repo is the full path of the project of the extension.
+	Repo string `yaml:"repo"`
+	// Version is the release tag of binary extensions, and the commit SHA
+	// of Git extensions.
+	Version string `yaml:"version"`
+	// Pin is the version the extension is pinned to, if any. Upgrades skip
+	// pinned extensions, until they are unpinned.
+	Pin string `yaml:"pin,omitempty"`
+
+	dir string
+}
+
+// Source returns the project the extension was installed from.
+func (e *Extension) Source() string {
+	return e.Host + "/" + e.Repo
+}
+
+// ShortVersion returns the version of the extension, with commit SHAs
+// shortened.
+func (e *Extension) ShortVersion() string {
+	if e.Kind == KindGit && len(e.Version) > 8 {
+		return e.Version[:8]
+	}
+	return e.Version
+}
+
+// Executable returns the path of the executable of the extension.
+func (e *Extension) Executable() string {
+	return filepath.Join(e.dir, executableName(e.Name, e.Kind))
+}
+
+func executableName(name string, kind Kind) string {
+	if kind == KindBinary && runtime.GOOS == "windows" {
+		return Prefix + name + ".exe"
+	}
+	return Prefix + name
+}
+
+// NameFromRepo returns the name of the extension of a project, which must
+// be named glab-<name>.
+func NameFromRepo(repoName string) (string, error) {
+	name := strings.TrimPrefix(repoName, Prefix)
+	if name == repoName || name == "" {
+		return "", fmt.Errorf("extension projects must be named %q, got %q.", Prefix+"<name>", repoName)
+	}
+	return name, nil
+}
+
+// Dir returns the directory of the installed extensions.
+func Dir() string {
+	return filepath.Join(config.ConfigDir(), "extensions")
+}
+
+// Manager installs, lists, upgrades and removes the extensions of a directory.
+type Manager struct {
+	dir string
+}
+
+func NewManager(dir string) *Manager {
+	return &Manager{dir: dir}
+}
+
+// List returns the installed extensions, sorted by name.
+func (m *Manager) List() ([]*Extension, error) {
+	entries, err := os.ReadDir(m.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var extensions []*Extension
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), Prefix) {
+			continue
+		}
+		ext, err := readManifest(filepath.Join(m.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		extensions = append(extensions, ext)
+	}
+
+	sort.Slice(extensions, func(i, j int) bool { return extensions[i].Name < extensions[j].Name })
+	return extensions, nil
+}
+
+// Get returns an installed extension.
+func (m *Manager) Get(name string) (*Extension, error) {
+	ext, err := readManifest(filepath.Join(m.dir, Prefix+name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotInstalled, name)
+	}
+	return ext, err
+}
+
+// Install installs the extension of a project: the executable asset of a
+// release for the current platform if there is one, or else a clone of the
+// repository. pin installs a release tag or a Git ref instead of the latest
+// version, and pins the extension to it.
+func (m *Manager) Install(client *gitlab.Client, repo glrepo.Interface, protocol, pin string) (*Extension, error) {
+	name, err := NameFromRepo(repo.RepoName())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.Get(name); err == nil {
+		return nil, fmt.Errorf("extension %q is already installed.", name)
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", repo.FullName(), err)
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return nil, err
+	}
+	tmpDir, err := os.MkdirTemp(m.dir, ".install-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	ext := &Extension{
+		Name: name,
+		Host: repo.RepoHost(),
+		Repo: project.PathWithNamespace,
+		Pin:  pin,
+		dir:  tmpDir,
+	}
+
+	release, asset, err := findRelease(client, project.ID, name, pin)
+	if err != nil {
+		return nil, err
+	}
+	if asset != nil {
+		ext.Kind = KindBinary
+		ext.Version = release.TagName
+		if err := download(client, asset, ext.Executable()); err != nil {
+			return nil, err
+		}
+	} else {
+		ext.Kind = KindGit
+		if err := clone(glrepo.RemoteURL(project, protocol), tmpDir, pin); err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(ext.Executable()); err != nil {
+			return nil, fmt.Errorf("%s has no release asset for %s/%s, and no %s executable at the root of its repository.",
+				repo.FullName(), runtime.GOOS, runtime.GOARCH, Prefix+name)
+		}
+		if ext.Version, err = gitOutput(tmpDir, "rev-parse", "HEAD"); err != nil {
+			return nil, err
+		}
+	}
+
+	ext.dir = filepath.Join(m.dir, Prefix+name)
+	if err := os.Rename(tmpDir, ext.dir); err != nil {
+		return nil, err
+	}
+	if err := writeManifest(ext.dir, ext); err != nil {
+		_ = os.RemoveAll(ext.dir)
+		return nil, err
+	}
+	return ext, nil
+}
+
+// Upgrade upgrades an extension to its latest version, or to pin, and
+// reports whether its version changed. Pinning an extension to a new version
+// is only possible with the same kind of version: a release tag for binary
+// extensions, a Git ref for the others.
+func (m *Manager) Upgrade(client *gitlab.Client, ext *Extension, pin string) (bool, error) {
+	previous := ext.Version
+
+	switch ext.Kind {
+	case KindBinary:
+		release, asset, err := findRelease(client, ext.Repo, ext.Name, pin)
+		if err != nil {
+			return false, err
+		}
+		if asset == nil {
+			if pin != "" {
+				return false, fmt.Errorf("release %s of %s has no asset for %s/%s.", pin, ext.Repo, runtime.GOOS, runtime.GOARCH)
+			}
+			return false, fmt.Errorf("the latest release of %s has no asset for %s/%s.", ext.Repo, runtime.GOOS, runtime.GOARCH)
+		}
+		if release.TagName != ext.Version {
+			tmpFile := ext.Executable() + ".new"
+			if err := download(client, asset, tmpFile); err != nil {
+				return false, err
+			}
+			if err := os.Rename(tmpFile, ext.Executable()); err != nil {
+				return false, err
+			}
+			ext.Version = release.TagName
+		}
+	default:
+		if _, err := gitOutput(ext.dir, "fetch", "--quiet", "--tags", "origin"); err != nil {
+			return false, err
+		}
+		var err error
+		if pin != "" {
+			_, err = gitOutput(ext.dir, "checkout", "--quiet", pin)
+		} else {
+			_, err = gitOutput(ext.dir, "merge", "--quiet", "--ff-only", "@{upstream}")
+		}
+		if err != nil {
+			return false, err
+		}
+		if ext.Version, err = gitOutput(ext.dir, "rev-parse", "HEAD"); err != nil {
+			return false, err
+		}
+	}
+
+	if pin != "" {
+		ext.Pin = pin
+	}
+	if err := writeManifest(ext.dir, ext); err != nil {
+		return false, err
+	}
+	return ext.Version != previous, nil
+}
+
+// Unpin unpins an extension, so that upgrades upgrade it to its latest
+// version again. Git extensions are checked out on the default branch of
+// their repository, which pinning detached them from.
+func (m *Manager) Unpin(ext *Extension) error {
+	if ext.Kind == KindGit {
+		remoteHead, err := gitOutput(ext.dir, "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+		if err != nil {
+			return fmt.Errorf("failed to find the default branch of %s: %w", ext.Repo, err)
+		}
+		if _, err := gitOutput(ext.dir, "checkout", "--quiet", strings.TrimPrefix(remoteHead, "origin/")); err != nil {
+			return err
+		}
+	}
+
+	ext.Pin = ""
+	return writeManifest(ext.dir, ext)
+}
+
+// Remove removes an installed extension.
+func (m *Manager) Remove(name string) error {
+	ext, err := m.Get(name)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(ext.dir); err != nil {
+		return err
+	}
+	return os.Remove(manifestPath(ext.dir))
+}
+
+// findRelease returns the latest release of a project, or the release of
+// the pin tag, and its executable asset for the current platform. Both are
+// nil if there is no such release, and the asset is nil if the release has
+// no asset for the platform.
+func findRelease(client *gitlab.Client, pid any, name, pin string) (*gitlab.Release, *gitlab.ReleaseLink, error) {
+	var release *gitlab.Release
+	var resp *gitlab.Response
+	var err error
+	if pin != "" {
+		release, resp, err = client.Releases.GetRelease(pid, pin)
+	} else {
+		release, resp, err = client.Releases.GetLatestRelease(pid)
+	}
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get the releases of %v: %w", pid, err)
+	}
+
+	return release, findAsset(release, name), nil
+}
+
+// findAsset returns the asset of a release that is the executable of the
+// extension for the current platform, like glab-name_linux_amd64. The name can
+// only go on after a delimiter, like in glab-name_windows_amd64.exe, so that
+// glab-name_linux_arm64 isn't an asset for linux/arm.
+func findAsset(release *gitlab.Release, name string) *gitlab.ReleaseLink {
+	want := fmt.Sprintf("%s%s_%s_%s", Prefix, name, runtime.GOOS, runtime.GOARCH)
+	for _, link := range release.Assets.Links {
+		assetName := strings.ToLower(link.Name)
+		rest, ok := strings.CutPrefix(assetName, want)
+		if !ok || (rest != "" && !strings.ContainsAny(rest[:1], "._-")) {
+			continue
+		}
+		switch filepath.Ext(assetName) {
+		case ".sha256", ".sig", ".asc", ".txt", ".gz", ".zip":
+			continue
+		}
+		return link
+	}
+	return nil
+}
+
+func download(client *gitlab.Client, asset *gitlab.ReleaseLink, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o755)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	assetURL := asset.DirectAssetURL
+	if assetURL == "" {
+		assetURL = asset.URL
+	}
+	if err := releaseutils.DownloadAsset(context.Background(), client, assetURL, f); err != nil {
+		return fmt.Errorf("failed to download %s: %w", asset.Name, err)
+	}
+	return nil
+}
+
+func clone(remoteURL, dir, pin string) error {
+	cloneCmd := exec.Command("git", "clone", "--quiet", remoteURL, dir)
+	if err := run.PrepareCmd(cloneCmd).Run(); err != nil {
+		return fmt.Errorf("failed to clone %s: %w", remoteURL, err)
+	}
+	if pin != "" {
+		if _, err := gitOutput(dir, "checkout", "--quiet", pin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func gitOutput(dir string, args ...string) (string, error) {
+	gitCmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out, err := run.PrepareCmd(gitCmd).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// manifestPath returns the path of the manifest of the extension installed
+// in dir.
+func manifestPath(dir string) string {
+	return dir + manifestExt
+}
+
+func readManifest(dir string) (*Extension, error) {
+	data, err := os.ReadFile(manifestPath(dir))
+	if err != nil {
+		return nil, err
+	}
+
+	ext := &Extension{}
+	if err := yaml.Unmarshal(data, ext); err != nil {
+		return nil, fmt.Errorf("invalid extension manifest %s: %w", manifestPath(dir), err)
+	}
+	ext.dir = dir
+	return ext, nil
+}
+
+func writeManifest(dir string, ext *Extension) error {
+	data, err := yaml.Marshal(ext)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(manifestPath(dir), data, 0o600)
+}
@@ -0,0 +1,271 @@
+//go:build !integration
+
+package extensionutils
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+)
+
+func testClient(t *testing.T, rt http.RoundTripper) *gitlab.Client {
+	t.Helper()
+
+	return cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()
+}
+
+// sourceRepo creates a Git repository with a glab-deploy script, and
+// returns its path.
+func sourceRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	git(t, dir, "init", "--quiet", "--initial-branch=main")
+	commitScript(t, dir, "v1")
+	return dir
+}
+
+func commitScript(t *testing.T, dir, version string) {
+	t.Helper()
+
+	script := fmt.Sprintf("#!/bin/sh\necho %s\n", version)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "glab-deploy"), []byte(script), 0o755))
+	git(t, dir, "add", ".")
+	git(t, dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", version)
+	git(t, dir, "tag", version)
+}
+
+func git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func projectResponse(remoteURL string) httpmock.Responder {
+	return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(
+		`{"id": 7, "path_with_namespace": "OWNER/glab-deploy", "http_url_to_repo": %q}`, remoteURL))
+}
+
+func TestNameFromRepo(t *testing.T) {
+	name, err := NameFromRepo("glab-deploy")
+	require.NoError(t, err)
+	assert.Equal(t, "deploy", name)
+
+	_, err = NameFromRepo("deploy")
+	assert.EqualError(t, err, `extension projects must be named "glab-<name>", got "deploy".`)
+
+	_, err = NameFromRepo("glab-")
+	assert.Error(t, err)
+}
+
+func TestInstall_releaseAsset(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	asset := fmt.Sprintf("glab-deploy_%s_%s", runtime.GOOS, runtime.GOARCH)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2Fglab-deploy?license=true&with_custom_attributes=true", projectResponse(""))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/7/releases/permalink/latest",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"tag_name": "v1.2.0", "assets": {"links": [
+			{"name": "checksums.txt", "direct_asset_url": "https://gitlab.com/OWNER/glab-deploy/-/releases/v1.2.0/downloads/checksums.txt"},
+			{"name": "glab-deploy_other_arch", "direct_asset_url": "https://gitlab.com/OWNER/glab-deploy/-/releases/v1.2.0/downloads/other"},
+			{"name": %q, "direct_asset_url": "https://gitlab.com/OWNER/glab-deploy/-/releases/v1.2.0/downloads/%[1]s"}
+		]}}`, asset)))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/glab-deploy/-/releases/v1.2.0/downloads/"+asset,
+		httpmock.NewStringResponse(http.StatusOK, "binary"))
+
+	manager := NewManager(t.TempDir())
+	repo, err := glrepo.FromFullName("OWNER/glab-deploy", "gitlab.com")
+	require.NoError(t, err)
+
+	ext, err := manager.Install(testClient(t, fakeHTTP), repo, "https", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "deploy", ext.Name)
+	assert.Equal(t, KindBinary, ext.Kind)
+	assert.Equal(t, "v1.2.0", ext.Version)
+	assert.Equal(t, "gitlab.com/OWNER/glab-deploy", ext.Source())
+
+	content, err := os.ReadFile(ext.Executable())
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(content))
+
+	installed, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, installed, 1)
+	assert.Equal(t, ext.Version, installed[0].Version)
+
+	_, err = manager.Install(testClient(t, fakeHTTP), repo, "https", "")
+	assert.EqualError(t, err, `extension "deploy" is already installed.`)
+}
+
+func TestFindAsset(t *testing.T) {
+	platform := runtime.GOOS + "_" + runtime.GOARCH
+
+	tests := []struct {
+		name   string
+		assets []string
+		want   string
+	}{
+		{
+			name:   "exact name",
+			assets: []string{"glab-foo_" + platform},
+			want:   "glab-foo_" + platform,
+		},
+		{
+			name:   "name with a suffix",
+			assets: []string{"glab-foo_" + platform + ".sha256", "glab-foo_" + platform + ".exe"},
+			want:   "glab-foo_" + platform + ".exe",
+		},
+		{
+			name:   "other extension with the same prefix",
+			assets: []string{"glab-foobar_" + platform},
+		},
+		{
+			name:   "architecture with the same prefix",
+			assets: []string{"glab-foo_" + platform + "64"},
+		},
+		{
+			name:   "other platform",
+			assets: []string{"glab-foo_other_arch"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			release := &gitlab.Release{}
+			for _, name := range tc.assets {
+				release.Assets.Links = append(release.Assets.Links, &gitlab.ReleaseLink{Name: name})
+			}
+
+			asset := findAsset(release, "foo")
+			if tc.want == "" {
+				assert.Nil(t, asset)
+				return
+			}
+			require.NotNil(t, asset)
+			assert.Equal(t, tc.want, asset.Name)
+		})
+	}
+}
+
+func TestInstall_gitRepository(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test extension is a shell script")
+	}
+
+	source := sourceRepo(t)
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2Fglab-deploy?license=true&with_custom_attributes=true", projectResponse(source))
+	fakeHTTP.RegisterReusableResponder(http.MethodGet, "/api/v4/projects/7/releases/permalink/latest",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not found"}`))
+
+	manager := NewManager(t.TempDir())
+	repo, err := glrepo.FromFullName("OWNER/glab-deploy", "gitlab.com")
+	require.NoError(t, err)
+
+	ext, err := manager.Install(testClient(t, fakeHTTP), repo, "https", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, KindGit, ext.Kind)
+	assert.Equal(t, git(t, source, "rev-parse", "HEAD"), ext.Version)
+	out, err := exec.Command(ext.Executable()).Output()
+	require.NoError(t, err)
+	assert.Equal(t, "v1\n", string(out))
+
+	// Upgrade to the latest commit
+	upgraded, err := manager.Upgrade(nil, ext, "")
+	require.NoError(t, err)
+	assert.False(t, upgraded)
+
+	commitScript(t, source, "v2")
+	upgraded, err = manager.Upgrade(nil, ext, "")
+	require.NoError(t, err)
+	assert.True(t, upgraded)
+	out, err = exec.Command(ext.Executable()).Output()
+	require.NoError(t, err)
+	assert.Equal(t, "v2\n", string(out))
+
+	// Pin to a tag
+	upgraded, err = manager.Upgrade(nil, ext, "v1")
+	require.NoError(t, err)
+	assert.True(t, upgraded)
+
+	pinned, err := manager.Get("deploy")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", pinned.Pin)
+	assert.Equal(t, git(t, source, "rev-parse", "v1"), pinned.Version)
+	assert.Empty(t, git(t, pinned.dir, "status", "--porcelain"), "the manifest must not be in the work tree")
+
+	// Unpin, and upgrade to the latest commit of the default branch
+	require.NoError(t, manager.Unpin(pinned))
+	upgraded, err = manager.Upgrade(nil, pinned, "")
+	require.NoError(t, err)
+	assert.True(t, upgraded)
+
+	unpinned, err := manager.Get("deploy")
+	require.NoError(t, err)
+	assert.Empty(t, unpinned.Pin)
+	assert.Equal(t, git(t, source, "rev-parse", "v2"), unpinned.Version)
+	assert.Equal(t, "main", git(t, unpinned.dir, "branch", "--show-current"))
+}
+
+func TestInstall_noExecutable(t *testing.T) {
+	source := t.TempDir()
+	git(t, source, "init", "--quiet")
+	require.NoError(t, os.WriteFile(filepath.Join(source, "README.md"), []byte("readme"), 0o644))
+	git(t, source, "add", ".")
+	git(t, source, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2Fglab-deploy?license=true&with_custom_attributes=true", projectResponse(source))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/7/releases/permalink/latest",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not found"}`))
+
+	dir := t.TempDir()
+	repo, err := glrepo.FromFullName("OWNER/glab-deploy", "gitlab.com")
+	require.NoError(t, err)
+
+	_, err = NewManager(dir).Install(testClient(t, fakeHTTP), repo, "https", "")
+	assert.EqualError(t, err, fmt.Sprintf(
+		"OWNER/glab-deploy has no release asset for %s/%s, and no glab-deploy executable at the root of its repository.",
+		runtime.GOOS, runtime.GOARCH))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "failed installs must not leave files")
+}
+
+func TestRemove(t *testing.T) {
+	dir := t.TempDir()
+	extDir := filepath.Join(dir, "glab-deploy")
+	require.NoError(t, os.Mkdir(extDir, 0o755))
+	require.NoError(t, writeManifest(extDir, &Extension{Name: "deploy", Kind: KindBinary, Host: "gitlab.com", Repo: "OWNER/glab-deploy"}))
+
+	manager := NewManager(dir)
+	require.NoError(t, manager.Remove("deploy"))
+	assert.NoDirExists(t, extDir)
+	assert.NoFileExists(t, extDir+".yml")
+
+	assert.ErrorIs(t, manager.Remove("deploy"), ErrNotInstalled)
+}
@@ -0,0 +1,95 @@
+package install
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+
+	repo string
+	pin  string
+}
+
+func NewCmdInstall(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "install <repository> [flags]",
+		Short: `Install a glab extension.`,
+		Long: heredoc.Docf(`
+			Install a glab extension from a GitLab project named %[1]sglab-<name>%[1]s.
+
+			The repository can be a full path, like %[1]sgroup/glab-foo%[1]s, on the default
+			GitLab host, or a URL.
+
+			Use %[1]s--pin%[1]s to install a release tag, or a Git ref for extensions without
+			release assets, instead of the latest version. Upgrades skip pinned extensions.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Install the latest release of an extension
+			$ glab extension install mygroup/glab-deploy
+
+			# Install an extension from a self-managed instance, pinned to a version
+			$ glab extension install https://gitlab.example.com/tools/glab-audit --pin v1.2.0
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repo = args[0]
+			return opts.run(cmd.Root())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.pin, "pin", "", "Install this release tag or Git ref, and pin the extension to it.")
+
+	return cmd
+}
+
+func (o *options) run(root *cobra.Command) error {
+	repo, err := glrepo.FromFullName(o.repo, o.factory.DefaultHostname())
+	if err != nil {
+		return cmdutils.FlagError{Err: err}
+	}
+
+	name, err := extensionutils.NameFromRepo(repo.RepoName())
+	if err != nil {
+		return cmdutils.FlagError{Err: err}
+	}
+	if cmd, _, err := root.Find([]string{name}); err == nil && cmd != root && cmd.Annotations[extensionutils.Annotation] == "" {
+		return fmt.Errorf("%q matches the built-in command %q. Rename the extension project.", name, cmd.Name())
+	}
+
+	apiClient, err := o.factory.ApiClient(repo.RepoHost())
+	if err != nil {
+		return err
+	}
+	protocol, _ := o.factory.Config().Get(repo.RepoHost(), "git_protocol")
+
+	ext, err := extensionutils.NewManager(extensionutils.Dir()).Install(apiClient.Lab(), repo, protocol, o.pin)
+	if err != nil {
+		return err
+	}
+
+	version := ext.ShortVersion()
+	if ext.Pin != "" {
+		version += " (pinned)"
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Installed extension %s %s from %s.\n", o.io.Color().GreenCheck(), ext.Name, version, ext.Source())
+	return nil
+}
@@ -0,0 +1,47 @@
+//go:build !integration
+
+package install
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestExtensionInstall_invalidName(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no prefix",
+			cli:     "tools/deploy",
+			wantErr: `extension projects must be named "glab-<name>", got "deploy".`,
+		},
+		{
+			name:    "built-in command",
+			cli:     "tools/glab-issue",
+			wantErr: `"issue" matches the built-in command "issue". Rename the extension project.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ios, _, stdout, stderr := cmdtest.TestIOStreams()
+			f := cmdtest.NewTestFactory(ios)
+
+			root := &cobra.Command{Use: "glab"}
+			root.AddCommand(&cobra.Command{Use: "issue", Run: func(*cobra.Command, []string) {}})
+			root.AddCommand(NewCmdInstall(f))
+
+			_, err := cmdtest.ExecuteCommand(root, "install "+tc.cli, stdout, stderr)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
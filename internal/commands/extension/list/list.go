@@ -0,0 +1,63 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io *iostreams.IOStreams
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the installed extensions.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+	return cmd
+}
+
+func (o *options) run() error {
+	extensions, err := extensionutils.NewManager(extensionutils.Dir()).List()
+	if err != nil {
+		return fmt.Errorf("failed to list the extensions: %w", err)
+	}
+
+	if len(extensions) == 0 {
+		fmt.Fprintln(o.io.StdErr, "No extensions installed.")
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("Name", "Source", "Kind", "Version")
+	for _, ext := range extensions {
+		version := ext.ShortVersion()
+		if ext.Pin != "" {
+			version += c.Gray(" (pinned)")
+		}
+		table.AddRow(ext.Name, ext.Source(), ext.Kind, version)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
@@ -0,0 +1,65 @@
+//go:build !integration
+
+package list
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func installExtension(t *testing.T, configDir, name, manifest string) {
+	t.Helper()
+
+	dir := filepath.Join(configDir, "extensions", "glab-"+name)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(dir+".yml", []byte(manifest), 0o600))
+}
+
+func TestExtensionList(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("GLAB_CONFIG_DIR", configDir)
+
+	installExtension(t, configDir, "deploy", heredoc.Doc(`
+		name: deploy
+		kind: binary
+		host: gitlab.com
+		repo: tools/glab-deploy
+		version: v1.2.0
+	`))
+	installExtension(t, configDir, "audit", heredoc.Doc(`
+		name: audit
+		kind: git
+		host: gitlab.example.com
+		repo: security/glab-audit
+		version: 0123456789abcdef0123456789abcdef01234567
+		pin: v2
+	`))
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	output, err := cmdtest.ExecuteCommand(NewCmdList(cmdtest.NewTestFactory(ios)), "", stdout, stderr)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Name	Source	Kind	Version
+		audit	gitlab.example.com/security/glab-audit	git	01234567 (pinned)
+		deploy	gitlab.com/tools/glab-deploy	binary	v1.2.0
+	`), output.String())
+}
+
+func TestExtensionList_empty(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	output, err := cmdtest.ExecuteCommand(NewCmdList(cmdtest.NewTestFactory(ios)), "", stdout, stderr)
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Equal(t, "No extensions installed.\n", output.Stderr())
+}
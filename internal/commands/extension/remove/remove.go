@@ -0,0 +1,49 @@
+package remove
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io *iostreams.IOStreams
+
+	name string
+}
+
+func NewCmdRemove(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io: f.IO(),
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Short:   `Remove an installed extension.`,
+		Aliases: []string{"rm", "delete"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = strings.TrimPrefix(args[0], extensionutils.Prefix)
+			return opts.run()
+		},
+	}
+	return cmd
+}
+
+func (o *options) run() error {
+	if err := extensionutils.NewManager(extensionutils.Dir()).Remove(o.name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Removed extension %s.\n", o.io.Color().RedCheck(), o.name)
+	return nil
+}
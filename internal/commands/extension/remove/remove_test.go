@@ -0,0 +1,34 @@
+//go:build !integration
+
+package remove
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestExtensionRemove(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("GLAB_CONFIG_DIR", configDir)
+
+	dir := filepath.Join(configDir, "extensions", "glab-deploy")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(dir+".yml", []byte("name: deploy\nkind: binary\n"), 0o600))
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	output, err := cmdtest.ExecuteCommand(NewCmdRemove(cmdtest.NewTestFactory(ios)), "glab-deploy", stdout, stderr)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed extension deploy.\n", output.String())
+	assert.NoDirExists(t, dir)
+	assert.NoFileExists(t, dir+".yml")
+
+	_, err = cmdtest.ExecuteCommand(NewCmdRemove(cmdtest.NewTestFactory(ios)), "deploy", stdout, stderr)
+	assert.EqualError(t, err, "extension is not installed: deploy")
+}
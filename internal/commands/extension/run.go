@@ -0,0 +1,64 @@
+package extension
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+)
+
+// NewCmdRun returns the command that runs an installed extension. glab does
+// not parse its flags: the extension receives all its arguments.
+func NewCmdRun(f cmdutils.Factory, ext *extensionutils.Extension) *cobra.Command {
+	return &cobra.Command{
+		Use:                ext.Name,
+		Short:              fmt.Sprintf("Extension %s.", ext.Source()),
+		DisableFlagParsing: true,
+		Annotations: map[string]string{
+			extensionutils.Annotation: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExtension(f, ext, args)
+		},
+	}
+}
+
+func runExtension(f cmdutils.Factory, ext *extensionutils.Extension, args []string) error {
+	extCmd := exec.Command(ext.Executable(), args...)
+	extCmd.Stdin = f.IO().In
+	extCmd.Stdout = f.IO().StdOut
+	extCmd.Stderr = f.IO().StdErr
+	extCmd.Env = environment(f)
+
+	if err := extCmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			// The extension reported its own error.
+			return cmdutils.WrapErrorWithCode(cmdutils.SilentError, exitErr.ExitCode(), "")
+		}
+		return fmt.Errorf("failed to run extension %s: %w", ext.Name, err)
+	}
+	return nil
+}
+
+// environment returns the environment of an extension: the environment of
+// glab, with the resolved host, token and repository.
+func environment(f cmdutils.Factory) []string {
+	env := os.Environ()
+
+	host := f.DefaultHostname()
+	if repo, err := f.BaseRepo(); err == nil {
+		host = repo.RepoHost()
+		env = append(env, "GLAB_REPO="+repo.FullName())
+	}
+	env = append(env, "GITLAB_HOST="+host)
+	if token, _ := f.Config().Get(host, "token"); token != "" {
+		env = append(env, "GITLAB_TOKEN="+token)
+	}
+	return env
+}
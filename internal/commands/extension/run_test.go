@@ -0,0 +1,61 @@
+//go:build !integration
+
+package extension
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestRunExtension(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test extension is a shell script")
+	}
+	t.Setenv("GITLAB_TOKEN", "")
+
+	dir := t.TempDir()
+	extDir := filepath.Join(dir, "glab-env")
+	require.NoError(t, os.Mkdir(extDir, 0o755))
+	require.NoError(t, os.WriteFile(extDir+".yml", []byte("name: env\nkind: git\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(extDir, "glab-env"), []byte(heredoc.Doc(`
+		#!/bin/sh
+		echo "host=$GITLAB_HOST token=$GITLAB_TOKEN repo=$GLAB_REPO args=$*"
+		[ "$1" = "fail" ] && exit 3
+		exit 0
+	`)), 0o755))
+
+	ext, err := extensionutils.NewManager(dir).Get("env")
+	require.NoError(t, err)
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	f := cmdtest.NewTestFactory(ios,
+		cmdtest.WithConfig(config.NewFromString(heredoc.Doc(`
+			hosts:
+			  gitlab.com:
+			    token: secret
+		`))),
+	)
+
+	output, err := cmdtest.ExecuteCommand(NewCmdRun(f, ext), "list --state opened -R other/repo", stdout, stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "host=gitlab.com token=secret repo=OWNER/REPO args=list --state opened -R other/repo\n", output.String())
+
+	stdout.Reset()
+	_, err = cmdtest.ExecuteCommand(NewCmdRun(f, ext), "fail", stdout, stderr)
+	var exitErr *cmdutils.ExitError
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, 3, exitErr.Code)
+	assert.ErrorIs(t, err, cmdutils.SilentError)
+}
@@ -0,0 +1,154 @@
+package upgrade
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io      *iostreams.IOStreams
+	factory cmdutils.Factory
+
+	name  string
+	all   bool
+	pin   string
+	unpin bool
+}
+
+func NewCmdUpgrade(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:      f.IO(),
+		factory: f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade [<name>] [flags]",
+		Short: `Upgrade installed extensions.`,
+		Long: heredoc.Docf(`
+			Upgrade an extension, or all extensions with %[1]s--all%[1]s, to their latest
+			version.
+
+			Pinned extensions are skipped. Use %[1]s--pin%[1]s to pin an extension to another
+			version: a release tag for extensions installed from a release asset, or a
+			Git ref for the others. Use %[1]s--unpin%[1]s to unpin an extension, and upgrade
+			it to its latest version: extensions installed from a Git repository are
+			checked out on its default branch again.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Upgrade all extensions
+			$ glab extension upgrade --all
+
+			# Pin an extension to a new version
+			$ glab extension upgrade deploy --pin v1.3.0
+
+			# Unpin an extension, and upgrade it to its latest version
+			$ glab extension upgrade deploy --unpin
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = strings.TrimPrefix(args[0], extensionutils.Prefix)
+			}
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Upgrade all extensions.")
+	cmd.Flags().StringVar(&opts.pin, "pin", "", "Upgrade the extension to this release tag or Git ref, and pin it.")
+	cmd.Flags().BoolVar(&opts.unpin, "unpin", false, "Unpin the extension, and upgrade it to its latest version.")
+	cmd.MarkFlagsMutuallyExclusive("pin", "unpin")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if o.name == "" && !o.all {
+		return cmdutils.FlagError{Err: errors.New("specify an extension to upgrade, or use --all.")}
+	}
+	if o.name != "" && o.all {
+		return cmdutils.FlagError{Err: errors.New("specify an extension, or use --all, not both.")}
+	}
+	if o.pin != "" && o.all {
+		return cmdutils.FlagError{Err: errors.New("--pin cannot be used with --all.")}
+	}
+	if o.unpin && o.all {
+		return cmdutils.FlagError{Err: errors.New("--unpin cannot be used with --all.")}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	manager := extensionutils.NewManager(extensionutils.Dir())
+
+	var extensions []*extensionutils.Extension
+	if o.all {
+		var err error
+		if extensions, err = manager.List(); err != nil {
+			return fmt.Errorf("failed to list the extensions: %w", err)
+		}
+		if len(extensions) == 0 {
+			fmt.Fprintln(o.io.StdErr, "No extensions installed.")
+			return nil
+		}
+	} else {
+		ext, err := manager.Get(o.name)
+		if err != nil {
+			return err
+		}
+		extensions = append(extensions, ext)
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, ext := range extensions {
+		if ext.Pin != "" && o.pin == "" && !o.unpin {
+			fmt.Fprintf(o.io.StdOut, "%s Skipped %s: pinned to %s.\n", c.DotWarnIcon(), ext.Name, ext.Pin)
+			continue
+		}
+
+		previous := ext.ShortVersion()
+		upgraded, err := o.upgrade(manager, ext)
+		switch {
+		case err != nil:
+			failed++
+			fmt.Fprintf(o.io.StdErr, "%s Failed to upgrade %s: %s\n", c.FailedIcon(), ext.Name, err)
+		case upgraded:
+			fmt.Fprintf(o.io.StdOut, "%s Upgraded %s from %s to %s.\n", c.GreenCheck(), ext.Name, previous, ext.ShortVersion())
+		default:
+			fmt.Fprintf(o.io.StdOut, "%s %s is up to date: %s.\n", c.GreenCheck(), ext.Name, ext.ShortVersion())
+		}
+	}
+
+	if failed > 0 {
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+func (o *options) upgrade(manager *extensionutils.Manager, ext *extensionutils.Extension) (bool, error) {
+	apiClient, err := o.factory.ApiClient(ext.Host)
+	if err != nil {
+		return false, err
+	}
+	if o.unpin {
+		if err := manager.Unpin(ext); err != nil {
+			return false, err
+		}
+	}
+	return manager.Upgrade(apiClient.Lab(), ext, o.pin)
+}
@@ -0,0 +1,85 @@
+//go:build !integration
+
+package upgrade
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	return cmdtest.ExecuteCommand(NewCmdUpgrade(cmdtest.NewTestFactory(ios)), cli, stdout, stderr)
+}
+
+func TestExtensionUpgrade_pinned(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("GLAB_CONFIG_DIR", configDir)
+
+	dir := filepath.Join(configDir, "extensions", "glab-deploy")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(dir+".yml",
+		[]byte("name: deploy\nkind: binary\nhost: gitlab.com\nrepo: tools/glab-deploy\nversion: v1.0.0\npin: v1.0.0\n"), 0o600))
+
+	output, err := runCommand(t, "--all")
+	require.NoError(t, err)
+
+	assert.Equal(t, "• Skipped deploy: pinned to v1.0.0.\n", output.String())
+}
+
+func TestExtensionUpgrade_errors(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no extension",
+			cli:     "",
+			wantErr: "specify an extension to upgrade, or use --all.",
+		},
+		{
+			name:    "extension and all",
+			cli:     "deploy --all",
+			wantErr: "specify an extension, or use --all, not both.",
+		},
+		{
+			name:    "pin with all",
+			cli:     "--all --pin v2",
+			wantErr: "--pin cannot be used with --all.",
+		},
+		{
+			name:    "unpin with all",
+			cli:     "--all --unpin",
+			wantErr: "--unpin cannot be used with --all.",
+		},
+		{
+			name:    "pin and unpin",
+			cli:     "deploy --pin v2 --unpin",
+			wantErr: "if any flags in the group [pin unpin] are set none of the others can be; [pin unpin] were all set",
+		},
+		{
+			name:    "not installed",
+			cli:     "deploy",
+			wantErr: "extension is not installed: deploy",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runCommand(t, tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
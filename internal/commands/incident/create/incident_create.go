@@ -0,0 +1,119 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	title        string
+	description  string
+	severity     string
+	labels       []string
+	assignees    []string
+	confidential bool
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an incident.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			$ glab incident create --title "Checkout is returning 500 errors" --severity critical
+			$ glab incident create -t "Elevated latency on the API" -d "p95 is above 2s since 14:05 UTC." --severity high --assignee @me
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.severity != "" {
+				if err := incidentutils.ValidateEnum("severity", opts.severity, incidentutils.Severities); err != nil {
+					return err
+				}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the incident.")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the incident.")
+	cmd.Flags().StringVar(&opts.severity, "severity", "", "Severity of the incident: critical, high, medium, low, unknown.")
+	cmd.Flags().StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels to the incident. Multiple labels are comma-separated.")
+	cmd.Flags().StringSliceVarP(&opts.assignees, "assignee", "a", []string{}, "Assign the incident to users, by username. Multiple users are comma-separated.")
+	cmd.Flags().BoolVarP(&opts.confidential, "confidential", "c", false, "Set the incident to confidential.")
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	createOpts := &gitlab.CreateIssueOptions{
+		Title:     gitlab.Ptr(o.title),
+		IssueType: gitlab.Ptr(string(issuable.TypeIncident)),
+		Labels:    (*gitlab.LabelOptions)(&o.labels),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if o.confidential {
+		createOpts.Confidential = gitlab.Ptr(true)
+	}
+	if len(o.assignees) > 0 {
+		users, err := api.UsersByNames(client, o.assignees)
+		if err != nil {
+			return err
+		}
+		createOpts.AssigneeIDs = cmdutils.IDsFromUsers(users)
+	}
+
+	fmt.Fprintln(o.io.StdErr, "- Creating incident in", repo.FullName())
+	issue, _, err := client.Issues.CreateIssue(repo.FullName(), createOpts)
+	if err != nil {
+		return fmt.Errorf("failed to create the incident: %w", err)
+	}
+
+	if o.severity != "" {
+		fmt.Fprintln(o.io.StdErr, "- Setting the severity to", o.severity)
+		if err := incidentutils.SetSeverity(client, repo, issue, o.severity); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(o.io.StdOut, issueutils.DisplayIssue(o.io.Color(), issue, o.io.IsaTTY))
+	return nil
+}
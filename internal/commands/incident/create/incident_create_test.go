@@ -0,0 +1,60 @@
+//go:build !integration
+
+package create
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdCreate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const incidentJSON = `{
+	"id": 420, "iid": 42, "issue_type": "incident", "state": "opened",
+	"title": "Checkout is down", "created_at": "2026-10-19T14:05:00Z",
+	"web_url": "https://gitlab.com/OWNER/REPO/-/issues/42"
+}`
+
+func TestIncidentCreate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues",
+		`{"title": "Checkout is down", "issue_type": "incident", "labels": "outage", "confidential": true}`,
+		httpmock.NewStringResponse(http.StatusCreated, incidentJSON))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), `"input":{"iid":"42","projectPath":"OWNER/REPO","severity":"CRITICAL"}`)
+			return httpmock.NewStringResponse(http.StatusOK, `{"data": {"issueSetSeverity": {"errors": []}}}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, `--title "Checkout is down" --severity critical --label outage --confidential`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "- Creating incident in OWNER/REPO\n- Setting the severity to critical\n", output.Stderr())
+	assert.Contains(t, output.String(), "https://gitlab.com/OWNER/REPO/-/issues/42")
+}
+
+func TestIncidentCreate_invalidSeverity(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `--title "Checkout is down" --severity sev1`)
+	assert.EqualError(t, err, `invalid severity "sev1". Use one of: critical, high, medium, low, unknown.`)
+}
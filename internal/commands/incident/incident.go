@@ -6,11 +6,14 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	incidentCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/close"
+	incidentCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/create"
 	incidentListCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/list"
 	incidentNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/note"
 	incidentReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/reopen"
 	incidentSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/subscribe"
+	incidentTimelineCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline"
 	incidentUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/unsubscribe"
+	incidentUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/update"
 	incidentViewCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/view"
 )
 
@@ -21,6 +24,9 @@ func NewCmdIncident(f cmdutils.Factory) *cobra.Command {
 		Long:  ``,
 		Example: heredoc.Doc(`
 			$ glab incident list
+			$ glab incident create --title "Checkout is down" --severity critical
+			$ glab incident update 42 --status acknowledged
+			$ glab incident timeline add 42 --note "Rolled back to v2.3.1." --tag "Impact mitigated"
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
@@ -35,6 +41,9 @@ func NewCmdIncident(f cmdutils.Factory) *cobra.Command {
 	cmdutils.EnableRepoOverride(incidentCmd, f)
 
 	incidentCmd.AddCommand(incidentListCmd.NewCmdList(f, nil))
+	incidentCmd.AddCommand(incidentCreateCmd.NewCmdCreate(f))
+	incidentCmd.AddCommand(incidentUpdateCmd.NewCmdUpdate(f))
+	incidentCmd.AddCommand(incidentTimelineCmd.NewCmdTimeline(f))
 	incidentCmd.AddCommand(incidentNoteCmd.NewCmdNote(f))
 	incidentCmd.AddCommand(incidentViewCmd.NewCmdView(f))
 	incidentCmd.AddCommand(incidentCloseCmd.NewCmdClose(f))
@@ -0,0 +1,233 @@
+package incidentutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Severities are the severities of incidents, from the most to the least severe.
+var Severities = []string{"critical", "high", "medium", "low", "unknown"}
+
+// Statuses are the escalation statuses of incidents.
+var Statuses = []string{"triggered", "acknowledged", "resolved", "ignored"}
+
+// TimelineTags are the tags of timeline events that GitLab predefines.
+var TimelineTags = []string{"Start time", "End time", "Impact detected", "Response initiated", "Impact mitigated", "Cause identified"}
+
+const policiesQuery = `query($fullPath: ID!, $name: String) {
+  project(fullPath: $fullPath) {
+    incidentManagementEscalationPolicies(name: $name) { nodes { id name } }
+  }
+}`
+
+// ValidateEnum returns a flag error if value is not one of values.
+func ValidateEnum(flag, value string, values []string) error {
+	if !slices.Contains(values, value) {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid %s %q. Use one of: %s.", flag, value, strings.Join(values, ", "))}
+	}
+	return nil
+}
+
+// IncidentFromArg returns the incident of an argument, which is an IID or a
+// URL. Issues of other types are not incidents, and return an error.
+func IncidentFromArg(f cmdutils.Factory, client *gitlab.Client, arg string) (*gitlab.Issue, glrepo.Interface, error) {
+	issue, repo, err := issueutils.IssueFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issue.IssueType == nil || *issue.IssueType != string(issuable.TypeIncident) {
+		return nil, nil, fmt.Errorf("#%d is an issue, not an incident.", issue.IID)
+	}
+	return issue, repo, nil
+}
+
+// IssueGID returns the global ID of an issue, used by the GraphQL API.
+func IssueGID(issue *gitlab.Issue) string {
+	return fmt.Sprintf("gid://gitlab/Issue/%d", issue.ID)
+}
+
+// mutate runs a mutation that takes a single input, and returns the errors
+// of its payload as an error.
+func mutate(client *gitlab.Client, name, inputType string, input map[string]any) error {
+	query := fmt.Sprintf(`mutation($input: %s!) {
+  %s(input: $input) { errors }
+}`, inputType, name)
+
+	var data map[string]struct {
+		Errors []string `json:"errors"`
+	}
+	if err := api.GraphQL(client, query, map[string]any{"input": input}, &data); err != nil {
+		return err
+	}
+	if errs := data[name].Errors; len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func issueInput(repo glrepo.Interface, issue *gitlab.Issue) map[string]any {
+	return map[string]any{
+		"projectPath": repo.FullName(),
+		"iid":         strconv.FormatInt(issue.IID, 10),
+	}
+}
+
+// SetSeverity sets the severity of an incident.
+func SetSeverity(client *gitlab.Client, repo glrepo.Interface, issue *gitlab.Issue, severity string) error {
+	input := issueInput(repo, issue)
+	input["severity"] = strings.ToUpper(severity)
+	if err := mutate(client, "issueSetSeverity", "IssueSetSeverityInput", input); err != nil {
+		return fmt.Errorf("failed to set the severity: %w", err)
+	}
+	return nil
+}
+
+// SetStatus sets the escalation status of an incident.
+func SetStatus(client *gitlab.Client, repo glrepo.Interface, issue *gitlab.Issue, status string) error {
+	input := issueInput(repo, issue)
+	input["status"] = strings.ToUpper(status)
+	if err := mutate(client, "issueSetEscalationStatus", "IssueSetEscalationStatusInput", input); err != nil {
+		return fmt.Errorf("failed to set the status: %w", err)
+	}
+	return nil
+}
+
+// SetEscalationPolicy pages the escalation policy of the project with the
+// given name for an incident. An empty name removes the escalation policy.
+func SetEscalationPolicy(client *gitlab.Client, repo glrepo.Interface, issue *gitlab.Issue, name string) error {
+	var policyID any
+	if name != "" {
+		id, err := escalationPolicyID(client, repo, name)
+		if err != nil {
+			return err
+		}
+		policyID = id
+	}
+
+	input := issueInput(repo, issue)
+	input["escalationPolicyId"] = policyID
+	if err := mutate(client, "issueSetEscalationPolicy", "IssueSetEscalationPolicyInput", input); err != nil {
+		return fmt.Errorf("failed to set the escalation policy: %w", err)
+	}
+	return nil
+}
+
+func escalationPolicyID(client *gitlab.Client, repo glrepo.Interface, name string) (string, error) {
+	var data struct {
+		Project *struct {
+			Policies struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"incidentManagementEscalationPolicies"`
+		} `json:"project"`
+	}
+	if err := api.GraphQL(client, policiesQuery, map[string]any{"fullPath": repo.FullName(), "name": name}, &data); err != nil {
+		return "", fmt.Errorf("failed to get the escalation policies: %w", err)
+	}
+	if data.Project == nil {
+		return "", fmt.Errorf("project %s not found.", repo.FullName())
+	}
+	for _, policy := range data.Project.Policies.Nodes {
+		if strings.EqualFold(policy.Name, name) {
+			return policy.ID, nil
+		}
+	}
+	return "", fmt.Errorf("escalation policy %q not found in %s.", name, repo.FullName())
+}
+
+// TimelineEvent is an event of the timeline of an incident.
+type TimelineEvent struct {
+	ID         string    `json:"id"`
+	Note       string    `json:"note"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Action     string    `json:"action"`
+	Author     *struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	Tags struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"timelineEventTags"`
+}
+
+// TagNames returns the names of the tags of the event.
+func (e *TimelineEvent) TagNames() []string {
+	names := make([]string, 0, len(e.Tags.Nodes))
+	for _, tag := range e.Tags.Nodes {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+const timelineEventFields = `id note occurredAt action author { username } timelineEventTags { nodes { name } }`
+
+// AddTimelineEvent adds an event to the timeline of an incident.
+func AddTimelineEvent(client *gitlab.Client, issue *gitlab.Issue, note string, occurredAt time.Time, tags []string) (*TimelineEvent, error) {
+	input := map[string]any{
+		"incidentId": IssueGID(issue),
+		"note":       note,
+		"occurredAt": occurredAt.UTC().Format(time.RFC3339),
+	}
+	if len(tags) > 0 {
+		input["timelineEventTagNames"] = tags
+	}
+
+	query := `mutation($input: TimelineEventCreateInput!) {
+  timelineEventCreate(input: $input) { timelineEvent { ` + timelineEventFields + ` } errors }
+}`
+	var data struct {
+		TimelineEventCreate struct {
+			TimelineEvent *TimelineEvent `json:"timelineEvent"`
+			Errors        []string       `json:"errors"`
+		} `json:"timelineEventCreate"`
+	}
+	if err := api.GraphQL(client, query, map[string]any{"input": input}, &data); err != nil {
+		return nil, fmt.Errorf("failed to add the timeline event: %w", err)
+	}
+	if errs := data.TimelineEventCreate.Errors; len(errs) > 0 {
+		return nil, fmt.Errorf("failed to add the timeline event: %s", strings.Join(errs, "; "))
+	}
+	return data.TimelineEventCreate.TimelineEvent, nil
+}
+
+// TimelineEvents returns the events of the timeline of an incident, in the
+// order they occurred.
+func TimelineEvents(client *gitlab.Client, repo glrepo.Interface, issue *gitlab.Issue) ([]*TimelineEvent, error) {
+	query := `query($fullPath: ID!, $incidentId: IssueID!) {
+  project(fullPath: $fullPath) {
+    incidentManagementTimelineEvents(incidentId: $incidentId) { nodes { ` + timelineEventFields + ` } }
+  }
+}`
+	var data struct {
+		Project *struct {
+			Events struct {
+				Nodes []*TimelineEvent `json:"nodes"`
+			} `json:"incidentManagementTimelineEvents"`
+		} `json:"project"`
+	}
+	if err := api.GraphQL(client, query, map[string]any{"fullPath": repo.FullName(), "incidentId": IssueGID(issue)}, &data); err != nil {
+		return nil, fmt.Errorf("failed to get the timeline: %w", err)
+	}
+	if data.Project == nil {
+		return nil, fmt.Errorf("project %s not found.", repo.FullName())
+	}
+
+	events := data.Project.Events.Nodes
+	slices.SortStableFunc(events, func(a, b *TimelineEvent) int { return a.OccurredAt.Compare(b.OccurredAt) })
+	return events, nil
+}
@@ -0,0 +1,114 @@
+package add
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// timeLayouts are the layouts accepted by --occurred-at. Times without a
+// time zone are in the local time zone.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02 15:04", "15:04"}
+
+// now returns the current time. It's a variable so tests can override it.
+var now = time.Now
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	factory      cmdutils.Factory
+
+	note       string
+	occurredAt string
+	tags       []string
+}
+
+func NewCmdAdd(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		factory:      f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <id> [flags]",
+		Short: `Add an event to the timeline of an incident.`,
+		Example: heredoc.Doc(`
+			# Record that the impact was mitigated now
+			$ glab incident timeline add 42 --note "Rolled back to v2.3.1." --tag "Impact mitigated"
+
+			# Record an event that happened earlier today
+			$ glab incident timeline add 42 --note "Error rate alert fired." --occurred-at 14:05 --tag "Impact detected"
+
+			# Record an event at a precise time
+			$ glab incident timeline add 42 --note "Deploy of v2.4.0 started." --occurred-at 2026-10-19T13:58:00Z
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.note, "note", "n", "", "Description of the event. Supports Markdown.")
+	cmd.Flags().StringVar(&opts.occurredAt, "occurred-at", "", "When the event occurred, as RFC 3339, 'YYYY-MM-DD HH:MM', or 'HH:MM' for today. Defaults to now.")
+	cmd.Flags().StringSliceVar(&opts.tags, "tag", []string{}, "Tag the event. Multiple tags are comma-separated. Predefined tags: 'Start time', 'End time', 'Impact detected', 'Response initiated', 'Impact mitigated', 'Cause identified'.")
+	_ = cmd.MarkFlagRequired("note")
+
+	return cmd
+}
+
+func (o *options) run(arg string) error {
+	occurredAt := now()
+	if o.occurredAt != "" {
+		var err error
+		if occurredAt, err = parseTime(o.occurredAt, occurredAt); err != nil {
+			return err
+		}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, _, err := incidentutils.IncidentFromArg(o.factory, client, arg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := incidentutils.AddTimelineEvent(client, issue, o.note, occurredAt, o.tags); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Added an event at %s to the timeline of incident #%d.\n",
+		o.io.Color().GreenCheck(), occurredAt.Format("2006-01-02 15:04 MST"), issue.IID)
+	return nil
+}
+
+// parseTime parses the value of --occurred-at. Times of day are on the day
+// of ref.
+func parseTime(value string, ref time.Time) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, value, ref.Location())
+		if err != nil {
+			continue
+		}
+		if layout == "15:04" {
+			t = time.Date(ref.Year(), ref.Month(), ref.Day(), t.Hour(), t.Minute(), 0, 0, ref.Location())
+		}
+		return t, nil
+	}
+	return time.Time{}, &cmdutils.FlagError{Err: fmt.Errorf("invalid time %q for '--occurred-at'. Use RFC 3339, 'YYYY-MM-DD HH:MM', or 'HH:MM'.", value)}
+}
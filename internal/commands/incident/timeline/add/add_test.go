@@ -0,0 +1,76 @@
+//go:build !integration
+
+package add
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdAdd(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTimelineAdd(t *testing.T) {
+	oldNow := now
+	t.Cleanup(func() { now = oldNow })
+	now = func() time.Time { return time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC) }
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "incident"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Contains(t, string(body), "timelineEventCreate(input: $input)")
+			assert.Contains(t, string(body), `"input":{"incidentId":"gid://gitlab/Issue/420","note":"Error rate alert fired.","occurredAt":"2026-10-19T14:05:00Z","timelineEventTagNames":["Impact detected"]}`)
+			return httpmock.NewStringResponse(http.StatusOK, `{"data": {"timelineEventCreate": {"errors": [], "timelineEvent": {"id": "gid://gitlab/IncidentManagement::TimelineEvent/1"}}}}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, `42 --note "Error rate alert fired." --occurred-at 14:05 --tag "Impact detected"`)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Added an event at 2026-10-19 14:05 UTC to the timeline of incident #42.\n", output.String())
+}
+
+func Test_parseTime(t *testing.T) {
+	ref := time.Date(2026, 10, 19, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2026-10-18T22:00:00+02:00", time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)},
+		{"2026-10-18T09:15", time.Date(2026, 10, 18, 9, 15, 0, 0, time.UTC)},
+		{"2026-10-18 09:15", time.Date(2026, 10, 18, 9, 15, 0, 0, time.UTC)},
+		{"09:15", time.Date(2026, 10, 19, 9, 15, 0, 0, time.UTC)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := parseTime(tc.value, ref)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got), "got %s", got)
+		})
+	}
+
+	_, err := parseTime("yesterday", ref)
+	assert.EqualError(t, err, `invalid time "yesterday" for '--occurred-at'. Use RFC 3339, 'YYYY-MM-DD HH:MM', or 'HH:MM'.`)
+}
@@ -0,0 +1,98 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	factory      cmdutils.Factory
+
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		factory:      f,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list <id> [flags]",
+		Short:   `List the timeline events of an incident.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab incident timeline list 42
+			$ glab incident timeline list 42 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run(args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run(arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := incidentutils.IncidentFromArg(o.factory, client, arg)
+	if err != nil {
+		return err
+	}
+
+	events, err := incidentutils.TimelineEvents(client, repo, issue)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(events)
+	}
+
+	if len(events) == 0 {
+		fmt.Fprintf(o.io.StdErr, "Incident #%d has no timeline events.\n", issue.IID)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("Occurred at", "Note", "Tags", "Author")
+	for _, event := range events {
+		author := ""
+		if event.Author != nil {
+			author = "@" + event.Author.Username
+		}
+		table.AddRow(event.OccurredAt.Local().Format("2006-01-02 15:04"), event.Note, strings.Join(event.TagNames(), ", "), c.Gray(author))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const timelineJSON = `{"data": {"project": {"incidentManagementTimelineEvents": {"nodes": [
+	{"id": "2", "note": "Rolled back to v2.3.1.", "occurredAt": "2026-10-19T14:30:00Z", "action": "comment",
+	 "author": {"username": "bob"}, "timelineEventTags": {"nodes": [{"name": "Impact mitigated"}]}},
+	{"id": "1", "note": "Error rate alert fired.", "occurredAt": "2026-10-19T14:05:00Z", "action": "comment",
+	 "author": {"username": "alice"}, "timelineEventTags": {"nodes": [{"name": "Start time"}, {"name": "Impact detected"}]}}
+]}}}}`
+
+func TestTimelineList(t *testing.T) {
+	t.Setenv("TZ", "UTC")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "incident"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", httpmock.NewStringResponse(http.StatusOK, timelineJSON))
+
+	output, err := runCommand(t, fakeHTTP, `42`)
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "Error rate alert fired.")
+	assert.Contains(t, out, "Start time, Impact detected")
+	assert.Less(t, strings.Index(out, "Error rate alert fired."), strings.Index(out, "Rolled back to v2.3.1."), "events must be in the order they occurred")
+}
+
+func TestTimelineList_json(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "incident"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", httpmock.NewStringResponse(http.StatusOK, timelineJSON))
+
+	output, err := runCommand(t, fakeHTTP, `42 --output json`)
+	require.NoError(t, err)
+
+	var events []struct {
+		ID string `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, "1", events[0].ID)
+}
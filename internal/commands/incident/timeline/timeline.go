@@ -0,0 +1,23 @@
+package timeline
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	timelineAddCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline/add"
+	timelineListCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline/list"
+)
+
+func NewCmdTimeline(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeline <command> [flags]",
+		Short: `Work with the timeline events of incidents.`,
+		Long: `Timeline events record what happened during an incident, and when. They
+help responders catch up, and are the basis of the post-incident review.
+`,
+	}
+
+	cmd.AddCommand(timelineAddCmd.NewCmdAdd(f))
+	cmd.AddCommand(timelineListCmd.NewCmdList(f))
+	return cmd
+}
@@ -0,0 +1,121 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	factory      cmdutils.Factory
+
+	severity         string
+	status           string
+	escalationPolicy string
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		factory:      f,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update <id> [flags]",
+		Short: `Update the severity, status, or escalation policy of an incident.`,
+		Long: heredoc.Doc(`
+			Update the severity, status, or escalation policy of an incident.
+
+			Setting an escalation policy pages its on-call responders, until the
+			incident is acknowledged or resolved. Escalation policies require
+			GitLab Premium.
+		`),
+		Example: heredoc.Doc(`
+			# Raise the severity of incident 42
+			$ glab incident update 42 --severity critical
+
+			# Acknowledge incident 42
+			$ glab incident update 42 --status acknowledged
+
+			# Page the on-call responders of the "Primary on-call" escalation policy
+			$ glab incident update 42 --escalation-policy "Primary on-call"
+
+			# Resolve an incident, by URL
+			$ glab incident update https://gitlab.com/OWNER/REPO/-/issues/incident/42 --status resolved
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("severity") && !cmd.Flags().Changed("status") && !cmd.Flags().Changed("escalation-policy") {
+				return &cmdutils.FlagError{Err: fmt.Errorf("specify at least one of '--severity', '--status', or '--escalation-policy'.")}
+			}
+			if cmd.Flags().Changed("severity") {
+				if err := incidentutils.ValidateEnum("severity", opts.severity, incidentutils.Severities); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("status") {
+				if err := incidentutils.ValidateEnum("status", opts.status, incidentutils.Statuses); err != nil {
+					return err
+				}
+			}
+			return opts.run(cmd, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.severity, "severity", "", "Severity of the incident: critical, high, medium, low, unknown.")
+	cmd.Flags().StringVar(&opts.status, "status", "", "Escalation status of the incident: triggered, acknowledged, resolved, ignored.")
+	cmd.Flags().StringVar(&opts.escalationPolicy, "escalation-policy", "", "Name of the escalation policy to page. Use \"\" to remove the escalation policy.")
+
+	return cmd
+}
+
+func (o *options) run(cmd *cobra.Command, arg string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	issue, repo, err := incidentutils.IncidentFromArg(o.factory, client, arg)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	if cmd.Flags().Changed("severity") {
+		if err := incidentutils.SetSeverity(client, repo, issue, o.severity); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Set the severity of incident #%d to %s.\n", c.GreenCheck(), issue.IID, o.severity)
+	}
+	if cmd.Flags().Changed("status") {
+		if err := incidentutils.SetStatus(client, repo, issue, o.status); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Set the status of incident #%d to %s.\n", c.GreenCheck(), issue.IID, o.status)
+	}
+	if cmd.Flags().Changed("escalation-policy") {
+		if err := incidentutils.SetEscalationPolicy(client, repo, issue, o.escalationPolicy); err != nil {
+			return err
+		}
+		if o.escalationPolicy == "" {
+			fmt.Fprintf(o.io.StdOut, "%s Removed the escalation policy of incident #%d.\n", c.GreenCheck(), issue.IID)
+		} else {
+			fmt.Fprintf(o.io.StdOut, "%s Paged the escalation policy %q for incident #%d.\n", c.GreenCheck(), o.escalationPolicy, issue.IID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+//go:build !integration
+
+package update
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdUpdate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+type graphQLCall struct {
+	// query is a part of the expected query.
+	query string
+	// variables are the expected variables, as JSON. Not checked if empty.
+	variables string
+	response  string
+}
+
+// graphQLResponder returns a responder that answers GraphQL requests with
+// the calls, in order.
+func graphQLResponder(t *testing.T, calls ...graphQLCall) httpmock.Responder {
+	t.Helper()
+
+	n := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, n, len(calls), "unexpected GraphQL request")
+		call := calls[n]
+		n++
+
+		var body struct {
+			Query     string          `json:"query"`
+			Variables json.RawMessage `json:"variables"`
+		}
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body.Query, call.query)
+		if call.variables != "" {
+			assert.JSONEq(t, call.variables, string(body.Variables))
+		}
+		return httpmock.NewStringResponse(http.StatusOK, call.response)(req)
+	}
+}
+
+func TestIncidentUpdate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "incident"}`))
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql", graphQLResponder(t,
+		graphQLCall{
+			query:     "issueSetSeverity(input: $input)",
+			variables: `{"input": {"projectPath": "OWNER/REPO", "iid": "42", "severity": "HIGH"}}`,
+			response:  `{"data": {"issueSetSeverity": {"errors": []}}}`,
+		},
+		graphQLCall{
+			query:     "issueSetEscalationStatus(input: $input)",
+			variables: `{"input": {"projectPath": "OWNER/REPO", "iid": "42", "status": "ACKNOWLEDGED"}}`,
+			response:  `{"data": {"issueSetEscalationStatus": {"errors": []}}}`,
+		},
+		graphQLCall{
+			query:     "incidentManagementEscalationPolicies(name: $name)",
+			variables: `{"fullPath": "OWNER/REPO", "name": "primary on-call"}`,
+			response: `{"data": {"project": {"incidentManagementEscalationPolicies": {"nodes": [
+				{"id": "gid://gitlab/IncidentManagement::EscalationPolicy/7", "name": "Primary on-call"}
+			]}}}}`,
+		},
+		graphQLCall{
+			query:     "issueSetEscalationPolicy(input: $input)",
+			variables: `{"input": {"projectPath": "OWNER/REPO", "iid": "42", "escalationPolicyId": "gid://gitlab/IncidentManagement::EscalationPolicy/7"}}`,
+			response:  `{"data": {"issueSetEscalationPolicy": {"errors": []}}}`,
+		},
+	))
+
+	output, err := runCommand(t, fakeHTTP, `42 --severity high --status acknowledged --escalation-policy "primary on-call"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `✓ Set the severity of incident #42 to high.
+✓ Set the status of incident #42 to acknowledged.
+✓ Paged the escalation policy "primary on-call" for incident #42.
+`, output.String())
+}
+
+func TestIncidentUpdate_errors(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "incident"}`))
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql", graphQLResponder(t,
+		graphQLCall{
+			response: `{"data": {"issueSetEscalationStatus": {"errors": ["Escalation status is not supported."]}}}`,
+		},
+	))
+
+	_, err := runCommand(t, fakeHTTP, `42 --status resolved`)
+	assert.EqualError(t, err, "failed to set the status: Escalation status is not supported.")
+}
+
+func TestIncidentUpdate_notAnIncident(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 420, "iid": 42, "issue_type": "issue"}`))
+
+	_, err := runCommand(t, fakeHTTP, `42 --severity low`)
+	assert.EqualError(t, err, "#42 is an issue, not an incident.")
+}
+
+func TestIncidentUpdate_flags(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `42`)
+	assert.EqualError(t, err, "specify at least one of '--severity', '--status', or '--escalation-policy'.")
+
+	_, err = runCommand(t, &httpmock.Mocker{}, `42 --status closed`)
+	assert.EqualError(t, err, `invalid status "closed". Use one of: triggered, acknowledged, resolved, ignored.`)
+}
@@ -0,0 +1,62 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdAdd(f cmdutils.Factory, kind timeutils.Kind) *cobra.Command {
+	var summary string
+
+	args := cobra.ExactArgs(2)
+	if kind == timeutils.KindMR {
+		args = cobra.RangeArgs(1, 2)
+	}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("add %s <duration> [flags]", kind.Arg()),
+		Short: fmt.Sprintf(`Log time spent on %s.`, kind.WithArticle()),
+		Long: heredoc.Doc(`
+			Log time spent. The duration uses the GitLab time tracking format, like 1h30m
+			or 1w 2d. A negative duration, like -30m, subtracts time.
+		`),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			$ glab %[1]s time add 123 1h30m
+			$ glab %[1]s time add 123 2h --summary "Code review"
+		`, kind)),
+		Args: args,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			duration := args[len(args)-1]
+			target, err := timeutils.Resolve(f, kind, args[:len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			stats, err := target.AddSpentTime(client, duration, summary)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Added %s to %s: %s.\n", f.IO().Color().GreenCheck(), duration, target, timeutils.Summary(stats))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&summary, "summary", "s", "", "Summary of the time spent.")
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+//go:build !integration
+
+package add
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, kind timeutils.Kind, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdAdd(factory, kind)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTimeAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    timeutils.Kind
+		cli     string
+		getPath string
+		getBody string
+		addPath string
+		wantOut string
+	}{
+		{
+			name:    "issue",
+			kind:    timeutils.KindIssue,
+			cli:     `12 1h30m --summary "Code review"`,
+			getPath: "/api/v4/projects/OWNER/REPO/issues/12",
+			getBody: `{"id": 1, "iid": 12}`,
+			addPath: "/api/v4/projects/OWNER/REPO/issues/12/add_spent_time",
+			wantOut: "✓ Added 1h30m to issue #12: 3h spent of 1d estimated.\n",
+		},
+		{
+			name:    "merge request",
+			kind:    timeutils.KindMR,
+			cli:     `!3 1h30m --summary "Code review"`,
+			getPath: "/api/v4/projects/OWNER/REPO/merge_requests/3",
+			getBody: `{"id": 1, "iid": 3}`,
+			addPath: "/api/v4/projects/OWNER/REPO/merge_requests/3/add_spent_time",
+			wantOut: "✓ Added 1h30m to merge request !3: 3h spent of 1d estimated.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			fakeHTTP.RegisterResponder(http.MethodGet, tc.getPath, httpmock.NewStringResponse(http.StatusOK, tc.getBody))
+			fakeHTTP.RegisterResponder(http.MethodPost, tc.addPath,
+				func(req *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, `{"duration": "1h30m", "summary": "Code review"}`, string(body))
+					return httpmock.NewStringResponse(http.StatusCreated, `{"human_time_estimate": "1d", "human_total_time_spent": "3h"}`)(req)
+				})
+
+			output, err := runCommand(t, fakeHTTP, tc.kind, tc.cli)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantOut, output.String())
+		})
+	}
+}
+
+func TestTimeAdd_missingDuration(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, timeutils.KindIssue, "12")
+	assert.EqualError(t, err, "accepts 2 arg(s), received 1")
+}
@@ -0,0 +1,57 @@
+package estimate
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdEstimate(f cmdutils.Factory, kind timeutils.Kind) *cobra.Command {
+	args := cobra.ExactArgs(2)
+	if kind == timeutils.KindMR {
+		args = cobra.RangeArgs(1, 2)
+	}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("estimate %s <duration> [flags]", kind.Arg()),
+		Short: fmt.Sprintf(`Set the time estimate of %s.`, kind.WithArticle()),
+		Long: heredoc.Doc(`
+			Set the time estimate. The duration uses the GitLab time tracking format,
+			like 1h30m or 1w 2d. It replaces any previous estimate.
+		`),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			$ glab %[1]s time estimate 123 3d
+		`, kind)),
+		Args: args,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			duration := args[len(args)-1]
+			target, err := timeutils.Resolve(f, kind, args[:len(args)-1])
+			if err != nil {
+				return err
+			}
+
+			stats, err := target.SetTimeEstimate(client, duration)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Set the time estimate of %s: %s.\n", f.IO().Color().GreenCheck(), target, timeutils.Summary(stats))
+			return nil
+		},
+	}
+
+	return cmd
+}
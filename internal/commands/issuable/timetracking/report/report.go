@@ -0,0 +1,428 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// now returns the current time. Tests replace it.
+var now = time.Now
+
+const dateLayout = "2006-01-02"
+
+// noLabel is the label of the time spent on issuables without labels.
+const noLabel = "(no label)"
+
+const timelogsQuery = `query($fullPath: ID!, $startDate: Time, $endDate: Time, $username: String, $full: Boolean, $after: String) {
+  namespace: %s(fullPath: $fullPath) {
+    timelogs(startDate: $startDate, endDate: $endDate, username: $username, first: 100, after: $after) {
+      nodes {
+        spentAt
+        timeSpent
+        summary
+        user { username }
+        issue { iid title reference(full: $full) labels { nodes { title } } }
+        mergeRequest { iid title reference(full: $full) labels { nodes { title } } }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+type issuable struct {
+	IID       string `json:"iid"`
+	Title     string `json:"title"`
+	Reference string `json:"reference"`
+	Labels    struct {
+		Nodes []struct {
+			Title string `json:"title"`
+		} `json:"nodes"`
+	} `json:"labels"`
+}
+
+type timelog struct {
+	SpentAt   time.Time `json:"spentAt"`
+	TimeSpent int64     `json:"timeSpent"`
+	Summary   string    `json:"summary"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Issue        *issuable `json:"issue"`
+	MergeRequest *issuable `json:"mergeRequest"`
+}
+
+// Row is a line of the report: the time spent by a user, on a label, or of
+// a single timelog entry.
+type Row struct {
+	Date      string   `json:"date,omitempty"`
+	User      string   `json:"user,omitempty"`
+	Label     string   `json:"label,omitempty"`
+	Reference string   `json:"reference,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Summary   string   `json:"summary,omitempty"`
+	Entries   int      `json:"entries,omitempty"`
+	Seconds   int64    `json:"seconds"`
+}
+
+type options struct {
+	io       *iostreams.IOStreams
+	factory  cmdutils.Factory
+	kind     timeutils.Kind
+	baseRepo func() (glrepo.Interface, error)
+
+	args         []string
+	group        string
+	user         string
+	since        string
+	until        string
+	by           string
+	outputFormat string
+
+	start, end time.Time
+}
+
+func NewCmdReport(f cmdutils.Factory, kind timeutils.Kind) *cobra.Command {
+	opts := &options{
+		io:       f.IO(),
+		factory:  f,
+		kind:     kind,
+		baseRepo: f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report [<id>] [flags]",
+		Short: fmt.Sprintf(`Report the time spent on %ss, per user or per label.`, kind.Noun()),
+		Long: heredoc.Docf(`
+			Report the time logged on the %[2]ss of a project or group over a date range,
+			using the timelogs API.
+
+			By default, the report covers the current month and sums the time spent per
+			user. With %[1]s--by label%[1]s, it sums the time per label of the %[2]ss: time
+			spent on %[3]s with several labels counts for each label. With %[1]s--by entry%[1]s,
+			it lists every timelog.
+
+			Use %[1]s--output csv%[1]s to export the report, for example for billing.
+		`, "`", kind.Noun(), kind.WithArticle()),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			# Time spent per user this month
+			$ glab %[1]s time report
+
+			# Time spent per label in a group in January, as CSV
+			$ glab %[1]s time report --group mygroup --by label --since 2025-01-01 --until 2025-01-31 --output csv > january.csv
+
+			# Every timelog of a user on one %[2]s
+			$ glab %[1]s time report 123 --by entry --user alice
+		`, kind, kind.Noun())),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.args = args
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Report the time spent in a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.user, "user", "u", "", "Only report the time spent by a user.")
+	cmd.Flags().StringVar(&opts.since, "since", "", "First day of the report, in the YYYY-MM-DD format. Defaults to the first day of the current month.")
+	cmd.Flags().StringVar(&opts.until, "until", "", "Last day of the report, in the YYYY-MM-DD format. Defaults to today.")
+	cmd.Flags().StringVar(&opts.by, "by", "user", "Sum the time spent by: user, label, entry.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, csv, json.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	switch o.by {
+	case "user", "label", "entry":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid --by %q. Use one of: user, label, entry.", o.by)}
+	}
+	switch o.outputFormat {
+	case "text", "csv", "json":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, csv, json.", o.outputFormat)}
+	}
+	if len(o.args) > 0 && o.group != "" {
+		return cmdutils.FlagError{Err: fmt.Errorf("--group cannot be used with %s.", o.kind.WithArticle())}
+	}
+
+	t := now()
+	o.start = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	o.end = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	var err error
+	if o.since != "" {
+		if o.start, err = time.Parse(dateLayout, o.since); err != nil {
+			return cmdutils.FlagError{Err: fmt.Errorf("invalid --since %q. Use the YYYY-MM-DD format.", o.since)}
+		}
+	}
+	if o.until != "" {
+		if o.end, err = time.Parse(dateLayout, o.until); err != nil {
+			return cmdutils.FlagError{Err: fmt.Errorf("invalid --until %q. Use the YYYY-MM-DD format.", o.until)}
+		}
+	}
+	if o.end.Before(o.start) {
+		return cmdutils.FlagError{Err: errors.New("--until must not be before --since.")}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.factory.GitLabClient()
+	if err != nil {
+		return err
+	}
+
+	field, fullPath := "group", o.group
+	var target *timeutils.Target
+	if o.group == "" {
+		field = "project"
+		if len(o.args) > 0 {
+			if target, err = timeutils.Resolve(o.factory, o.kind, o.args); err != nil {
+				return err
+			}
+			fullPath = target.Repo.FullName()
+		} else {
+			repo, err := o.baseRepo()
+			if err != nil {
+				return err
+			}
+			fullPath = repo.FullName()
+		}
+	}
+
+	timelogs, err := o.fetchTimelogs(client, field, fullPath)
+	if err != nil {
+		return err
+	}
+
+	var entries []*timelog
+	for _, t := range timelogs {
+		item := o.item(t)
+		if item == nil || (target != nil && item.IID != fmt.Sprint(target.IID)) {
+			continue
+		}
+		entries = append(entries, t)
+	}
+
+	rows := o.rows(entries)
+
+	switch o.outputFormat {
+	case "json":
+		return json.NewEncoder(o.io.StdOut).Encode(rows)
+	case "csv":
+		return o.writeCSV(rows)
+	}
+
+	scope := fmt.Sprintf("%ss of %s", o.kind.Noun(), fullPath)
+	if target != nil {
+		scope = fmt.Sprintf("%s in %s", target, fullPath)
+	}
+	o.printText(rows, scope)
+	return nil
+}
+
+func (o *options) fetchTimelogs(client *gitlab.Client, field, fullPath string) ([]*timelog, error) {
+	variables := map[string]any{
+		"fullPath":  fullPath,
+		"startDate": o.start.Format(time.RFC3339),
+		"endDate":   o.end.Add(24*time.Hour - time.Second).Format(time.RFC3339),
+		"full":      field == "group",
+	}
+	if o.user != "" {
+		variables["username"] = o.user
+	}
+
+	var timelogs []*timelog
+	for {
+		var data struct {
+			Namespace *struct {
+				Timelogs struct {
+					Nodes    []*timelog `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"timelogs"`
+			} `json:"namespace"`
+		}
+		if err := api.GraphQL(client, fmt.Sprintf(timelogsQuery, field), variables, &data); err != nil {
+			return nil, fmt.Errorf("failed to get the timelogs: %w", err)
+		}
+		if data.Namespace == nil {
+			return nil, fmt.Errorf("%s %s not found.", field, fullPath)
+		}
+
+		timelogs = append(timelogs, data.Namespace.Timelogs.Nodes...)
+		if !data.Namespace.Timelogs.PageInfo.HasNextPage {
+			return timelogs, nil
+		}
+		variables["after"] = data.Namespace.Timelogs.PageInfo.EndCursor
+	}
+}
+
+func (o *options) item(t *timelog) *issuable {
+	if o.kind == timeutils.KindMR {
+		return t.MergeRequest
+	}
+	return t.Issue
+}
+
+// rows sums the timelogs by user or label, sorted by time spent, or lists
+// them by date for entries.
+func (o *options) rows(timelogs []*timelog) []*Row {
+	var rows []*Row
+
+	if o.by == "entry" {
+		for _, t := range timelogs {
+			item := o.item(t)
+			labels := []string{}
+			for _, l := range item.Labels.Nodes {
+				labels = append(labels, l.Title)
+			}
+			rows = append(rows, &Row{
+				Date:      t.SpentAt.UTC().Format(dateLayout),
+				User:      t.User.Username,
+				Reference: item.Reference,
+				Title:     item.Title,
+				Labels:    labels,
+				Summary:   t.Summary,
+				Seconds:   t.TimeSpent,
+			})
+		}
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].Date < rows[j].Date })
+		return rows
+	}
+
+	byKey := map[string]*Row{}
+	add := func(key string, t *timelog) {
+		row, ok := byKey[key]
+		if !ok {
+			row = &Row{}
+			if o.by == "user" {
+				row.User = key
+			} else {
+				row.Label = key
+			}
+			byKey[key] = row
+			rows = append(rows, row)
+		}
+		row.Entries++
+		row.Seconds += t.TimeSpent
+	}
+
+	for _, t := range timelogs {
+		if o.by == "user" {
+			add(t.User.Username, t)
+			continue
+		}
+		labels := o.item(t).Labels.Nodes
+		if len(labels) == 0 {
+			add(noLabel, t)
+		}
+		for _, l := range labels {
+			add(l.Title, t)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Seconds != rows[j].Seconds {
+			return rows[i].Seconds > rows[j].Seconds
+		}
+		return rows[i].User+rows[i].Label < rows[j].User+rows[j].Label
+	})
+	return rows
+}
+
+func hours(seconds int64) string {
+	return fmt.Sprintf("%.2f", float64(seconds)/3600)
+}
+
+func (o *options) writeCSV(rows []*Row) error {
+	w := csv.NewWriter(o.io.StdOut)
+
+	switch o.by {
+	case "entry":
+		_ = w.Write([]string{"date", "user", "reference", "title", "labels", "summary", "seconds", "hours"})
+		for _, r := range rows {
+			_ = w.Write([]string{r.Date, r.User, r.Reference, r.Title, strings.Join(r.Labels, ";"), r.Summary, fmt.Sprint(r.Seconds), hours(r.Seconds)})
+		}
+	default:
+		_ = w.Write([]string{o.by, "entries", "seconds", "hours"})
+		for _, r := range rows {
+			_ = w.Write([]string{r.User + r.Label, fmt.Sprint(r.Entries), fmt.Sprint(r.Seconds), hours(r.Seconds)})
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func (o *options) printText(rows []*Row, scope string) {
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Time spent on %s from %s to %s\n\n",
+		scope, o.start.Format(dateLayout), o.end.Format(dateLayout))
+
+	if len(rows) == 0 {
+		fmt.Fprintln(o.io.StdOut, "No time was logged.")
+		return
+	}
+
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+
+	var total int64
+	switch o.by {
+	case "entry":
+		table.AddRow("Date", "User", "Reference", "Title", "Time spent", "Summary")
+		for _, r := range rows {
+			total += r.Seconds
+			table.AddRow(r.Date, "@"+r.User, r.Reference, r.Title, utils.FormatTimeTracking(r.Seconds), r.Summary)
+		}
+	default:
+		header := "User"
+		if o.by == "label" {
+			header = "Label"
+		}
+		table.AddRow(header, "Entries", "Time spent", "Hours")
+		for _, r := range rows {
+			name := r.Label
+			if o.by == "user" {
+				name = "@" + r.User
+				total += r.Seconds
+			}
+			table.AddRow(name, r.Entries, utils.FormatTimeTracking(r.Seconds), hours(r.Seconds))
+		}
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+
+	if o.by == "label" {
+		fmt.Fprintln(o.io.StdOut, c.Gray(fmt.Sprintf("\nTime spent on %s with several labels counts for each label.", o.kind.WithArticle())))
+		return
+	}
+	fmt.Fprintf(o.io.StdOut, "\nTotal: %s (%s hours)\n", utils.FormatTimeTracking(total), hours(total))
+}
@@ -0,0 +1,229 @@
+//go:build !integration
+
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, kind timeutils.Kind, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdReport(factory, kind)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func setNow(t *testing.T, date string) {
+	t.Helper()
+
+	old := now
+	t.Cleanup(func() { now = old })
+	parsed, err := time.Parse(time.RFC3339, date)
+	require.NoError(t, err)
+	now = func() time.Time { return parsed }
+}
+
+const firstPage = `{"data": {"namespace": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2025-01-06T10:00:00Z", "timeSpent": 7200, "summary": "Design", "user": {"username": "alice"},
+		 "issue": {"iid": "12", "title": "Login page", "reference": "#12", "labels": {"nodes": [{"title": "frontend"}, {"title": "customer::acme"}]}}},
+		{"spentAt": "2025-01-07T10:00:00Z", "timeSpent": 1800, "summary": "", "user": {"username": "bob"},
+		 "mergeRequest": {"iid": "3", "title": "Add login page", "reference": "!3", "labels": {"nodes": []}}}
+	],
+	"pageInfo": {"hasNextPage": true, "endCursor": "abc"}
+}}}}`
+
+const secondPage = `{"data": {"namespace": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2025-01-02T10:00:00Z", "timeSpent": 3600, "summary": "Review", "user": {"username": "bob"},
+		 "issue": {"iid": "13", "title": "Signup form", "reference": "#13", "labels": {"nodes": []}}},
+		{"spentAt": "2025-01-08T10:00:00Z", "timeSpent": 5400, "summary": "Fixes", "user": {"username": "alice"},
+		 "issue": {"iid": "12", "title": "Login page", "reference": "#12", "labels": {"nodes": [{"title": "frontend"}]}}}
+	],
+	"pageInfo": {"hasNextPage": false, "endCursor": "def"}
+}}}}`
+
+// timelogsResponder returns the pages of timelogs in order, and checks the
+// variables of each query.
+func timelogsResponder(t *testing.T, wantVariables []map[string]any, pages ...string) httpmock.Responder {
+	t.Helper()
+
+	call := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, call, len(pages), "unexpected GraphQL query")
+
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		var payload struct {
+			Variables map[string]any `json:"variables"`
+		}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		assert.Equal(t, wantVariables[call], payload.Variables)
+
+		page := pages[call]
+		call++
+		return httpmock.NewStringResponse(http.StatusOK, page)(req)
+	}
+}
+
+func TestTimeReport_byUser(t *testing.T) {
+	setNow(t, "2025-01-08T12:00:00Z")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	first := map[string]any{
+		"fullPath":  "OWNER/REPO",
+		"startDate": "2025-01-01T00:00:00Z",
+		"endDate":   "2025-01-08T23:59:59Z",
+		"full":      false,
+	}
+	second := map[string]any{"after": "abc"}
+	for k, v := range first {
+		second[k] = v
+	}
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql",
+		timelogsResponder(t, []map[string]any{first, second}, firstPage, secondPage))
+
+	output, err := runCommand(t, fakeHTTP, timeutils.KindIssue, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Time spent on issues of OWNER/REPO from 2025-01-01 to 2025-01-08
+
+		User	Entries	Time spent	Hours
+		@alice	2	3h 30m	3.50
+		@bob	1	1h	1.00
+
+		Total: 4h 30m (4.50 hours)
+	`), output.String())
+}
+
+func TestTimeReport_byLabelCSV(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql",
+		timelogsResponder(t, []map[string]any{
+			{
+				"fullPath":  "mygroup",
+				"startDate": "2024-12-01T00:00:00Z",
+				"endDate":   "2025-01-31T23:59:59Z",
+				"username":  "alice",
+				"full":      true,
+			},
+		}, secondPage))
+
+	output, err := runCommand(t, fakeHTTP, timeutils.KindIssue,
+		"--group mygroup --user alice --by label --since 2024-12-01 --until 2025-01-31 --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		label,entries,seconds,hours
+		frontend,1,5400,1.50
+		(no label),1,3600,1.00
+	`), output.String())
+}
+
+func TestTimeReport_entriesOfMergeRequest(t *testing.T) {
+	setNow(t, "2025-01-08T12:00:00Z")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/3",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "iid": 3}`))
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql",
+		timelogsResponder(t, []map[string]any{
+			{
+				"fullPath":  "OWNER/REPO",
+				"startDate": "2025-01-01T00:00:00Z",
+				"endDate":   "2025-01-08T23:59:59Z",
+				"full":      false,
+			},
+		}, `{"data": {"namespace": {"timelogs": {
+			"nodes": [
+				{"spentAt": "2025-01-07T10:00:00Z", "timeSpent": 1800, "summary": "Rebase", "user": {"username": "bob"},
+				 "mergeRequest": {"iid": "3", "title": "Add login page", "reference": "!3", "labels": {"nodes": []}}},
+				{"spentAt": "2025-01-06T10:00:00Z", "timeSpent": 3600, "summary": "Review", "user": {"username": "alice"},
+				 "mergeRequest": {"iid": "4", "title": "Other", "reference": "!4", "labels": {"nodes": []}}}
+			],
+			"pageInfo": {"hasNextPage": false, "endCursor": ""}
+		}}}}`))
+
+	output, err := runCommand(t, fakeHTTP, timeutils.KindMR, "3 --by entry --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		date,user,reference,title,labels,summary,seconds,hours
+		2025-01-07,bob,!3,Add login page,,Rebase,1800,0.50
+	`), output.String())
+}
+
+func TestTimeReport_noTimelogs(t *testing.T) {
+	setNow(t, "2025-01-08T12:00:00Z")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql",
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"namespace": {"timelogs": {"nodes": [], "pageInfo": {"hasNextPage": false}}}}}`))
+
+	output, err := runCommand(t, fakeHTTP, timeutils.KindMR, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Time spent on merge requests of OWNER/REPO from 2025-01-01 to 2025-01-08\n\nNo time was logged.\n", output.String())
+}
+
+func TestTimeReport_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "invalid by",
+			cli:     "--by project",
+			wantErr: `invalid --by "project". Use one of: user, label, entry.`,
+		},
+		{
+			name:    "group with an issue",
+			cli:     "12 --group mygroup",
+			wantErr: "--group cannot be used with an issue.",
+		},
+		{
+			name:    "invalid since",
+			cli:     "--since 01/01/2025",
+			wantErr: `invalid --since "01/01/2025". Use the YYYY-MM-DD format.`,
+		},
+		{
+			name:    "until before since",
+			cli:     "--since 2025-02-01 --until 2025-01-31",
+			wantErr: "--until must not be before --since.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runCommand(t, &httpmock.Mocker{}, timeutils.KindIssue, tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
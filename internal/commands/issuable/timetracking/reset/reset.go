@@ -0,0 +1,76 @@
+package reset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdReset(f cmdutils.Factory, kind timeutils.Kind) *cobra.Command {
+	var estimate, spent bool
+
+	args := cobra.ExactArgs(1)
+	if kind == timeutils.KindMR {
+		args = cobra.MaximumNArgs(1)
+	}
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("reset %s [flags]", kind.Arg()),
+		Short: fmt.Sprintf(`Reset the spent time or time estimate of %s.`, kind.WithArticle()),
+		Long: heredoc.Doc(`
+			Reset the time tracking. Without flags, removes all spent time. Use
+			--estimate to remove the time estimate instead, or both flags to remove both.
+		`),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			$ glab %[1]s time reset 123
+			$ glab %[1]s time reset 123 --estimate
+			$ glab %[1]s time reset 123 --estimate --spent
+		`, kind)),
+		Args: args,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !estimate {
+				spent = true
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			target, err := timeutils.Resolve(f, kind, args)
+			if err != nil {
+				return err
+			}
+
+			var stats *gitlab.TimeStats
+			if spent {
+				if stats, err = target.ResetSpentTime(client); err != nil {
+					return err
+				}
+			}
+			if estimate {
+				if stats, err = target.ResetTimeEstimate(client); err != nil {
+					return err
+				}
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Reset the time tracking of %s: %s.\n", f.IO().Color().GreenCheck(), target, timeutils.Summary(stats))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&estimate, "estimate", false, "Reset the time estimate.")
+	cmd.Flags().BoolVar(&spent, "spent", false, "Reset the spent time. Default when --estimate is not set.")
+
+	return cmd
+}
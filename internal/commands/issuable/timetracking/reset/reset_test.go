@@ -0,0 +1,74 @@
+//go:build !integration
+
+package reset
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, kind timeutils.Kind, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdReset(factory, kind)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTimeReset(t *testing.T) {
+	tests := []struct {
+		name      string
+		cli       string
+		wantPaths []string
+		wantOut   string
+	}{
+		{
+			name:      "spent time by default",
+			cli:       "3",
+			wantPaths: []string{"reset_spent_time"},
+			wantOut:   "✓ Reset the time tracking of merge request !3: 0h spent, no estimate.\n",
+		},
+		{
+			name:      "estimate",
+			cli:       "3 --estimate",
+			wantPaths: []string{"reset_time_estimate"},
+			wantOut:   "✓ Reset the time tracking of merge request !3: 0h spent, no estimate.\n",
+		},
+		{
+			name:      "both",
+			cli:       "3 --estimate --spent",
+			wantPaths: []string{"reset_spent_time", "reset_time_estimate"},
+			wantOut:   "✓ Reset the time tracking of merge request !3: 0h spent, no estimate.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/3",
+				httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "iid": 3}`))
+			for _, path := range tc.wantPaths {
+				fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/merge_requests/3/"+path,
+					httpmock.NewStringResponse(http.StatusOK, `{}`))
+			}
+
+			output, err := runCommand(t, fakeHTTP, timeutils.KindMR, tc.cli)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantOut, output.String())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	timeAddCmd "gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/add"
+	timeEstimateCmd "gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/estimate"
+	timeReportCmd "gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/report"
+	timeResetCmd "gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/reset"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+)
+
+func NewCmdTime(f cmdutils.Factory, kind timeutils.Kind) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: fmt.Sprintf(`Track the time spent on %ss.`, kind.Noun()),
+		Long: heredoc.Docf(`
+			Log time spent, set time estimates, and report the time spent on %[1]ss.
+
+			Durations use the GitLab time tracking format, like %[2]s1h30m%[2]s or %[2]s1w 2d%[2]s,
+			where a day is 8 hours and a week is 5 days.
+		`, kind.Noun(), "`"),
+		Example: heredoc.Doc(fmt.Sprintf(`
+			$ glab %[1]s time add 123 1h30m --summary "Code review"
+			$ glab %[1]s time estimate 123 2d
+			$ glab %[1]s time report --since 2025-01-01 --output csv
+		`, kind)),
+	}
+
+	cmd.AddCommand(timeAddCmd.NewCmdAdd(f, kind))
+	cmd.AddCommand(timeEstimateCmd.NewCmdEstimate(f, kind))
+	cmd.AddCommand(timeResetCmd.NewCmdReset(f, kind))
+	cmd.AddCommand(timeReportCmd.NewCmdReport(f, kind))
+
+	return cmd
+}
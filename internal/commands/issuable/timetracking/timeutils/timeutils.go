@@ -0,0 +1,166 @@
+package timeutils
+
+import (
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Kind is the kind of issuable whose time is tracked.
+type Kind string
+
+const (
+	KindIssue Kind = "issue"
+	KindMR    Kind = "mr"
+)
+
+// Noun returns the name of the kind, for messages.
+func (k Kind) Noun() string {
+	if k == KindMR {
+		return "merge request"
+	}
+	return "issue"
+}
+
+// WithArticle returns the name of the kind with its indefinite article.
+func (k Kind) WithArticle() string {
+	if k == KindMR {
+		return "a merge request"
+	}
+	return "an issue"
+}
+
+// Arg returns the usage of the argument that selects an issuable.
+func (k Kind) Arg() string {
+	if k == KindMR {
+		return "[<id> | <branch>]"
+	}
+	return "<id>"
+}
+
+// Target is an issue or merge request whose time is tracked.
+type Target struct {
+	Kind Kind
+	Repo glrepo.Interface
+	IID  int64
+}
+
+// Ref returns the reference of the target, like #12 or !3.
+func (t *Target) Ref() string {
+	if t.Kind == KindMR {
+		return fmt.Sprintf("!%d", t.IID)
+	}
+	return fmt.Sprintf("#%d", t.IID)
+}
+
+// String returns the target for messages, like issue #12.
+func (t *Target) String() string {
+	return t.Kind.Noun() + " " + t.Ref()
+}
+
+// Resolve returns the target selected by the arguments. Merge requests
+// default to the merge request of the current branch.
+func Resolve(f cmdutils.Factory, kind Kind, args []string) (*Target, error) {
+	if kind == KindMR {
+		mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+		if err != nil {
+			return nil, err
+		}
+		return &Target{Kind: kind, Repo: repo, IID: mr.IID}, nil
+	}
+
+	client, err := f.GitLabClient()
+	if err != nil {
+		return nil, err
+	}
+	issue, repo, err := issueutils.IssueFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+	if err != nil {
+		return nil, err
+	}
+	return &Target{Kind: kind, Repo: repo, IID: issue.IID}, nil
+}
+
+// AddSpentTime adds spent time to the target.
+func (t *Target) AddSpentTime(client *gitlab.Client, duration, summary string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr(duration)}
+	if summary != "" {
+		opts.Summary = gitlab.Ptr(summary)
+	}
+
+	var stats *gitlab.TimeStats
+	var err error
+	if t.Kind == KindMR {
+		stats, _, err = client.MergeRequests.AddSpentTime(t.Repo.FullName(), t.IID, opts)
+	} else {
+		stats, _, err = client.Issues.AddSpentTime(t.Repo.FullName(), t.IID, opts)
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to add spent time to %s.", t))
+	}
+	return stats, nil
+}
+
+// SetTimeEstimate sets the time estimate of the target.
+func (t *Target) SetTimeEstimate(client *gitlab.Client, duration string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr(duration)}
+
+	var stats *gitlab.TimeStats
+	var err error
+	if t.Kind == KindMR {
+		stats, _, err = client.MergeRequests.SetTimeEstimate(t.Repo.FullName(), t.IID, opts)
+	} else {
+		stats, _, err = client.Issues.SetTimeEstimate(t.Repo.FullName(), t.IID, opts)
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to set the time estimate of %s.", t))
+	}
+	return stats, nil
+}
+
+// ResetTimeEstimate removes the time estimate of the target.
+func (t *Target) ResetTimeEstimate(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	var stats *gitlab.TimeStats
+	var err error
+	if t.Kind == KindMR {
+		stats, _, err = client.MergeRequests.ResetTimeEstimate(t.Repo.FullName(), t.IID)
+	} else {
+		stats, _, err = client.Issues.ResetTimeEstimate(t.Repo.FullName(), t.IID)
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to reset the time estimate of %s.", t))
+	}
+	return stats, nil
+}
+
+// ResetSpentTime removes all spent time of the target.
+func (t *Target) ResetSpentTime(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	var stats *gitlab.TimeStats
+	var err error
+	if t.Kind == KindMR {
+		stats, _, err = client.MergeRequests.ResetSpentTime(t.Repo.FullName(), t.IID)
+	} else {
+		stats, _, err = client.Issues.ResetSpentTime(t.Repo.FullName(), t.IID)
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to reset the spent time of %s.", t))
+	}
+	return stats, nil
+}
+
+// Summary returns the spent time and estimate of time stats, like
+// "3h spent of 1d estimated".
+func Summary(stats *gitlab.TimeStats) string {
+	spent := stats.HumanTotalTimeSpent
+	if spent == "" {
+		spent = "0h"
+	}
+	if stats.HumanTimeEstimate == "" {
+		return spent + " spent, no estimate"
+	}
+	return fmt.Sprintf("%s spent of %s estimated", spent, stats.HumanTimeEstimate)
+}
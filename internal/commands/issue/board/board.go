@@ -5,6 +5,8 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	boardCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/create"
+	boardListCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/list"
+	boardMoveCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/move"
 	boardViewCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/view"
 )
 
@@ -17,6 +19,8 @@ func NewCmdBoard(f cmdutils.Factory) *cobra.Command {
 
 	issueCmd.AddCommand(boardCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(boardViewCmd.NewCmdView(f))
+	issueCmd.AddCommand(boardListCmd.NewCmdList(f))
+	issueCmd.AddCommand(boardMoveCmd.NewCmdMove(f))
 	issueCmd.PersistentFlags().StringP("repo", "R", "", "Select another repository using the OWNER/REPO format or the project ID. Supports group namespaces.")
 
 	return issueCmd
@@ -0,0 +1,263 @@
+// Package boardutils resolves issue boards and their lists, and moves issues between lists.
+package boardutils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// ListKind is what decides which issues a board list contains.
+type ListKind string
+
+const (
+	// OpenList contains the open issues that are not in a label list.
+	OpenList ListKind = "open"
+	// ClosedList contains the closed issues.
+	ClosedList    ListKind = "closed"
+	LabelList     ListKind = "label"
+	AssigneeList  ListKind = "assignee"
+	MilestoneList ListKind = "milestone"
+)
+
+// List is a list of an issue board. The Open and Closed lists are not returned by
+// the API, and have no ID.
+type List struct {
+	ID        int64
+	Kind      ListKind
+	Label     *gitlab.Label
+	Assignee  *gitlab.BoardListAssignee
+	Milestone *gitlab.Milestone
+}
+
+// Name returns the name of the list as shown on the board. Assignee lists are
+// named after the username, prefixed with @.
+func (l *List) Name() string {
+	switch l.Kind {
+	case OpenList:
+		return "Open"
+	case ClosedList:
+		return "Closed"
+	case LabelList:
+		return l.Label.Name
+	case AssigneeList:
+		return "@" + l.Assignee.Username
+	case MilestoneList:
+		return l.Milestone.Title
+	}
+	return ""
+}
+
+// Lists returns the lists of a board in order, between the Open and Closed lists.
+// Lists of unsupported kinds, like iteration lists, are skipped.
+func Lists(boardLists []*gitlab.BoardList) []*List {
+	lists := []*List{{Kind: OpenList}}
+
+	sorted := slices.Clone(boardLists)
+	slices.SortStableFunc(sorted, func(a, b *gitlab.BoardList) int {
+		return int(a.Position - b.Position)
+	})
+	for _, bl := range sorted {
+		l := &List{ID: bl.ID, Label: bl.Label, Assignee: bl.Assignee, Milestone: bl.Milestone}
+		switch {
+		case bl.Label != nil:
+			l.Kind = LabelList
+		case bl.Assignee != nil:
+			l.Kind = AssigneeList
+		case bl.Milestone != nil:
+			l.Kind = MilestoneList
+		default:
+			continue
+		}
+		lists = append(lists, l)
+	}
+
+	return append(lists, &List{Kind: ClosedList})
+}
+
+// FindList finds a list by its ID, or by its name, case-insensitively.
+func FindList(lists []*List, nameOrID string) (*List, error) {
+	id, _ := strconv.ParseInt(nameOrID, 10, 64)
+	for _, l := range lists {
+		if (id != 0 && l.ID == id) || strings.EqualFold(l.Name(), nameOrID) {
+			return l, nil
+		}
+	}
+
+	names := make([]string, 0, len(lists))
+	for _, l := range lists {
+		names = append(names, fmt.Sprintf("%q", l.Name()))
+	}
+	return nil, fmt.Errorf("no list %q on the board. The lists are %s.", nameOrID, strings.Join(names, ", "))
+}
+
+// Contains returns whether an issue is in the list, given all lists of its board.
+func (l *List) Contains(issue *gitlab.Issue, lists []*List) bool {
+	if l.Kind == ClosedList {
+		return issue.State == "closed"
+	}
+	if issue.State == "closed" {
+		return false
+	}
+
+	switch l.Kind {
+	case OpenList:
+		for _, other := range lists {
+			if other.Kind == LabelList && other.Contains(issue, lists) {
+				return false
+			}
+		}
+		return true
+	case LabelList:
+		return slices.Contains(issue.Labels, l.Label.Name)
+	case AssigneeList:
+		return slices.ContainsFunc(issue.Assignees, func(u *gitlab.IssueAssignee) bool {
+			return u.ID == l.Assignee.ID
+		})
+	case MilestoneList:
+		return issue.Milestone != nil && issue.Milestone.ID == l.Milestone.ID
+	}
+	return false
+}
+
+// MoveOptions returns the update that moves an issue from one list to another.
+// Like on the board in the GitLab UI, only the label, assignee, or milestone of
+// the source list is removed: the issue stays in the other lists it is in. Closed
+// issues are reopened, unless they move to the Closed list. from can be nil when
+// the issue isn't taken out of any list. It returns nil if nothing changes.
+func MoveOptions(issue *gitlab.Issue, from, to *List) *gitlab.UpdateIssueOptions {
+	opts := &gitlab.UpdateIssueOptions{}
+	changed := false
+
+	assignees := make([]int64, 0, len(issue.Assignees))
+	for _, a := range issue.Assignees {
+		assignees = append(assignees, a.ID)
+	}
+	assigneesChanged := false
+
+	if from != nil && from != to {
+		switch from.Kind {
+		case LabelList:
+			if slices.Contains(issue.Labels, from.Label.Name) {
+				opts.RemoveLabels = &gitlab.LabelOptions{from.Label.Name}
+				changed = true
+			}
+		case AssigneeList:
+			if slices.Contains(assignees, from.Assignee.ID) {
+				assignees = slices.DeleteFunc(assignees, func(id int64) bool { return id == from.Assignee.ID })
+				assigneesChanged = true
+			}
+		case MilestoneList:
+			if issue.Milestone != nil && issue.Milestone.ID == from.Milestone.ID {
+				opts.MilestoneID = gitlab.Ptr(int64(0))
+				changed = true
+			}
+		}
+	}
+
+	switch to.Kind {
+	case ClosedList:
+		if issue.State != "closed" {
+			opts.StateEvent = gitlab.Ptr("close")
+			changed = true
+		}
+	default:
+		if issue.State == "closed" {
+			opts.StateEvent = gitlab.Ptr("reopen")
+			changed = true
+		}
+	}
+
+	switch to.Kind {
+	case LabelList:
+		if !slices.Contains(issue.Labels, to.Label.Name) {
+			opts.AddLabels = &gitlab.LabelOptions{to.Label.Name}
+			changed = true
+		}
+	case AssigneeList:
+		if !slices.Contains(assignees, to.Assignee.ID) {
+			assignees = append(assignees, to.Assignee.ID)
+			assigneesChanged = true
+		}
+	case MilestoneList:
+		if issue.Milestone == nil || issue.Milestone.ID != to.Milestone.ID {
+			opts.MilestoneID = gitlab.Ptr(to.Milestone.ID)
+			changed = true
+		}
+	}
+	if assigneesChanged {
+		opts.AssigneeIDs = &assignees
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return opts
+}
+
+// MoveIssue moves an issue from one list to another, and returns the updated issue.
+func MoveIssue(client *gitlab.Client, issue *gitlab.Issue, from, to *List) (*gitlab.Issue, error) {
+	opts := MoveOptions(issue, from, to)
+	if opts == nil {
+		return issue, nil
+	}
+
+	updated, _, err := client.Issues.UpdateIssue(issue.ProjectID, issue.IID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// FindBoard finds a project issue board by its name or ID. If nameOrID is empty,
+// the project must have exactly one board.
+func FindBoard(client *gitlab.Client, project string, nameOrID string) (*gitlab.IssueBoard, error) {
+	boards, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.IssueBoard, *gitlab.Response, error) {
+		return client.Boards.ListIssueBoards(project, &gitlab.ListIssueBoardsOptions{}, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue boards: %w", err)
+	}
+
+	if nameOrID == "" {
+		switch len(boards) {
+		case 0:
+			return nil, fmt.Errorf("project %s has no issue boards.", project)
+		case 1:
+			return boards[0], nil
+		default:
+			return nil, fmt.Errorf("project %s has %d issue boards. Use --board to select one.", project, len(boards))
+		}
+	}
+
+	id, _ := strconv.ParseInt(nameOrID, 10, 64)
+	var found []*gitlab.IssueBoard
+	for _, b := range boards {
+		if (id != 0 && b.ID == id) || strings.EqualFold(b.Name, nameOrID) {
+			found = append(found, b)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no issue board %q in project %s.", nameOrID, project)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("multiple issue boards are named %q. Use the ID instead.", nameOrID)
+	}
+}
+
+// BoardLists returns the lists of a project issue board.
+func BoardLists(client *gitlab.Client, project string, board int64) ([]*List, error) {
+	boardLists, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.BoardList, *gitlab.Response, error) {
+		return client.Boards.GetIssueBoardLists(project, board, &gitlab.GetIssueBoardListsOptions{}, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue board lists: %w", err)
+	}
+	return Lists(boardLists), nil
+}
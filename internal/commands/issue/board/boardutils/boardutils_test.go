@@ -0,0 +1,195 @@
+//go:build !integration
+
+package boardutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func testLists() []*List {
+	return Lists([]*gitlab.BoardList{
+		{ID: 12, Position: 1, Label: &gitlab.Label{Name: "Doing"}},
+		{ID: 11, Position: 0, Label: &gitlab.Label{Name: "To Do"}},
+		{ID: 13, Position: 2, Assignee: &gitlab.BoardListAssignee{ID: 7, Username: "alice"}},
+		{ID: 14, Position: 3, Milestone: &gitlab.Milestone{ID: 3, Title: "v1.0"}},
+		{ID: 15, Position: 4, Iteration: &gitlab.ProjectIteration{ID: 1}},
+	})
+}
+
+func TestLists(t *testing.T) {
+	var names []string
+	for _, l := range testLists() {
+		names = append(names, l.Name())
+	}
+	assert.Equal(t, []string{"Open", "To Do", "Doing", "@alice", "v1.0", "Closed"}, names)
+}
+
+func TestFindList(t *testing.T) {
+	lists := testLists()
+
+	l, err := FindList(lists, "doing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), l.ID)
+
+	l, err = FindList(lists, "13")
+	require.NoError(t, err)
+	assert.Equal(t, "@alice", l.Name())
+
+	l, err = FindList(lists, "Closed")
+	require.NoError(t, err)
+	assert.Equal(t, ClosedList, l.Kind)
+
+	_, err = FindList(lists, "Done")
+	require.Error(t, err)
+	assert.Equal(t, `no list "Done" on the board. The lists are "Open", "To Do", "Doing", "@alice", "v1.0", "Closed".`, err.Error())
+}
+
+func TestContains(t *testing.T) {
+	lists := testLists()
+	open, todo, doing, alice, milestone, closed := lists[0], lists[1], lists[2], lists[3], lists[4], lists[5]
+
+	issue := &gitlab.Issue{
+		State:     "opened",
+		Labels:    []string{"Doing", "bug"},
+		Assignees: []*gitlab.IssueAssignee{{ID: 7}},
+		Milestone: &gitlab.Milestone{ID: 3},
+	}
+	assert.False(t, open.Contains(issue, lists))
+	assert.False(t, todo.Contains(issue, lists))
+	assert.True(t, doing.Contains(issue, lists))
+	assert.True(t, alice.Contains(issue, lists))
+	assert.True(t, milestone.Contains(issue, lists))
+	assert.False(t, closed.Contains(issue, lists))
+
+	// Assignee and milestone lists don't take issues out of the Open list.
+	issue.Labels = nil
+	assert.True(t, open.Contains(issue, lists))
+
+	issue.State = "closed"
+	assert.False(t, open.Contains(issue, lists))
+	assert.False(t, alice.Contains(issue, lists))
+	assert.True(t, closed.Contains(issue, lists))
+}
+
+func TestMoveOptions(t *testing.T) {
+	lists := testLists()
+	open, todo, doing, alice, milestone, closed := lists[0], lists[1], lists[2], lists[3], lists[4], lists[5]
+
+	tests := []struct {
+		name  string
+		issue *gitlab.Issue
+		from  *List
+		to    *List
+		want  *gitlab.UpdateIssueOptions
+	}{
+		{
+			name:  "label to label",
+			issue: &gitlab.Issue{State: "opened", Labels: []string{"To Do", "bug"}},
+			from:  todo,
+			to:    doing,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:    &gitlab.LabelOptions{"Doing"},
+				RemoveLabels: &gitlab.LabelOptions{"To Do"},
+			},
+		},
+		{
+			name: "label to label keeps other lists",
+			issue: &gitlab.Issue{
+				State:     "opened",
+				Labels:    []string{"To Do"},
+				Assignees: []*gitlab.IssueAssignee{{ID: 7}},
+				Milestone: &gitlab.Milestone{ID: 3},
+			},
+			from: todo,
+			to:   doing,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:    &gitlab.LabelOptions{"Doing"},
+				RemoveLabels: &gitlab.LabelOptions{"To Do"},
+			},
+		},
+		{
+			name:  "label to open",
+			issue: &gitlab.Issue{State: "opened", Labels: []string{"To Do", "Doing"}},
+			from:  todo,
+			to:    open,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"To Do"},
+			},
+		},
+		{
+			name:  "open to label",
+			issue: &gitlab.Issue{State: "opened", Labels: []string{"bug"}},
+			from:  open,
+			to:    doing,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels: &gitlab.LabelOptions{"Doing"},
+			},
+		},
+		{
+			name:  "label to closed",
+			issue: &gitlab.Issue{State: "opened", Labels: []string{"Doing", "To Do"}},
+			from:  doing,
+			to:    closed,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"Doing"},
+				StateEvent:   gitlab.Ptr("close"),
+			},
+		},
+		{
+			name:  "closed to label",
+			issue: &gitlab.Issue{State: "closed", Labels: []string{"Doing"}},
+			from:  closed,
+			to:    todo,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:  &gitlab.LabelOptions{"To Do"},
+				StateEvent: gitlab.Ptr("reopen"),
+			},
+		},
+		{
+			name:  "assignee to milestone",
+			issue: &gitlab.Issue{State: "opened", Assignees: []*gitlab.IssueAssignee{{ID: 7}, {ID: 8}}},
+			from:  alice,
+			to:    milestone,
+			want: &gitlab.UpdateIssueOptions{
+				AssigneeIDs: &[]int64{8},
+				MilestoneID: gitlab.Ptr(int64(3)),
+			},
+		},
+		{
+			name:  "milestone to assignee",
+			issue: &gitlab.Issue{State: "opened", Milestone: &gitlab.Milestone{ID: 3}, Assignees: []*gitlab.IssueAssignee{{ID: 8}}},
+			from:  milestone,
+			to:    alice,
+			want: &gitlab.UpdateIssueOptions{
+				AssigneeIDs: &[]int64{8, 7},
+				MilestoneID: gitlab.Ptr(int64(0)),
+			},
+		},
+		{
+			name:  "no source list",
+			issue: &gitlab.Issue{State: "opened", Milestone: &gitlab.Milestone{ID: 3}},
+			to:    doing,
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels: &gitlab.LabelOptions{"Doing"},
+			},
+		},
+		{
+			name:  "already in list",
+			issue: &gitlab.Issue{State: "opened", Labels: []string{"Doing"}},
+			from:  doing,
+			to:    doing,
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MoveOptions(tc.issue, tc.from, tc.to))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package add
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	board     string
+	label     string
+	assignee  string
+	milestone string
+}
+
+func NewCmdAdd(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add [flags]",
+		Short: `Add a list to a project issue board.`,
+		Long: heredoc.Doc(`
+			Add a list to a project issue board. A list contains the open issues with a
+			label, assigned to a user, or in a milestone.
+
+			The board can be omitted if the project has only one board.
+		`),
+		Example: heredoc.Doc(`
+			# Add a list for the issues labeled "In progress"
+			$ glab issue board list add --label "In progress"
+
+			# Add a list for the issues assigned to a user, to the "Team" board
+			$ glab issue board list add --board Team --assignee johndoe
+
+			# Add a list for the issues in a milestone
+			$ glab issue board list add --milestone v1.0
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.board, "board", "b", "", "Name or ID of the board.")
+	cmd.Flags().StringVarP(&opts.label, "label", "l", "", "Add a list for the issues with this label.")
+	cmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Add a list for the issues assigned to this user.")
+	cmd.Flags().StringVarP(&opts.milestone, "milestone", "m", "", "Add a list for the issues in this milestone.")
+	cmd.MarkFlagsOneRequired("label", "assignee", "milestone")
+	cmd.MarkFlagsMutuallyExclusive("label", "assignee", "milestone")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.FindBoard(client, repo.FullName(), o.board)
+	if err != nil {
+		return err
+	}
+
+	listOpts, name, err := o.listOptions(client, repo.FullName())
+	if err != nil {
+		return err
+	}
+
+	list, _, err := client.Boards.CreateIssueBoardList(repo.FullName(), board.ID, listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to add the list.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Added list %q with ID %d to board %q.\n", o.io.Color().GreenCheck(), name, list.ID, board.Name)
+	return nil
+}
+
+// listOptions resolves the label, user, or milestone of the list, and returns the list name.
+func (o *options) listOptions(client *gitlab.Client, project string) (*gitlab.CreateIssueBoardListOptions, string, error) {
+	switch {
+	case o.label != "":
+		label, _, err := client.Labels.GetLabel(project, o.label)
+		if errors.Is(err, gitlab.ErrNotFound) {
+			return nil, "", fmt.Errorf("label %q not found in project %s.", o.label, project)
+		}
+		if err != nil {
+			return nil, "", cmdutils.WrapError(err, "failed to get label.")
+		}
+		return &gitlab.CreateIssueBoardListOptions{LabelID: gitlab.Ptr(label.ID)}, label.Name, nil
+
+	case o.assignee != "":
+		user, err := api.UserByName(client, o.assignee)
+		if err != nil {
+			return nil, "", err
+		}
+		return &gitlab.CreateIssueBoardListOptions{AssigneeID: gitlab.Ptr(user.ID)}, "@" + user.Username, nil
+
+	default:
+		milestones, err := api.ListAllMilestones(client, project, &api.ListMilestonesOptions{
+			Title: gitlab.Ptr(o.milestone),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, m := range milestones {
+			if m.Title == o.milestone {
+				return &gitlab.CreateIssueBoardListOptions{MilestoneID: gitlab.Ptr(m.ID)}, m.Title, nil
+			}
+		}
+		return nil, "", fmt.Errorf("milestone %q not found in project %s.", o.milestone, project)
+	}
+}
@@ -0,0 +1,131 @@
+//go:build !integration
+
+package add
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdAdd(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func TestBoardListAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		stubs   func(*httpmock.Mocker)
+		body    string
+		wantOut string
+	}{
+		{
+			name: "label",
+			cli:  "--label bug",
+			stubs: func(fakeHTTP *httpmock.Mocker) {
+				fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/labels/bug",
+					httpmock.NewStringResponse(http.StatusOK, `{"id": 5, "name": "bug"}`))
+			},
+			body:    `{"label_id": 5}`,
+			wantOut: "✓ Added list \"bug\" with ID 9 to board \"Development\".\n",
+		},
+		{
+			name: "assignee",
+			cli:  "--assignee alice",
+			stubs: func(fakeHTTP *httpmock.Mocker) {
+				fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users",
+					httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "username": "alice"}]`))
+			},
+			body:    `{"assignee_id": 7}`,
+			wantOut: "✓ Added list \"@alice\" with ID 9 to board \"Development\".\n",
+		},
+		{
+			name: "milestone",
+			cli:  "--milestone v1.0",
+			stubs: func(fakeHTTP *httpmock.Mocker) {
+				fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO",
+					httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "namespace": {"id": 2, "kind": "user"}}`))
+				fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+					httpmock.NewStringResponse(http.StatusOK, `[{"id": 3, "title": "v1.0"}]`))
+			},
+			body:    `{"milestone_id": 3}`,
+			wantOut: "✓ Added list \"v1.0\" with ID 9 to board \"Development\".\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards",
+				httpmock.NewStringResponse(http.StatusOK, `[{"id": 1, "name": "Development"}]`))
+			tc.stubs(fakeHTTP)
+			fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/boards/1/lists",
+				expectBody(t, tc.body, httpmock.NewStringResponse(http.StatusCreated, `{"id": 9}`)))
+
+			output, err := runCommand(t, fakeHTTP, tc.cli)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, output.String())
+		})
+	}
+}
+
+func TestBoardListAddMultipleBoards(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1, "name": "Development"}, {"id": 2, "name": "Team"}]`))
+
+	_, err := runCommand(t, fakeHTTP, "--label bug")
+	require.Error(t, err)
+	assert.Equal(t, "project OWNER/REPO has 2 issue boards. Use --board to select one.", err.Error())
+}
+
+func TestBoardListAddLabelNotFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1, "name": "Development"}, {"id": 2, "name": "Team"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/labels/nope",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Label Not Found"}`))
+
+	_, err := runCommand(t, fakeHTTP, "--board team --label nope")
+	require.Error(t, err)
+	assert.Equal(t, `label "nope" not found in project OWNER/REPO.`, err.Error())
+}
+
+func TestBoardListAddRequiresKind(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one of the flags in the group [label assignee milestone] is required")
+}
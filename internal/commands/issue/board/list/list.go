@@ -0,0 +1,21 @@
+package list
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	boardListAddCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/list/add"
+	boardListRemoveCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board/list/remove"
+)
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <command>",
+		Short: "Add or remove the lists of a project issue board.",
+	}
+
+	cmd.AddCommand(boardListAddCmd.NewCmdAdd(f))
+	cmd.AddCommand(boardListRemoveCmd.NewCmdRemove(f))
+
+	return cmd
+}
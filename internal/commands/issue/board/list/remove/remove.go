@@ -0,0 +1,101 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	board string
+	list  string
+}
+
+func NewCmdRemove(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <list> [flags]",
+		Short:   `Remove a list from a project issue board.`,
+		Aliases: []string{"rm", "delete"},
+		Long: heredoc.Doc(`
+			Remove a list from a project issue board. The issues of the list are not changed.
+
+			The list is identified by its ID, or by its name: the label name, the milestone
+			title, or the username prefixed with @ for assignee lists.
+		`),
+		Example: heredoc.Doc(`
+			# Remove the "In progress" list
+			$ glab issue board list remove "In progress"
+
+			# Remove the list of a user from the "Team" board
+			$ glab issue board list remove @johndoe --board Team
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.list = args[0]
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.board, "board", "b", "", "Name or ID of the board.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.FindBoard(client, repo.FullName(), o.board)
+	if err != nil {
+		return err
+	}
+
+	lists, err := boardutils.BoardLists(client, repo.FullName(), board.ID)
+	if err != nil {
+		return err
+	}
+
+	list, err := boardutils.FindList(lists, o.list)
+	if err != nil {
+		return err
+	}
+	if list.ID == 0 {
+		return fmt.Errorf("the %s list cannot be removed.", list.Name())
+	}
+
+	if _, err := client.Boards.DeleteIssueBoardList(repo.FullName(), board.ID, list.ID); err != nil {
+		return cmdutils.WrapError(err, "failed to remove the list.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Removed list %q from board %q.\n", o.io.Color().GreenCheck(), list.Name(), board.Name)
+	return nil
+}
@@ -0,0 +1,86 @@
+//go:build !integration
+
+package remove
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdRemove(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func registerBoard(fakeHTTP *httpmock.Mocker) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1, "name": "Development"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards/1/lists",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 11, "position": 0, "label": {"name": "Doing"}},
+			{"id": 12, "position": 1, "assignee": {"id": 7, "username": "alice"}}
+		]`))
+}
+
+func TestBoardListRemove(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    string
+		path    string
+		wantOut string
+	}{
+		{"by name", "doing", "/api/v4/projects/OWNER/REPO/boards/1/lists/11", "✓ Removed list \"Doing\" from board \"Development\".\n"},
+		{"by assignee", "@alice", "/api/v4/projects/OWNER/REPO/boards/1/lists/12", "✓ Removed list \"@alice\" from board \"Development\".\n"},
+		{"by ID", "12", "/api/v4/projects/OWNER/REPO/boards/1/lists/12", "✓ Removed list \"@alice\" from board \"Development\".\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			registerBoard(fakeHTTP)
+			fakeHTTP.RegisterResponder(http.MethodDelete, tc.path, httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+			output, err := runCommand(t, fakeHTTP, tc.list)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, output.String())
+		})
+	}
+}
+
+func TestBoardListRemoveErrors(t *testing.T) {
+	tests := []struct {
+		list    string
+		wantErr string
+	}{
+		{"Open", "the Open list cannot be removed."},
+		{"Done", `no list "Done" on the board. The lists are "Open", "Doing", "@alice", "Closed".`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.list, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			registerBoard(fakeHTTP)
+
+			_, err := runCommand(t, fakeHTTP, tc.list)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr, err.Error())
+		})
+	}
+}
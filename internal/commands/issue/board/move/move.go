@@ -0,0 +1,176 @@
+package move
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io              *iostreams.IOStreams
+	apiClient       func(repoHost string) (*api.Client, error)
+	gitlabClient    func() (*gitlab.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname string
+
+	issue string
+	board string
+	from  string
+	to    string
+}
+
+func NewCmdMove(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		apiClient:       f.ApiClient,
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move <issue> --to <list> [flags]",
+		Short: `Move an issue to another list of a project issue board.`,
+		Long: heredoc.Doc(`
+			Move an issue to another list of a project issue board.
+
+			Like on the board in the GitLab UI, the issue gets the label, assignee, or
+			milestone of the target list, and loses the one of the list it leaves. It stays
+			in the other lists of the board. Moving an issue to the Closed list closes it,
+			and moving a closed issue to another list reopens it.
+
+			When the issue is in several label, assignee, or milestone lists, use --from to
+			select the list it leaves.
+
+			The list is identified by its ID, or by its name: the label name, the milestone
+			title, the username prefixed with @ for assignee lists, Open, or Closed.
+		`),
+		Example: heredoc.Doc(`
+			# Move issue 42 to the "In review" list
+			$ glab issue board move 42 --to "In review"
+
+			# Move an issue to the list of a user on the "Team" board
+			$ glab issue board move 42 --to @johndoe --board Team
+
+			# Close an issue from the board
+			$ glab issue board move 42 --to Closed
+
+			# Move an issue that is in both the "To Do" and @johndoe lists
+			$ glab issue board move 42 --from "To Do" --to Doing
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.issue = args[0]
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.to, "to", "t", "", "Name or ID of the list to move the issue to.")
+	cmd.Flags().StringVarP(&opts.from, "from", "f", "", "Name or ID of the list to take the issue out of. Defaults to the only list the issue is in.")
+	cmd.Flags().StringVarP(&opts.board, "board", "b", "", "Name or ID of the board.")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	board, err := boardutils.FindBoard(client, repo.FullName(), o.board)
+	if err != nil {
+		return err
+	}
+
+	lists, err := boardutils.BoardLists(client, repo.FullName(), board.ID)
+	if err != nil {
+		return err
+	}
+
+	to, err := boardutils.FindList(lists, o.to)
+	if err != nil {
+		return err
+	}
+
+	issue, _, err := issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname, o.issue)
+	if err != nil {
+		return err
+	}
+
+	from, err := sourceList(lists, issue, to, o.from)
+	if err != nil {
+		return err
+	}
+
+	if boardutils.MoveOptions(issue, from, to) == nil {
+		fmt.Fprintf(o.io.StdOut, "Issue #%d is already in list %q.\n", issue.IID, to.Name())
+		return nil
+	}
+
+	if _, err := boardutils.MoveIssue(client, issue, from, to); err != nil {
+		return cmdutils.WrapError(err, "failed to move the issue.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Moved issue #%d to list %q of board %q.\n", o.io.Color().GreenCheck(), issue.IID, to.Name(), board.Name)
+	return nil
+}
+
+// sourceList returns the list an issue leaves when it moves to another list.
+// Without a name, it's the only label, assignee, or milestone list the issue is
+// in, or nil when there's none.
+func sourceList(lists []*boardutils.List, issue *gitlab.Issue, to *boardutils.List, nameOrID string) (*boardutils.List, error) {
+	if nameOrID != "" {
+		from, err := boardutils.FindList(lists, nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if !from.Contains(issue, lists) {
+			return nil, fmt.Errorf("issue #%d is not in list %q.", issue.IID, from.Name())
+		}
+		return from, nil
+	}
+
+	var in []*boardutils.List
+	for _, l := range lists {
+		switch l.Kind {
+		case boardutils.LabelList, boardutils.AssigneeList, boardutils.MilestoneList:
+			if l != to && l.Contains(issue, lists) {
+				in = append(in, l)
+			}
+		}
+	}
+	switch len(in) {
+	case 0:
+		return nil, nil
+	case 1:
+		return in[0], nil
+	}
+
+	names := make([]string, 0, len(in))
+	for _, l := range in {
+		names = append(names, fmt.Sprintf("%q", l.Name()))
+	}
+	return nil, &cmdutils.FlagError{Err: fmt.Errorf("issue #%d is in lists %s. Use --from to select the list it leaves.", issue.IID, strings.Join(names, ", "))}
+}
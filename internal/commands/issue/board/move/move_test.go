@@ -0,0 +1,148 @@
+//go:build !integration
+
+package move
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdMove(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func registerBoard(fakeHTTP *httpmock.Mocker, issue string) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1, "name": "Development"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/boards/1/lists",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 11, "position": 0, "label": {"name": "To Do"}},
+			{"id": 12, "position": 1, "label": {"name": "Doing"}},
+			{"id": 13, "position": 2, "assignee": {"id": 7, "username": "alice"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/42",
+		httpmock.NewStringResponse(http.StatusOK, issue))
+}
+
+func TestBoardMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		issue   string
+		cli     string
+		body    string
+		wantOut string
+	}{
+		{
+			name:    "to label list",
+			issue:   `{"id": 100, "iid": 42, "project_id": 5, "state": "opened", "labels": ["To Do", "bug"]}`,
+			cli:     "42 --to Doing",
+			body:    `{"add_labels": "Doing", "remove_labels": "To Do"}`,
+			wantOut: "✓ Moved issue #42 to list \"Doing\" of board \"Development\".\n",
+		},
+		{
+			name:    "to closed list",
+			issue:   `{"id": 100, "iid": 42, "project_id": 5, "state": "opened", "labels": ["To Do", "bug"]}`,
+			cli:     "42 --to closed",
+			body:    `{"remove_labels": "To Do", "state_event": "close"}`,
+			wantOut: "✓ Moved issue #42 to list \"Closed\" of board \"Development\".\n",
+		},
+		{
+			name:    "from one of several lists",
+			issue:   `{"id": 100, "iid": 42, "project_id": 5, "state": "opened", "labels": ["To Do"], "assignees": [{"id": 7}]}`,
+			cli:     "42 --from \"To Do\" --to Doing",
+			body:    `{"add_labels": "Doing", "remove_labels": "To Do"}`,
+			wantOut: "✓ Moved issue #42 to list \"Doing\" of board \"Development\".\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			registerBoard(fakeHTTP, tc.issue)
+			fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/5/issues/42",
+				expectBody(t, tc.body, httpmock.NewStringResponse(http.StatusOK, `{"id": 100, "iid": 42}`)))
+
+			output, err := runCommand(t, fakeHTTP, tc.cli)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantOut, output.String())
+		})
+	}
+}
+
+func TestBoardMoveSourceList(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "several source lists",
+			cli:     "42 --to Doing",
+			wantErr: `issue #42 is in lists "To Do", "@alice". Use --from to select the list it leaves.`,
+		},
+		{
+			name:    "not in source list",
+			cli:     "42 --from Doing --to @alice",
+			wantErr: `issue #42 is not in list "Doing".`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			registerBoard(fakeHTTP, `{"id": 100, "iid": 42, "project_id": 5, "state": "opened", "labels": ["To Do"], "assignees": [{"id": 7}]}`)
+
+			_, err := runCommand(t, fakeHTTP, tc.cli)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr, err.Error())
+		})
+	}
+}
+
+func TestBoardMoveAlreadyInList(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerBoard(fakeHTTP, `{"id": 100, "iid": 42, "project_id": 5, "state": "opened", "labels": ["Doing"]}`)
+
+	output, err := runCommand(t, fakeHTTP, "42 --to Doing")
+	require.NoError(t, err)
+	assert.Equal(t, "Issue #42 is already in list \"Doing\".\n", output.String())
+}
+
+func TestBoardMoveRequiresTo(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "42")
+	require.Error(t, err)
+	assert.Equal(t, `required flag(s) "to" not set`, err.Error())
+}
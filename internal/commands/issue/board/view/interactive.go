@@ -0,0 +1,215 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/board/boardutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+const interactiveHelp = "[darkgray]←/→ select list  ↑/↓ select issue  shift+←/→ move issue  q quit"
+
+// boardModel holds the lists and issues of a board in interactive mode.
+type boardModel struct {
+	lists  []*boardutils.List
+	issues []*gitlab.Issue
+	// moveIssue moves an issue from one list to another, and returns the updated issue.
+	moveIssue func(issue *gitlab.Issue, from, to *boardutils.List) (*gitlab.Issue, error)
+}
+
+// cards returns the issues of a list, in the order of the board.
+func (m *boardModel) cards(list int) []*gitlab.Issue {
+	var issues []*gitlab.Issue
+	for _, issue := range m.issues {
+		if m.lists[list].Contains(issue, m.lists) {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// move moves an issue from one list to another, and replaces it with the updated issue.
+func (m *boardModel) move(issue *gitlab.Issue, from, to int) error {
+	updated, err := m.moveIssue(issue, m.lists[from], m.lists[to])
+	if err != nil {
+		return err
+	}
+	for i, existing := range m.issues {
+		if existing.ID == issue.ID {
+			m.issues[i] = updated
+		}
+	}
+	return nil
+}
+
+// interactiveBoard renders a boardModel, and moves issues with the keyboard.
+type interactiveBoard struct {
+	app     *tview.Application
+	model   *boardModel
+	columns []*tview.List
+	status  *tview.TextView
+	focused int
+}
+
+func runInteractive(a *tview.Application, client *gitlab.Client, board boardMeta, project *gitlab.Project, repo glrepo.Interface, opts *issueBoardViewOptions) error {
+	boardLists, err := fetchBoardLists(client, board, repo)
+	if err != nil {
+		return fmt.Errorf("getting issue board lists: %w", err)
+	}
+
+	var issues []*gitlab.Issue
+	for _, state := range []string{opened, closed} {
+		opts.state = state
+		var stateIssues []*gitlab.Issue
+		if board.group != nil {
+			stateIssues, err = getGroupBoardIssues(client, board.group.ID, opts)
+		} else {
+			stateIssues, err = getProjectBoardIssues(client, repo, opts)
+		}
+		if err != nil {
+			return fmt.Errorf("getting issue board lists: %w", err)
+		}
+		issues = append(issues, stateIssues...)
+	}
+
+	b := &interactiveBoard{
+		app: a,
+		model: &boardModel{
+			lists:  boardutils.Lists(boardLists),
+			issues: issues,
+			moveIssue: func(issue *gitlab.Issue, from, to *boardutils.List) (*gitlab.Issue, error) {
+				return boardutils.MoveIssue(client, issue, from, to)
+			},
+		},
+	}
+
+	columns := tview.NewFlex()
+	columns.SetBackgroundColor(tcell.ColorDefault)
+	for _, l := range b.model.lists {
+		column := tview.NewList().
+			ShowSecondaryText(true).
+			SetHighlightFullLine(true).
+			SetSelectedFocusOnly(true)
+		column.SetBackgroundColor(tcell.ColorDefault)
+		column.SetBorder(true).SetTitle(" " + l.Name() + " ")
+		if l.Label != nil {
+			column.SetTitleColor(tcell.GetColor(l.Label.Color))
+		}
+		b.columns = append(b.columns, column)
+		columns.AddItem(column, 0, 1, false)
+	}
+	b.status = tview.NewTextView().SetDynamicColors(true).SetText(interactiveHelp)
+	b.status.SetBackgroundColor(tcell.ColorDefault)
+
+	root := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(columns, 0, 1, true).
+		AddItem(b.status, 1, 0, false)
+	root.SetBackgroundColor(tcell.ColorDefault)
+	root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(boardTitle(board, project))
+
+	for i := range b.columns {
+		b.render(i, 0)
+	}
+	a.SetInputCapture(b.handleKey)
+	a.SetFocus(b.columns[0])
+
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	return a.SetScreen(screen).SetRoot(root, true).Run()
+}
+
+// render fills a column with its cards, and selects one of them.
+func (b *interactiveBoard) render(column, selected int) {
+	list := b.columns[column]
+	list.Clear()
+	for _, issue := range b.model.cards(column) {
+		list.AddItem(issue.Title, cardDetails(issue), 0, nil)
+	}
+	if list.GetItemCount() > 0 {
+		list.SetCurrentItem(min(selected, list.GetItemCount()-1))
+	}
+}
+
+func cardDetails(issue *gitlab.Issue) string {
+	details := fmt.Sprintf("[green]#%d", issue.IID)
+	var assignees []string
+	for _, a := range issue.Assignees {
+		assignees = append(assignees, "@"+a.Username)
+	}
+	if len(assignees) > 0 {
+		details += "[darkgray] - " + strings.Join(assignees, " ")
+	}
+	return details
+}
+
+func (b *interactiveBoard) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	shift := event.Modifiers()&tcell.ModShift != 0
+
+	switch {
+	case event.Key() == tcell.KeyEscape || event.Rune() == 'q':
+		b.app.Stop()
+	case event.Key() == tcell.KeyLeft && shift, event.Rune() == 'H':
+		b.moveSelected(-1)
+	case event.Key() == tcell.KeyRight && shift, event.Rune() == 'L':
+		b.moveSelected(1)
+	case event.Key() == tcell.KeyLeft, event.Rune() == 'h':
+		b.focus(b.focused - 1)
+	case event.Key() == tcell.KeyRight, event.Rune() == 'l':
+		b.focus(b.focused + 1)
+	case event.Rune() == 'j':
+		return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+	case event.Rune() == 'k':
+		return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+	default:
+		return event
+	}
+	return nil
+}
+
+func (b *interactiveBoard) focus(column int) {
+	if column < 0 || column >= len(b.columns) {
+		return
+	}
+	b.focused = column
+	b.app.SetFocus(b.columns[column])
+}
+
+// moveSelected moves the selected card to the list on its left or right.
+func (b *interactiveBoard) moveSelected(direction int) {
+	from, to := b.focused, b.focused+direction
+	if to < 0 || to >= len(b.columns) {
+		return
+	}
+
+	cards := b.model.cards(from)
+	current := b.columns[from].GetCurrentItem()
+	if current < 0 || current >= len(cards) {
+		return
+	}
+	issue := cards[current]
+
+	if err := b.model.move(issue, from, to); err != nil {
+		b.status.SetText(fmt.Sprintf("[red]Failed to move issue #%d: %s", issue.IID, err))
+		return
+	}
+
+	// Moving a card can change other lists too, like Open when a label is removed.
+	for i := range b.columns {
+		b.render(i, b.columns[i].GetCurrentItem())
+	}
+	for i, card := range b.model.cards(to) {
+		if card.ID == issue.ID {
+			b.columns[to].SetCurrentItem(i)
+		}
+	}
+	b.focus(to)
+	b.status.SetText(fmt.Sprintf("[green]Moved issue #%d to %s.  %s", issue.IID, b.model.lists[to].Name(), interactiveHelp))
+}
@@ -0,0 +1,69 @@
+//go:build !integration
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/board/boardutils"
+)
+
+func iids(issues []*gitlab.Issue) []int64 {
+	var ids []int64
+	for _, issue := range issues {
+		ids = append(ids, issue.IID)
+	}
+	return ids
+}
+
+func TestBoardModel(t *testing.T) {
+	m := &boardModel{
+		lists: boardutils.Lists([]*gitlab.BoardList{
+			{ID: 11, Label: &gitlab.Label{Name: "Doing"}},
+		}),
+		issues: []*gitlab.Issue{
+			{ID: 1, IID: 1, State: "opened"},
+			{ID: 2, IID: 2, State: "opened", Labels: []string{"Doing"}},
+			{ID: 3, IID: 3, State: "closed"},
+		},
+	}
+	m.moveIssue = func(issue *gitlab.Issue, from, to *boardutils.List) (*gitlab.Issue, error) {
+		assert.Equal(t, m.lists[0], from)
+		moved := *issue
+		moved.Labels = []string{to.Name()}
+		return &moved, nil
+	}
+
+	assert.Equal(t, []int64{1}, iids(m.cards(0)))
+	assert.Equal(t, []int64{2}, iids(m.cards(1)))
+	assert.Equal(t, []int64{3}, iids(m.cards(2)))
+
+	require.NoError(t, m.move(m.issues[0], 0, 1))
+	assert.Empty(t, m.cards(0))
+	assert.Equal(t, []int64{1, 2}, iids(m.cards(1)))
+}
+
+func TestBoardModelMoveError(t *testing.T) {
+	m := &boardModel{
+		lists:  boardutils.Lists(nil),
+		issues: []*gitlab.Issue{{ID: 1, IID: 1, State: "opened"}},
+		moveIssue: func(*gitlab.Issue, *boardutils.List, *boardutils.List) (*gitlab.Issue, error) {
+			return nil, errors.New("forbidden")
+		},
+	}
+
+	require.EqualError(t, m.move(m.issues[0], 0, 1), "forbidden")
+	assert.Equal(t, []int64{1}, iids(m.cards(0)))
+}
+
+func TestCardDetails(t *testing.T) {
+	issue := &gitlab.Issue{IID: 7, Assignees: []*gitlab.IssueAssignee{{Username: "alice"}, {Username: "bob"}}}
+	assert.Equal(t, "[green]#7[darkgray] - @alice @bob", cardDetails(issue))
+	assert.Equal(t, "[green]#8", cardDetails(&gitlab.Issue{IID: 8}))
+}
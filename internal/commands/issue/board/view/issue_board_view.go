@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/charmbracelet/huh"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -34,6 +35,8 @@ type issueBoardViewOptions struct {
 	labels    []string
 	milestone string
 	state     string
+
+	interactive bool
 }
 
 type boardMeta struct {
@@ -48,9 +51,17 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 	viewCmd := &cobra.Command{
 		Use:   "view [flags]",
 		Short: `View project issue board.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			View a project or group issue board.
+
+			With --interactive, issues can be moved between lists with the keyboard:
+			select a list with the left and right arrow keys, select an issue with the up and
+			down arrow keys, and move it with Shift and the left or right arrow key. Like on
+			the board in the GitLab UI, the issue gets the label, assignee, milestone, or state
+			of the target list, and loses the one of the list it leaves. Press q to quit.
+		`),
 		Annotations: map[string]string{
-			mcpannotations.Safe: "true",
+			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			a := tview.NewApplication()
@@ -98,6 +109,10 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 			}
 			selectedBoard := boardMetaMap[selection]
 
+			if opts.interactive {
+				return runInteractive(a, client, selectedBoard, project, repo, opts)
+			}
+
 			boardLists, err := getBoardLists(client, selectedBoard, repo)
 			if err != nil {
 				return fmt.Errorf("getting issue board lists: %w", err)
@@ -156,19 +171,7 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 				root.AddItem(bx, 0, 1, false)
 			}
 
-			// format table title
-			caser := cases.Title(language.English)
-			var boardType, boardContext string
-			if selectedBoard.group != nil {
-				boardType = caser.String("group")
-				boardContext = project.Namespace.Name
-			} else {
-				boardType = caser.String("project")
-				boardContext = project.NameWithNamespace
-			}
-			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(
-				fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext),
-			)
+			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(boardTitle(selectedBoard, project))
 
 			screen, err := tcell.NewScreen()
 			if err != nil {
@@ -187,6 +190,8 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 		StringSliceVarP(&opts.labels, "labels", "l", []string{}, "Filter board issues by labels. Multiple labels can be comma-separated or specified by repeating the flag.")
 	viewCmd.Flags().
 		StringVarP(&opts.milestone, "milestone", "m", "", "Filter board issues by milestone.")
+	viewCmd.Flags().
+		BoolVarP(&opts.interactive, "interactive", "i", false, "Move issues between the lists of the board with the keyboard.")
 	return viewCmd
 }
 
@@ -362,22 +367,35 @@ func getGroupIssueBoards(
 	return projectGroupIssueBoards, nil
 }
 
-func getBoardLists(apiClient *gitlab.Client, board boardMeta, repo glrepo.Interface) ([]*gitlab.BoardList, error) {
-	boardLists := []*gitlab.BoardList{}
-	var err error
+// boardTitle returns the title of the board view.
+func boardTitle(board boardMeta, project *gitlab.Project) string {
+	caser := cases.Title(language.English)
+	var boardType, boardContext string
+	if board.group != nil {
+		boardType = caser.String("group")
+		boardContext = project.Namespace.Name
+	} else {
+		boardType = caser.String("project")
+		boardContext = project.NameWithNamespace
+	}
+	return fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext)
+}
 
+// fetchBoardLists returns the lists of the board, as returned by the API.
+func fetchBoardLists(apiClient *gitlab.Client, board boardMeta, repo glrepo.Interface) ([]*gitlab.BoardList, error) {
 	if board.group != nil {
-		boardLists, _, err = apiClient.GroupIssueBoards.ListGroupIssueBoardLists(board.group.ID, board.id, &gitlab.ListGroupIssueBoardListsOptions{})
-		if err != nil {
-			return nil, err
-		}
+		boardLists, _, err := apiClient.GroupIssueBoards.ListGroupIssueBoardLists(board.group.ID, board.id, &gitlab.ListGroupIssueBoardListsOptions{})
+		return boardLists, err
 	}
 
-	if board.group == nil {
-		boardLists, _, err = apiClient.Boards.GetIssueBoardLists(repo.FullName(), board.id, &gitlab.GetIssueBoardListsOptions{})
-		if err != nil {
-			return nil, err
-		}
+	boardLists, _, err := apiClient.Boards.GetIssueBoardLists(repo.FullName(), board.id, &gitlab.GetIssueBoardListsOptions{})
+	return boardLists, err
+}
+
+func getBoardLists(apiClient *gitlab.Client, board boardMeta, repo glrepo.Interface) ([]*gitlab.BoardList, error) {
+	boardLists, err := fetchBoardLists(apiClient, board, repo)
+	if err != nil {
+		return nil, err
 	}
 
 	// add empty 'opened' and 'closed' lists before and after fetched lists
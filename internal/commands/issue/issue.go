@@ -13,6 +13,7 @@ import (
 	issueNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/note"
 	issueReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/reopen"
 	issueSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/subscribe"
+	issueTimeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/time"
 	issueUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/unsubscribe"
 	issueUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/update"
 	issueViewCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/view"
@@ -49,6 +50,7 @@ func NewCmdIssue(f cmdutils.Factory) *cobra.Command {
 	issueCmd.AddCommand(issueReopenCmd.NewCmdReopen(f))
 	issueCmd.AddCommand(issueViewCmd.NewCmdView(f))
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
+	issueCmd.AddCommand(issueTimeCmd.NewCmdTime(f))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
 	return issueCmd
@@ -0,0 +1,13 @@
+package time
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable/timetracking/timeutils"
+)
+
+func NewCmdTime(f cmdutils.Factory) *cobra.Command {
+	return timetracking.NewCmdTime(f, timeutils.KindIssue)
+}
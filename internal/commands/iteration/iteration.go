@@ -5,6 +5,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	iterationListCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/list"
+	iterationViewCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/view"
 )
 
 func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
@@ -17,5 +18,6 @@ func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
 	cmdutils.EnableRepoOverride(iterationCmd, f)
 
 	iterationCmd.AddCommand(iterationListCmd.NewCmdList(f))
+	iterationCmd.AddCommand(iterationViewCmd.NewCmdView(f))
 	return iterationCmd
 }
@@ -0,0 +1,88 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// chartWidth is the width of the longest bar of a chart.
+const chartWidth = 40
+
+// chartDay is the progress of an iteration at the end of a day.
+type chartDay struct {
+	Date time.Time
+	// Done is the weight, or the number, of the issues closed by the end of the day.
+	Done int64
+	// Ideal is the remaining weight, or number, of issues if the work was done
+	// at a constant pace.
+	Ideal float64
+}
+
+// issueSize returns the size of an issue in the charts: its weight, or 1 if
+// the charts count issues.
+func issueSize(issue *gitlab.Issue, byWeight bool) int64 {
+	if byWeight {
+		return issue.Weight
+	}
+	return 1
+}
+
+// chartDays returns the progress of the issues of an iteration for each day,
+// from its start date until its due date or today, whichever comes first.
+func chartDays(issues []*gitlab.Issue, start, due, today time.Time, byWeight bool) []chartDay {
+	var total int64
+	for _, issue := range issues {
+		total += issueSize(issue, byWeight)
+	}
+
+	length := int(due.Sub(start).Hours()/24) + 1
+	var days []chartDay
+	for i := 0; i < length; i++ {
+		day := start.AddDate(0, 0, i)
+		if day.After(today) {
+			break
+		}
+
+		var done int64
+		end := day.AddDate(0, 0, 1)
+		for _, issue := range issues {
+			if issue.State == "closed" && issue.ClosedAt != nil && issue.ClosedAt.Before(end) {
+				done += issueSize(issue, byWeight)
+			}
+		}
+
+		ideal := float64(total)
+		if length > 1 {
+			ideal = float64(total) * float64(length-1-i) / float64(length-1)
+		}
+		days = append(days, chartDay{Date: day, Done: done, Ideal: ideal})
+	}
+	return days
+}
+
+// renderChart renders a burndown or burnup chart, with one bar for each day.
+func renderChart(c *iostreams.ColorPalette, days []chartDay, total int64, burnup bool) string {
+	var sb strings.Builder
+	for _, day := range days {
+		value := total - day.Done
+		label := fmt.Sprintf("%d", value)
+		if burnup {
+			value = day.Done
+			label = fmt.Sprintf("%d/%d", day.Done, total)
+		} else {
+			label += c.Gray(fmt.Sprintf(" (ideal %.0f)", day.Ideal))
+		}
+
+		width := 0
+		if total > 0 {
+			width = int(value * chartWidth / total)
+		}
+		fmt.Fprintf(&sb, "%s │%s %s\n", day.Date.Format("Jan 02"), c.Cyan(strings.Repeat("█", width)), label)
+	}
+	return sb.String()
+}
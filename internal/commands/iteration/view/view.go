@@ -0,0 +1,449 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// now returns the current time. Tests replace it.
+var now = time.Now
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	iteration    string
+	group        string
+	current      bool
+	cadence      string
+	chart        string
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<iteration>] [flags]",
+		Short: `View the issues and progress of an iteration.`,
+		Long: heredoc.Doc(`
+			View the issues of an iteration, grouped by state, with their weight and time
+			tracking totals, and a burndown or burnup chart of the iteration.
+
+			The iteration is given by its ID or title. Use --current to view the
+			iteration that is in progress instead. If the group has more than one
+			iteration cadence, use --cadence to select the cadence.
+
+			The charts use issue weights when the issues have any, and else the number
+			of issues. They use the current scope of the iteration for every day.
+		`),
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			# View the current iteration of the project
+			$ glab iteration view --current
+
+			# View the current iteration of a cadence of a group, with a burnup chart
+			$ glab iteration view --current --cadence "Sprints" --group mygroup --chart burnup
+
+			# View an iteration by ID
+			$ glab iteration view 53
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.iteration = args[0]
+			}
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "View an iteration of a group, instead of the current project.")
+	cmd.Flags().BoolVarP(&opts.current, "current", "c", false, "View the current iteration.")
+	cmd.Flags().StringVar(&opts.cadence, "cadence", "", "Title of the iteration cadence of the current iteration.")
+	cmd.Flags().StringVar(&opts.chart, "chart", "burndown", "Chart to display: burndown, burnup, none.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if o.iteration == "" && !o.current {
+		return cmdutils.FlagError{Err: errors.New("specify an iteration, or use --current.")}
+	}
+	if o.iteration != "" && o.current {
+		return cmdutils.FlagError{Err: errors.New("specify either an iteration or --current, not both.")}
+	}
+	if o.cadence != "" && !o.current {
+		return cmdutils.FlagError{Err: errors.New("--cadence can only be used with --current.")}
+	}
+	switch o.chart {
+	case "burndown", "burnup", "none":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid chart %q. Use one of: burndown, burnup, none.", o.chart)}
+	}
+	if o.outputFormat != "text" && o.outputFormat != "json" {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", o.outputFormat)}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var repo glrepo.Interface
+	if o.group == "" {
+		if repo, err = o.baseRepo(); err != nil {
+			return err
+		}
+	}
+
+	iteration, err := o.findIteration(client, repo)
+	if err != nil {
+		return err
+	}
+
+	issues, err := o.listIssues(client, repo, iteration.ID)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(struct {
+			Iteration *gitlab.GroupIteration `json:"iteration"`
+			Issues    []*gitlab.Issue        `json:"issues"`
+		}{iteration, issues})
+	}
+
+	fmt.Fprint(o.io.StdOut, o.render(iteration, issues))
+	return nil
+}
+
+// listIterations returns the iterations of the group or project.
+func (o *options) listIterations(client *gitlab.Client, repo glrepo.Interface, state string) ([]*gitlab.GroupIteration, error) {
+	if o.group != "" {
+		iterations, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupIteration, *gitlab.Response, error) {
+			return client.GroupIterations.ListGroupIterations(o.group, &gitlab.ListGroupIterationsOptions{
+				ListOptions:      gitlab.ListOptions{PerPage: api.MaxPerPage},
+				State:            gitlab.Ptr(state),
+				IncludeAncestors: gitlab.Ptr(true),
+			}, p)
+		})
+		if err != nil {
+			return nil, cmdutils.WrapError(err, "failed to list iterations.")
+		}
+		return iterations, nil
+	}
+
+	projectIterations, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectIteration, *gitlab.Response, error) {
+		return client.ProjectIterations.ListProjectIterations(repo.FullName(), &gitlab.ListProjectIterationsOptions{
+			ListOptions:      gitlab.ListOptions{PerPage: api.MaxPerPage},
+			State:            gitlab.Ptr(state),
+			IncludeAncestors: gitlab.Ptr(true),
+		}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list iterations.")
+	}
+
+	iterations := make([]*gitlab.GroupIteration, 0, len(projectIterations))
+	for _, it := range projectIterations {
+		iterations = append(iterations, gitlab.Ptr(gitlab.GroupIteration(*it)))
+	}
+	return iterations, nil
+}
+
+func (o *options) findIteration(client *gitlab.Client, repo glrepo.Interface) (*gitlab.GroupIteration, error) {
+	if !o.current {
+		iterations, err := o.listIterations(client, repo, "all")
+		if err != nil {
+			return nil, err
+		}
+		id, _ := strconv.ParseInt(o.iteration, 10, 64)
+		for _, it := range iterations {
+			if it.ID == id || (it.Title != "" && it.Title == o.iteration) {
+				return it, nil
+			}
+		}
+		return nil, fmt.Errorf("no iteration %q. Use the ID or title of an iteration, see glab iteration list.", o.iteration)
+	}
+
+	iterations, err := o.listIterations(client, repo, "current")
+	if err != nil {
+		return nil, err
+	}
+
+	if o.cadence != "" {
+		id, err := o.cadenceIteration(client, repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range iterations {
+			if it.ID == id {
+				return it, nil
+			}
+		}
+		return nil, fmt.Errorf("no current iteration in cadence %q.", o.cadence)
+	}
+
+	switch len(iterations) {
+	case 0:
+		return nil, errors.New("no current iteration.")
+	case 1:
+		return iterations[0], nil
+	default:
+		return nil, fmt.Errorf("%d iterations are current, one for each cadence. Use --cadence to select one.", len(iterations))
+	}
+}
+
+// cadenceIteration returns the ID of the current iteration of the cadence.
+// The REST API has no cadences, so it uses the GraphQL API.
+func (o *options) cadenceIteration(client *gitlab.Client, repo glrepo.Interface) (int64, error) {
+	group := o.group
+	if group == "" {
+		group = repo.RepoOwner()
+	}
+
+	var data struct {
+		Group *struct {
+			Iterations struct {
+				Nodes []struct {
+					ID               string `json:"id"`
+					IterationCadence struct {
+						Title string `json:"title"`
+					} `json:"iterationCadence"`
+				} `json:"nodes"`
+			} `json:"iterations"`
+		} `json:"group"`
+	}
+	query := `query($fullPath: ID!) {
+  group(fullPath: $fullPath) {
+    iterations(state: current, includeAncestors: true) { nodes { id iterationCadence { title } } }
+  }
+}`
+	if err := api.GraphQL(client, query, map[string]any{"fullPath": group}, &data); err != nil {
+		return 0, fmt.Errorf("failed to get the iteration cadences: %w", err)
+	}
+	if data.Group == nil {
+		return 0, fmt.Errorf("group %s not found.", group)
+	}
+
+	for _, node := range data.Group.Iterations.Nodes {
+		if strings.EqualFold(node.IterationCadence.Title, o.cadence) {
+			return strconv.ParseInt(node.ID[strings.LastIndex(node.ID, "/")+1:], 10, 64)
+		}
+	}
+	return 0, fmt.Errorf("no current iteration in cadence %q.", o.cadence)
+}
+
+func (o *options) listIssues(client *gitlab.Client, repo glrepo.Interface, iterationID int64) ([]*gitlab.Issue, error) {
+	var issues []*gitlab.Issue
+	var err error
+	if o.group != "" {
+		issues, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.Issues.ListGroupIssues(o.group, &gitlab.ListGroupIssuesOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+				IterationID: gitlab.Ptr(iterationID),
+			}, p)
+		})
+	} else {
+		issues, err = gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			return client.Issues.ListProjectIssues(repo.FullName(), &gitlab.ListProjectIssuesOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+				IterationID: gitlab.Ptr(iterationID),
+			}, p)
+		})
+	}
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list the issues of the iteration.")
+	}
+	return issues, nil
+}
+
+// iterationTitle returns the title of an iteration, or its dates if it has
+// no title, like the iterations of automatic cadences.
+func iterationTitle(it *gitlab.GroupIteration) string {
+	dates := ""
+	if it.StartDate != nil && it.DueDate != nil {
+		dates = fmt.Sprintf("%s – %s", it.StartDate, it.DueDate)
+	}
+	if it.Title == "" {
+		if dates == "" {
+			return fmt.Sprintf("Iteration %d", it.ID)
+		}
+		return dates
+	}
+	if dates == "" {
+		return it.Title
+	}
+	return fmt.Sprintf("%s (%s)", it.Title, dates)
+}
+
+func iterationState(state int64) string {
+	switch state {
+	case 1:
+		return "Upcoming"
+	case 2:
+		return "Current"
+	case 3:
+		return "Closed"
+	}
+	return "Unknown"
+}
+
+type totals struct {
+	count    int
+	weight   int64
+	estimate int64
+	spent    int64
+}
+
+func (t *totals) add(issue *gitlab.Issue) {
+	t.count++
+	t.weight += issue.Weight
+	if issue.TimeStats != nil {
+		t.estimate += issue.TimeStats.TimeEstimate
+		t.spent += issue.TimeStats.TotalTimeSpent
+	}
+}
+
+func (t totals) String() string {
+	return fmt.Sprintf("%s, weight %d, %s estimated, %s spent",
+		utils.Pluralize(t.count, "issue"), t.weight, utils.FormatTimeTracking(t.estimate), utils.FormatTimeTracking(t.spent))
+}
+
+func (o *options) render(it *gitlab.GroupIteration, issues []*gitlab.Issue) string {
+	c := o.io.Color()
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s\n", c.Bold(iterationTitle(it)))
+	fmt.Fprintf(&sb, "State: %s\n", iterationState(it.State))
+
+	var open, closed, all totals
+	var openIssues, closedIssues []*gitlab.Issue
+	for _, issue := range issues {
+		all.add(issue)
+		if issue.State == "closed" {
+			closed.add(issue)
+			closedIssues = append(closedIssues, issue)
+		} else {
+			open.add(issue)
+			openIssues = append(openIssues, issue)
+		}
+	}
+
+	if all.count > 0 {
+		fmt.Fprintf(&sb, "Progress: %d of %s closed", closed.count, utils.Pluralize(all.count, "issue"))
+		if all.weight > 0 {
+			fmt.Fprintf(&sb, ", weight %d of %d (%d%%)", closed.weight, all.weight, closed.weight*100/all.weight)
+		}
+		fmt.Fprintln(&sb)
+		fmt.Fprintf(&sb, "Time: %s estimated, %s spent\n", utils.FormatTimeTracking(all.estimate), utils.FormatTimeTracking(all.spent))
+	}
+
+	for _, group := range []struct {
+		name   string
+		issues []*gitlab.Issue
+		totals totals
+	}{
+		{"Open", openIssues, open},
+		{"Closed", closedIssues, closed},
+	} {
+		if len(group.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "\n%s %s\n", c.Bold(group.name), c.Gray("("+group.totals.String()+")"))
+		sb.WriteString(o.issueTable(group.issues))
+	}
+
+	if all.count == 0 {
+		fmt.Fprintf(&sb, "\nNo issues in this iteration.\n")
+	}
+
+	if o.chart != "none" && all.count > 0 && it.StartDate != nil && it.DueDate != nil {
+		t := now()
+		today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		byWeight := all.weight > 0
+		days := chartDays(issues, time.Time(*it.StartDate), time.Time(*it.DueDate), today, byWeight)
+
+		if len(days) > 0 {
+			unit := "issues"
+			total := int64(all.count)
+			if byWeight {
+				unit = "weight"
+				total = all.weight
+			}
+			name := "Burndown"
+			if o.chart == "burnup" {
+				name = "Burnup"
+			}
+			fmt.Fprintf(&sb, "\n%s %s\n", c.Bold(name), c.Gray("("+unit+")"))
+			sb.WriteString(renderChart(c, days, total, o.chart == "burnup"))
+		}
+	}
+
+	if it.WebURL != "" {
+		fmt.Fprintf(&sb, "\n%s\n", c.Gray("View this iteration on GitLab: "+it.WebURL))
+	}
+	return sb.String()
+}
+
+func (o *options) issueTable(issues []*gitlab.Issue) string {
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+
+	for _, issue := range issues {
+		ref := fmt.Sprintf("#%d", issue.IID)
+		if issue.References != nil && issue.References.Full != "" && o.group != "" {
+			ref = issue.References.Full
+		}
+		if issue.State == "closed" {
+			ref = c.Red(ref)
+		} else {
+			ref = c.Green(ref)
+		}
+
+		table.AddCell(o.io.Hyperlink(ref, issue.WebURL))
+		table.AddCell(issue.Title)
+		table.AddCellf("weight %d", issue.Weight)
+
+		estimate, spent := int64(0), int64(0)
+		if issue.TimeStats != nil {
+			estimate, spent = issue.TimeStats.TimeEstimate, issue.TimeStats.TotalTimeSpent
+		}
+		table.AddCell(c.Gray(fmt.Sprintf("%s / %s", utils.FormatTimeTracking(spent), utils.FormatTimeTracking(estimate))))
+		table.EndRow()
+	}
+	return table.Render()
+}
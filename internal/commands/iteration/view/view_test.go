@@ -0,0 +1,185 @@
+//go:build !integration
+
+package view
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdView(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func setNow(t *testing.T, date string) {
+	t.Helper()
+
+	old := now
+	t.Cleanup(func() { now = old })
+	parsed, err := time.Parse(time.RFC3339, date)
+	require.NoError(t, err)
+	now = func() time.Time { return parsed }
+}
+
+const iterationsResponse = `[
+	{"id": 52, "title": "Sprint 4", "state": 3, "start_date": "2024-12-30", "due_date": "2025-01-03"},
+	{"id": 53, "title": "Sprint 5", "state": 2, "start_date": "2025-01-06", "due_date": "2025-01-10",
+	 "web_url": "https://gitlab.com/groups/OWNER/-/iterations/53"}
+]`
+
+const issuesResponse = `[
+	{"id": 101, "iid": 1, "title": "Login page", "state": "closed", "weight": 3, "closed_at": "2025-01-06T15:00:00Z",
+	 "time_stats": {"time_estimate": 14400, "total_time_spent": 18000}},
+	{"id": 102, "iid": 2, "title": "Signup form", "state": "closed", "weight": 5, "closed_at": "2025-01-08T09:00:00Z"},
+	{"id": 103, "iid": 3, "title": "Password reset", "state": "opened", "weight": 2,
+	 "time_stats": {"time_estimate": 28800, "total_time_spent": 0}}
+]`
+
+func TestIterationView(t *testing.T) {
+	setNow(t, "2025-01-08T12:00:00Z")
+
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/iterations",
+		httpmock.NewStringResponse(http.StatusOK, iterationsResponse))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "53", req.URL.Query().Get("iteration_id"))
+			return httpmock.NewStringResponse(http.StatusOK, issuesResponse)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, `"Sprint 5"`)
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, heredoc.Doc(`
+		Sprint 5 (2025-01-06 – 2025-01-10)
+		State: Current
+		Progress: 2 of 3 issues closed, weight 8 of 10 (80%)
+		Time: 1d 4h estimated, 5h spent
+	`))
+	assert.Contains(t, out, "Open (1 issue, weight 2, 1d estimated, 0h spent)\n#3\tPassword reset\tweight 2\t0h / 1d\n")
+	assert.Contains(t, out, "Closed (2 issues, weight 8, 4h estimated, 5h spent)\n#1\tLogin page")
+	assert.Contains(t, out, "Burndown (weight)\n"+
+		"Jan 06 │"+strings.Repeat("█", 28)+" 7 (ideal 10)\n"+
+		"Jan 07 │"+strings.Repeat("█", 28)+" 7 (ideal 8)\n"+
+		"Jan 08 │"+strings.Repeat("█", 8)+" 2 (ideal 5)\n\n")
+	assert.Contains(t, out, "View this iteration on GitLab: https://gitlab.com/groups/OWNER/-/iterations/53")
+}
+
+func TestIterationView_current(t *testing.T) {
+	setNow(t, "2025-01-07T12:00:00Z")
+
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/mygroup/iterations",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "current", req.URL.Query().Get("state"))
+			return httpmock.NewStringResponse(http.StatusOK, `[
+				{"id": 53, "title": "Sprint 5", "state": 2, "start_date": "2025-01-06", "due_date": "2025-01-10"}
+			]`)(req)
+		})
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/mygroup/issues",
+		httpmock.NewStringResponse(http.StatusOK, issuesResponse))
+
+	output, err := runCommand(t, fakeHTTP, "--current --group mygroup --chart burnup")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "Burnup (weight)\n"+
+		"Jan 06 │"+strings.Repeat("█", 12)+" 3/10\n"+
+		"Jan 07 │"+strings.Repeat("█", 12)+" 3/10\n")
+}
+
+func TestIterationView_currentCadence(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/iterations?include_ancestors=true&per_page=100&state=current",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 53, "title": "Sprint 5", "state": 2},
+			{"id": 61, "title": "", "state": 2, "start_date": "2025-01-01", "due_date": "2025-03-31"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql",
+		httpmock.NewStringResponse(http.StatusOK, `{"data": {"group": {"iterations": {"nodes": [
+			{"id": "gid://gitlab/Iteration/53", "iterationCadence": {"title": "Sprints"}},
+			{"id": "gid://gitlab/Iteration/61", "iterationCadence": {"title": "Quarters"}}
+		]}}}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues?iteration_id=61&per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "--current --cadence quarters")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-01-01 – 2025-03-31\nState: Current\n\nNo issues in this iteration.\n", output.String())
+}
+
+func TestIterationView_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		iterations string
+		wantErr    string
+	}{
+		{
+			name:    "no iteration",
+			cli:     "",
+			wantErr: "specify an iteration, or use --current.",
+		},
+		{
+			name:    "cadence without current",
+			cli:     "53 --cadence Sprints",
+			wantErr: "--cadence can only be used with --current.",
+		},
+		{
+			name:    "invalid chart",
+			cli:     "--current --chart pie",
+			wantErr: `invalid chart "pie". Use one of: burndown, burnup, none.`,
+		},
+		{
+			name:       "unknown iteration",
+			cli:        `"Sprint 9"`,
+			iterations: iterationsResponse,
+			wantErr:    `no iteration "Sprint 9". Use the ID or title of an iteration, see glab iteration list.`,
+		},
+		{
+			name:       "several current iterations",
+			cli:        "--current",
+			iterations: `[{"id": 53, "state": 2}, {"id": 61, "state": 2}]`,
+			wantErr:    "2 iterations are current, one for each cadence. Use --cadence to select one.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{}
+			defer fakeHTTP.Verify(t)
+
+			if tc.iterations != "" {
+				fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/iterations",
+					httpmock.NewStringResponse(http.StatusOK, tc.iterations))
+			}
+
+			_, err := runCommand(t, fakeHTTP, tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -119,7 +119,9 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.complete(cmd)
+			if err := opts.complete(cmd); err != nil {
+				return err
+			}
 
 			if err := opts.validate(cmd); err != nil {
 				return err
@@ -170,12 +172,39 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	return mrCreateCmd
 }
 
-func (o *options) complete(cmd *cobra.Command) {
+func (o *options) complete(cmd *cobra.Command) error {
 	hasTitle := cmd.Flags().Changed("title")
 	hasDescription := cmd.Flags().Changed("description")
 
 	// disable interactive mode if title and description are explicitly defined
 	o.isInteractive = !(hasTitle && hasDescription)
+
+	// The flags override the defaults of the configuration.
+	cfg := o.config()
+	if !cmd.Flags().Changed("target-branch") {
+		o.TargetBranch, _ = cfg.Get("", "mr_target_branch")
+	}
+	for key, values := range map[string]*[]string{"label": &o.Labels, "reviewer": &o.Reviewers} {
+		if cmd.Flags().Changed(key) {
+			continue
+		}
+		value, _ := cfg.Get("", "mr_"+key+"s")
+		list, err := config.SplitList(value)
+		if err != nil {
+			return fmt.Errorf("invalid mr_%ss configuration %q: %w", key, value, err)
+		}
+		*values = list
+	}
+	if !cmd.Flags().Changed("squash-before-merge") {
+		if value, _ := cfg.Get("", "mr_squash"); value != "" {
+			squash, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid mr_squash configuration %q. Use true or false.", value)
+			}
+			o.SquashBeforeMerge = squash
+		}
+	}
+	return nil
 }
 
 func (o *options) validate(cmd *cobra.Command) error {
@@ -394,19 +423,22 @@ func (o *options) run() error {
 						return err
 					}
 				} else {
-					templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.MergeRequestTemplate)
-					if err != nil {
-						return fmt.Errorf("error getting templates: %w", err)
-					}
-
 					const mrWithCommitsTemplate = "Open a merge request with commit messages."
 					const mrEmptyTemplate = "Open a blank merge request."
 
-					templateNames = append(templateNames, mrWithCommitsTemplate)
-					templateNames = append(templateNames, mrEmptyTemplate)
+					templateName, _ = o.config().Get("", "mr_template")
+					if templateName == "" {
+						templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.MergeRequestTemplate)
+						if err != nil {
+							return fmt.Errorf("error getting templates: %w", err)
+						}
 
-					if err := o.io.Select(context.Background(), &templateName, "Choose a template:", templateNames); err != nil {
-						return fmt.Errorf("could not prompt: %w", err)
+						templateNames = append(templateNames, mrWithCommitsTemplate)
+						templateNames = append(templateNames, mrEmptyTemplate)
+
+						if err := o.io.Select(context.Background(), &templateName, "Choose a template:", templateNames); err != nil {
+							return fmt.Errorf("could not prompt: %w", err)
+						}
 					}
 					switch templateName {
 					case mrWithCommitsTemplate:
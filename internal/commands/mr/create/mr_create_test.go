@@ -689,3 +689,53 @@ func Test_MRCreate_With_Recover_Integration(t *testing.T) {
 	assert.Contains(t, newOutput.Stderr(), "\nCreating merge request for feat-new-mr into master in OWNER/REPO\n\n")
 	assert.Contains(t, newOutput.String(), "https://gitlab.com/OWNER/REPO/-/merge_requests/12")
 }
+
+func TestMRCreate_configDefaults(t *testing.T) {
+	cfg := config.NewFromString(heredoc.Doc(`
+		mr_labels: backend
+		repo:
+		  mr_target_branch: develop
+		  mr_reviewers: alice,bob
+		  mr_labels: frontend
+		  mr_squash: "true"
+	`))
+
+	tests := []struct {
+		name string
+		args []string
+		want options
+	}{
+		{
+			name: "configuration",
+			want: options{TargetBranch: "develop", Reviewers: []string{"alice", "bob"}, Labels: []string{"backend"}, SquashBeforeMerge: true},
+		},
+		{
+			name: "flags override the configuration",
+			args: []string{"--target-branch", "main", "--reviewer", "carol", "--label", "docs", "--squash-before-merge=false"},
+			want: options{TargetBranch: "main", Reviewers: []string{"carol"}, Labels: []string{"docs"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+			opts := &options{config: func() config.Config { return cfg }}
+			cmd := &cobra.Command{}
+			cmd.Flags().StringVar(&opts.TargetBranch, "target-branch", "", "")
+			cmd.Flags().StringSliceVar(&opts.Labels, "label", nil, "")
+			cmd.Flags().StringSliceVar(&opts.Reviewers, "reviewer", nil, "")
+			cmd.Flags().BoolVar(&opts.SquashBeforeMerge, "squash-before-merge", false, "")
+			cmd.Flags().String("title", "", "")
+			cmd.Flags().String("description", "", "")
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			require.NoError(t, opts.complete(cmd))
+
+			assert.Equal(t, tc.want.TargetBranch, opts.TargetBranch)
+			assert.Equal(t, tc.want.Reviewers, opts.Reviewers)
+			assert.Equal(t, tc.want.Labels, opts.Labels)
+			assert.Equal(t, tc.want.SquashBeforeMerge, opts.SquashBeforeMerge)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	mrReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/reopen"
 	mrRevokeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/revoke"
 	mrSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/subscribe"
+	mrTimeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/time"
 	mrTodoCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/todo"
 	mrUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/unsubscribe"
 	mrUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/update"
@@ -65,6 +66,7 @@ func NewCmdMR(f cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrReopenCmd.NewCmdReopen(f))
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
+	mrCmd.AddCommand(mrTimeCmd.NewCmdTime(f))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
 	mrCmd.AddCommand(mrUpdateCmd.NewCmdUpdate(f))
@@ -0,0 +1,142 @@
+package add
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+
+	name        string
+	host        string
+	token       string
+	tokenStdin  bool
+	apiHost     string
+	apiProtocol string
+	gitProtocol string
+	headers     []string
+}
+
+func NewCmdAdd(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:     f.IO(),
+		config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <name> [flags]",
+		Short: `Add a configuration profile.`,
+		Example: heredoc.Doc(`
+			# Add a profile for a bot account on GitLab.com, with its token from a file
+			$ glab profile add bot --host gitlab.com --stdin < bot-token.txt
+
+			# Add a profile for a self-managed instance behind a proxy
+			$ glab profile add work --host gitlab.example.com --api-host gitlab.example.com:8443 --git-protocol https --header "X-Proxy-Auth: secret"
+
+			# Run a command with a profile
+			$ glab --profile bot mr list
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			if err := opts.complete(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.host, "host", "", "Hostname of the GitLab instance, like gitlab.com.")
+	cmd.Flags().StringVarP(&opts.token, "token", "t", "", "Personal access token of the profile.")
+	cmd.Flags().BoolVar(&opts.tokenStdin, "stdin", false, "Read the token from standard input.")
+	cmd.Flags().StringVarP(&opts.apiHost, "api-host", "a", "", "API host, if it differs from the hostname.")
+	cmd.Flags().StringVarP(&opts.apiProtocol, "api-protocol", "p", "", "API protocol: https, http.")
+	cmd.Flags().StringVarP(&opts.gitProtocol, "git-protocol", "g", "", "Git protocol: ssh, https, http.")
+	cmd.Flags().StringArrayVar(&opts.headers, "header", nil, "Custom header to add to API requests, in the 'Name: value' format. Can be repeated.")
+	cmd.MarkFlagsMutuallyExclusive("token", "stdin")
+	_ = cmd.MarkFlagRequired("host")
+
+	return cmd
+}
+
+func (o *options) complete() error {
+	if strings.TrimSpace(o.name) == "" || strings.ContainsAny(o.name, " \t:") {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid profile name %q.", o.name)}
+	}
+
+	// A URL sets the API protocol, unless --api-protocol is set.
+	hasProtocol := strings.Contains(o.host, "://")
+	host, protocol := glinstance.StripHostProtocol(o.host)
+	o.host = host
+	if hasProtocol && o.apiProtocol == "" {
+		o.apiProtocol = protocol
+	}
+
+	switch o.apiProtocol {
+	case "", "https", "http":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid --api-protocol %q. Use one of: https, http.", o.apiProtocol)}
+	}
+	switch o.gitProtocol {
+	case "", "ssh", "https", "http":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid --git-protocol %q. Use one of: ssh, https, http.", o.gitProtocol)}
+	}
+
+	if o.tokenStdin {
+		token, err := io.ReadAll(o.io.In)
+		if err != nil {
+			return fmt.Errorf("failed to read the token from standard input: %w", err)
+		}
+		o.token = strings.TrimSpace(string(token))
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	var headers []config.CustomHeader
+	for _, header := range o.headers {
+		name, value, ok := strings.Cut(header, ":")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return cmdutils.FlagError{Err: fmt.Errorf("invalid --header %q. Use the 'Name: value' format.", header)}
+		}
+		headers = append(headers, config.CustomHeader{Name: name, Value: value})
+	}
+
+	cfg := o.config()
+	err := config.AddProfile(cfg, o.name, map[string]string{
+		"host":         o.host,
+		"token":        o.token,
+		"api_host":     o.apiHost,
+		"api_protocol": o.apiProtocol,
+		"git_protocol": o.gitProtocol,
+	}, headers)
+	if err != nil {
+		return err
+	}
+	if err := cfg.Write(); err != nil {
+		return fmt.Errorf("failed to write the configuration: %w", err)
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Added profile %s for %s.\n", o.io.Color().GreenCheck(), o.name, o.host)
+	if o.token == "" {
+		fmt.Fprintf(o.io.StdErr, "The profile has no token. Authenticate with 'glab --profile %s auth login'.\n", o.name)
+	}
+	return nil
+}
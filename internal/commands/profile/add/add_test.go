@@ -0,0 +1,95 @@
+//go:build !integration
+
+package add
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, cfg config.Config, stdin, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios, cmdtest.WithConfig(cfg), cmdtest.WithStdin(stdin))
+	cmd := NewCmdAdd(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestProfileAdd(t *testing.T) {
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := config.NewFromString("")
+	output, err := runCommand(t, cfg, "bot-token\n",
+		`bot --host https://gitlab.example.com --stdin -g https --header "X-Proxy-Auth: secret"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Added profile bot for gitlab.example.com.\n", output.String())
+	assert.Empty(t, output.Stderr())
+	assert.Equal(t, heredoc.Doc(`
+		profiles:
+		    bot:
+		        host: gitlab.example.com
+		        token: bot-token
+		        api_protocol: https
+		        git_protocol: https
+		        custom_headers:
+		            - name: X-Proxy-Auth
+		              value: secret
+	`), mainBuf.String())
+}
+
+func TestProfileAdd_withoutToken(t *testing.T) {
+	defer config.StubWriteConfig(&bytes.Buffer{}, &bytes.Buffer{})()
+
+	output, err := runCommand(t, config.NewFromString(""), "", "work --host gitlab.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, "The profile has no token. Authenticate with 'glab --profile work auth login'.\n", output.Stderr())
+}
+
+func TestProfileAdd_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "invalid name",
+			cli:     `"my bot" --host gitlab.com`,
+			wantErr: `invalid profile name "my bot".`,
+		},
+		{
+			name:    "invalid git protocol",
+			cli:     "bot --host gitlab.com -g ftp",
+			wantErr: `invalid --git-protocol "ftp". Use one of: ssh, https, http.`,
+		},
+		{
+			name:    "invalid header",
+			cli:     "bot --host gitlab.com --header X-Proxy-Auth",
+			wantErr: `invalid --header "X-Proxy-Auth". Use the 'Name: value' format.`,
+		},
+		{
+			name:    "existing profile",
+			cli:     "work --host gitlab.com",
+			wantErr: `profile "work" already exists.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.NewFromString("profiles:\n  work:\n    host: gitlab.com\n")
+			_, err := runCommand(t, cfg, "", tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:     f.IO(),
+		config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the configuration profiles.`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+	return cmd
+}
+
+func (o *options) run() error {
+	cfg := o.config()
+	profiles, err := config.Profiles(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to read the profiles: %w", err)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Fprintln(o.io.StdErr, "No profiles configured. Add one with 'glab profile add'.")
+		return nil
+	}
+
+	active, source := config.ActiveProfile(cfg)
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("Name", "Host", "API host", "Git protocol", "Token", "Active")
+	for _, p := range profiles {
+		apiHost, _ := p.GetStringValue("api_host")
+		gitProtocol, _ := p.GetStringValue("git_protocol")
+		token := c.Gray("none")
+		if value, _ := p.GetStringValue("token"); value != "" {
+			token = "set"
+		}
+		activeCell := ""
+		if p.Name == active {
+			activeCell = c.Green("yes") + c.Gray(" ("+source+")")
+		}
+		table.AddRow(p.Name, p.Host, apiHost, gitProtocol, token, activeCell)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
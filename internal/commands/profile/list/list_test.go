@@ -0,0 +1,56 @@
+//go:build !integration
+
+package list
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, cfg config.Config) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios, cmdtest.WithConfig(cfg))
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, "", stdout, stderr)
+}
+
+func TestProfileList(t *testing.T) {
+	t.Setenv(config.ProfileEnv, "")
+
+	cfg := config.NewFromString(`
+profile: bot
+profiles:
+  work:
+    host: gitlab.example.com
+    api_host: api.gitlab.example.com
+  bot:
+    host: gitlab.com
+    token: bot-token
+    git_protocol: https
+`)
+	output, err := runCommand(t, cfg)
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Regexp(t, `Name\s+Host\s+API host\s+Git protocol\s+Token\s+Active`, out)
+	assert.Regexp(t, `bot\s+gitlab.com\s+\S*\s*https\s+set\s+yes \(`+config.ConfigFile()+`\)`, out)
+	assert.Regexp(t, `work\s+gitlab.example.com\s+api.gitlab.example.com\s+\S*\s*none`, out)
+	assert.Less(t, strings.Index(out, "bot"), strings.Index(out, "work"))
+}
+
+func TestProfileList_empty(t *testing.T) {
+	output, err := runCommand(t, config.NewFromString(""))
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Equal(t, "No profiles configured. Add one with 'glab profile add'.\n", output.Stderr())
+}
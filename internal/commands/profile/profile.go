@@ -0,0 +1,41 @@
+package profile
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	addCmd "gitlab.com/gitlab-org/cli/internal/commands/profile/add"
+	listCmd "gitlab.com/gitlab-org/cli/internal/commands/profile/list"
+	removeCmd "gitlab.com/gitlab-org/cli/internal/commands/profile/remove"
+	useCmd "gitlab.com/gitlab-org/cli/internal/commands/profile/use"
+)
+
+func NewCmdProfile(f cmdutils.Factory) *cobra.Command {
+	profileCmd := &cobra.Command{
+		Use:   "profile <command> [flags]",
+		Short: `Manage configuration profiles, to switch between GitLab identities.`,
+		Long: heredoc.Docf(`
+			A profile is a named identity on a GitLab host: its token, API host, Git
+			protocol, and custom headers. Profiles let you use several accounts on the
+			same host, like a personal account and a bot account.
+
+			When a profile is active, its settings replace the settings of its host in
+			the %[1]shosts%[1]s section of the configuration. Settings of other hosts are
+			not affected.
+
+			glab selects the active profile from, in order of precedence:
+
+			1. The %[1]s--profile%[1]s flag.
+			1. The %[1]sGLAB_PROFILE%[1]s environment variable.
+			1. The local configuration of the repository, set with %[1]sglab profile use --local%[1]s.
+			1. The global configuration, set with %[1]sglab profile use%[1]s.
+		`, "`"),
+	}
+
+	profileCmd.AddCommand(addCmd.NewCmdAdd(f))
+	profileCmd.AddCommand(listCmd.NewCmdList(f))
+	profileCmd.AddCommand(useCmd.NewCmdUse(f))
+	profileCmd.AddCommand(removeCmd.NewCmdRemove(f))
+	return profileCmd
+}
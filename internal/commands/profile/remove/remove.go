@@ -0,0 +1,54 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+
+	name string
+}
+
+func NewCmdRemove(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:     f.IO(),
+		config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <name>",
+		Short:   `Remove a configuration profile.`,
+		Aliases: []string{"rm", "delete"},
+		Args:    cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+	return cmd
+}
+
+func (o *options) run() error {
+	cfg := o.config()
+	if err := config.RemoveProfile(cfg, o.name); err != nil {
+		return err
+	}
+	if err := cfg.Write(); err != nil {
+		return fmt.Errorf("failed to write the configuration: %w", err)
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Removed profile %s.\n", o.io.Color().RedCheck(), o.name)
+	return nil
+}
@@ -0,0 +1,33 @@
+//go:build !integration
+
+package remove
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestProfileRemove(t *testing.T) {
+	t.Setenv(config.ProfileEnv, "")
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := config.NewFromString("profile: bot\nprofiles:\n    bot:\n        host: gitlab.com\n    work:\n        host: gitlab.example.com\n")
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	cmd := NewCmdRemove(cmdtest.NewTestFactory(ios, cmdtest.WithConfig(cfg)))
+
+	output, err := cmdtest.ExecuteCommand(cmd, "bot", stdout, stderr)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed profile bot.\n", output.String())
+	assert.Equal(t, "profiles:\n    work:\n        host: gitlab.example.com\n", mainBuf.String())
+
+	_, err = cmdtest.ExecuteCommand(cmd, "bot", stdout, stderr)
+	assert.EqualError(t, err, `profile "bot" does not exist. Run 'glab profile list' to see the available profiles.`)
+}
@@ -0,0 +1,114 @@
+package use
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+
+	name  string
+	local bool
+	clear bool
+}
+
+func NewCmdUse(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:     f.IO(),
+		config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "use [<name>] [flags]",
+		Short: `Set the active configuration profile.`,
+		Example: heredoc.Doc(`
+			# Use a profile for all commands
+			$ glab profile use bot
+
+			# Use a profile in the current repository only
+			$ glab profile use work --local
+
+			# Stop using a profile
+			$ glab profile use --clear
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.name = args[0]
+			}
+			if opts.name == "" && !opts.clear {
+				return cmdutils.FlagError{Err: errors.New("specify a profile, or use --clear.")}
+			}
+			if opts.name != "" && opts.clear {
+				return cmdutils.FlagError{Err: errors.New("specify a profile, or use --clear, not both.")}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.local, "local", "l", false, "Set the profile of the current repository only.")
+	cmd.Flags().BoolVar(&opts.clear, "clear", false, "Stop using a profile.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	cfg := o.config()
+	if o.name != "" {
+		if _, err := config.GetProfile(cfg, o.name); err != nil {
+			return err
+		}
+	}
+
+	if o.local {
+		localCfg, err := cfg.Local()
+		if err != nil {
+			return err
+		}
+		if o.clear {
+			err = localCfg.Delete("profile")
+		} else {
+			err = localCfg.Set("profile", o.name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write the local configuration: %w", err)
+		}
+	} else {
+		if err := cfg.Set("", "profile", o.name); err != nil {
+			return err
+		}
+		if err := cfg.Write(); err != nil {
+			return fmt.Errorf("failed to write the configuration: %w", err)
+		}
+	}
+
+	scope := ""
+	if o.local {
+		scope = " in this repository"
+	}
+	c := o.io.Color()
+	if o.clear {
+		fmt.Fprintf(o.io.StdOut, "%s Stopped using a profile%s.\n", c.GreenCheck(), scope)
+	} else {
+		fmt.Fprintf(o.io.StdOut, "%s Using profile %s%s.\n", c.GreenCheck(), o.name, scope)
+	}
+
+	if env := os.Getenv(config.ProfileEnv); env != "" {
+		fmt.Fprintf(o.io.StdErr, "%s %s is set to %q, and overrides this setting.\n", c.WarnIcon(), config.ProfileEnv, env)
+	}
+	return nil
+}
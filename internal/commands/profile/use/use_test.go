@@ -0,0 +1,89 @@
+//go:build !integration
+
+package use
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const profilesConfig = "profiles:\n    bot:\n        host: gitlab.com\n"
+
+func runCommand(t *testing.T, cfg config.Config, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios, cmdtest.WithConfig(cfg))
+	cmd := NewCmdUse(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestProfileUse(t *testing.T) {
+	t.Setenv(config.ProfileEnv, "")
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := config.NewFromString(profilesConfig)
+	output, err := runCommand(t, cfg, "bot")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Using profile bot.\n", output.String())
+	assert.Empty(t, output.Stderr())
+	assert.Equal(t, profilesConfig+"profile: bot\n", mainBuf.String())
+
+	mainBuf.Reset()
+	output, err = runCommand(t, cfg, "--clear")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Stopped using a profile.\n", output.String())
+	name, _ := config.ActiveProfile(cfg)
+	assert.Empty(t, name)
+}
+
+func TestProfileUse_environmentOverride(t *testing.T) {
+	t.Setenv(config.ProfileEnv, "other")
+	defer config.StubWriteConfig(&bytes.Buffer{}, &bytes.Buffer{})()
+
+	output, err := runCommand(t, config.NewFromString(profilesConfig), "bot")
+	require.NoError(t, err)
+
+	assert.Equal(t, "! GLAB_PROFILE is set to \"other\", and overrides this setting.\n", output.Stderr())
+}
+
+func TestProfileUse_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "no profile",
+			cli:     "",
+			wantErr: "specify a profile, or use --clear.",
+		},
+		{
+			name:    "profile and clear",
+			cli:     "bot --clear",
+			wantErr: "specify a profile, or use --clear, not both.",
+		},
+		{
+			name:    "unknown profile",
+			cli:     "work",
+			wantErr: `profile "work" does not exist. Run 'glab profile list' to see the available profiles.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runCommand(t, config.NewFromString(profilesConfig), tc.cli)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package branch
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	branchDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/project/branch/delete"
+	branchListCmd "gitlab.com/gitlab-org/cli/internal/commands/project/branch/list"
+	branchProtectCmd "gitlab.com/gitlab-org/cli/internal/commands/project/branch/protect"
+	branchUnprotectCmd "gitlab.com/gitlab-org/cli/internal/commands/project/branch/unprotect"
+)
+
+func NewCmdBranch(f cmdutils.Factory) *cobra.Command {
+	branchCmd := &cobra.Command{
+		Use:   "branch <command> [flags]",
+		Short: `Manage branches and branch protection of a repository.`,
+		Long: heredoc.Doc(`
+			List, delete, protect, and unprotect the branches of a repository on GitLab.
+		`),
+	}
+
+	branchCmd.AddCommand(branchListCmd.NewCmdList(f))
+	branchCmd.AddCommand(branchDeleteCmd.NewCmdDelete(f))
+	branchCmd.AddCommand(branchProtectCmd.NewCmdProtect(f))
+	branchCmd.AddCommand(branchUnprotectCmd.NewCmdUnprotect(f))
+
+	return branchCmd
+}
@@ -0,0 +1,209 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	branches    []string
+	merged      bool
+	olderThan   int
+	dryRun      bool
+	forceDelete bool
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete [<branch>...] [flags]",
+		Short: `Delete branches of a repository.`,
+		Long: heredoc.Doc(`
+			Delete one or more branches of a repository.
+
+			With --merged, delete every branch that is merged into the default branch
+			instead. The default branch and protected branches are never deleted this way.
+			Use --older-than to only delete merged branches whose last commit is older than
+			the given number of days, and --dry-run to see which branches would be deleted.
+		`),
+		Example: heredoc.Doc(`
+			# Delete two branches
+			$ glab repo branch delete feature-a feature-b
+
+			# Preview which merged branches without commits in the last 90 days would be deleted
+			$ glab repo branch delete --merged --older-than 90 --dry-run
+
+			# Delete them without prompting
+			$ glab repo branch delete --merged --older-than 90 --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.branches = args
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.BoolVar(&opts.merged, "merged", false, "Delete all branches merged into the default branch.")
+	fl.IntVar(&opts.olderThan, "older-than", 0, "With --merged, only delete branches whose last commit is older than this number of days.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "List the branches to delete without deleting them.")
+	fl.BoolVarP(&opts.forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if o.merged && len(o.branches) > 0 {
+		return &cmdutils.FlagError{Err: errors.New("specify either branch names or --merged, not both.")}
+	}
+
+	if !o.merged && len(o.branches) == 0 {
+		return &cmdutils.FlagError{Err: errors.New("specify the branches to delete, or use --merged.")}
+	}
+
+	if o.olderThan < 0 {
+		return &cmdutils.FlagError{Err: errors.New("--older-than must be a positive number of days.")}
+	}
+
+	if o.olderThan > 0 && !o.merged {
+		return &cmdutils.FlagError{Err: errors.New("--older-than can only be used with --merged.")}
+	}
+
+	if !o.forceDelete && !o.dryRun && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	branches := o.branches
+	if o.merged {
+		branches, err = o.mergedBranches(client, repo)
+		if err != nil {
+			return err
+		}
+
+		if len(branches) == 0 {
+			fmt.Fprintf(o.io.StdOut, "No merged branches to delete in %s.\n", repo.FullName())
+			return nil
+		}
+	}
+
+	if o.dryRun {
+		for _, branch := range branches {
+			fmt.Fprintf(o.io.StdOut, "Would delete %s\n", branch)
+		}
+		fmt.Fprintf(o.io.StdOut, "%s would be deleted from %s.\n", branchCount(len(branches)), repo.FullName())
+		return nil
+	}
+
+	if !o.forceDelete {
+		err = o.io.Confirm(ctx, &o.forceDelete, fmt.Sprintf("Delete %s from %s?", branchCount(len(branches)), repo.FullName()))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceDelete {
+		return cmdutils.CancelError()
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, branch := range branches {
+		resp, err := client.Branches.DeleteBranch(repo.FullName(), branch)
+		if err != nil {
+			failed++
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				fmt.Fprintf(o.io.StdErr, "%s Branch %s not found.\n", c.FailedIcon(), branch)
+			} else {
+				fmt.Fprintf(o.io.StdErr, "%s Failed to delete branch %s: %s\n", c.FailedIcon(), branch, err)
+			}
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted branch %s.\n", c.RedCheck(), branch)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %s.", branchCount(failed))
+	}
+
+	return nil
+}
+
+// mergedBranches returns the branches merged into the default branch that
+// may be deleted: never the default branch or a protected branch.
+func (o *options) mergedBranches(client *gitlab.Client, repo glrepo.Interface) ([]string, error) {
+	all, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Branch, *gitlab.Response, error) {
+		return client.Branches.ListBranches(repo.FullName(), &gitlab.ListBranchesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, "failed to list branches.")
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -o.olderThan)
+
+	var branches []string
+	for _, branch := range all {
+		if !branch.Merged || branch.Default || branch.Protected {
+			continue
+		}
+		if o.olderThan > 0 {
+			if branch.Commit == nil || branch.Commit.CommittedDate == nil || !branch.Commit.CommittedDate.Before(cutoff) {
+				continue
+			}
+		}
+		branches = append(branches, branch.Name)
+	}
+
+	return branches, nil
+}
+
+func branchCount(n int) string {
+	if n == 1 {
+		return "1 branch"
+	}
+	return fmt.Sprintf("%d branches", n)
+}
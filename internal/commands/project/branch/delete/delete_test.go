@@ -0,0 +1,120 @@
+//go:build !integration
+
+package delete
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestBranchDelete(t *testing.T) {
+	daysAgo := func(days int) *gitlab.Commit {
+		return &gitlab.Commit{CommittedDate: gitlab.Ptr(time.Now().AddDate(0, 0, -days))}
+	}
+	branches := []*gitlab.Branch{
+		{Name: "main", Default: true, Merged: true, Commit: daysAgo(200)},
+		{Name: "release-1", Protected: true, Merged: true, Commit: daysAgo(200)},
+		{Name: "old-merged", Merged: true, Commit: daysAgo(120)},
+		{Name: "recent-merged", Merged: true, Commit: daysAgo(10)},
+		{Name: "old-unmerged", Commit: daysAgo(300)},
+	}
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	tests := []struct {
+		name       string
+		cli        string
+		setup      func(tc *gitlabtesting.TestClient)
+		wantStdout string
+		wantStderr string
+		wantErr    string
+	}{
+		{
+			name: "deletes named branches",
+			cli:  "feature-a missing --yes",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "feature-a").Return(nil, nil)
+				tc.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "missing").Return(notFound, gitlab.ErrNotFound)
+			},
+			wantStdout: "✓ Deleted branch feature-a.\n",
+			wantStderr: "x Branch missing not found.\n",
+			wantErr:    "failed to delete 1 branch.",
+		},
+		{
+			name: "deletes merged branches older than a number of days",
+			cli:  "--merged --older-than 90 --yes",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+				tc.MockBranches.EXPECT().DeleteBranch("OWNER/REPO", "old-merged").Return(nil, nil)
+			},
+			wantStdout: "✓ Deleted branch old-merged.\n",
+		},
+		{
+			name: "dry run of merged branches",
+			cli:  "--merged --dry-run",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				Would delete old-merged
+				Would delete recent-merged
+				2 branches would be deleted from OWNER/REPO.
+			`),
+		},
+		{
+			name: "nothing to delete",
+			cli:  "--merged --older-than 365 --yes",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any(), gomock.Any()).Return(branches, &gitlab.Response{}, nil)
+			},
+			wantStdout: "No merged branches to delete in OWNER/REPO.\n",
+		},
+		{
+			name:    "names and merged",
+			cli:     "feature-a --merged --yes",
+			wantErr: "specify either branch names or --merged, not both.",
+		},
+		{
+			name:    "older than without merged",
+			cli:     "feature-a --older-than 30 --yes",
+			wantErr: "--older-than can only be used with --merged.",
+		},
+		{
+			name:    "requires --yes when not interactive",
+			cli:     "feature-a",
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc := gitlabtesting.NewTestClient(t)
+			if tt.setup != nil {
+				tt.setup(tc)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, out.OutBuf.String())
+			assert.Equal(t, tt.wantStderr, out.ErrBuf.String())
+		})
+	}
+}
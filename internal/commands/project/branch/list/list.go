@@ -0,0 +1,136 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	search       string
+	page         int
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the branches of a repository.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab repo branch list
+			$ glab repo branch list --search feature -R group/project
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.search, "search", "s", "", "Only list branches whose name contains this string.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	fl.StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    int64(o.page),
+			PerPage: int64(o.perPage),
+		},
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+
+	branches, _, err := client.Branches.ListBranches(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list branches.")
+	}
+
+	if o.outputFormat == "json" {
+		branchesJSON, _ := json.Marshal(branches)
+		fmt.Fprintln(o.io.StdOut, string(branchesJSON))
+		return nil
+	}
+
+	if len(branches) == 0 {
+		fmt.Fprintf(o.io.StdOut, "No branches available on %s.\n", repo.FullName())
+		return nil
+	}
+
+	fmt.Fprintf(o.io.StdOut, "Showing %d branches on %s. (Page %d)\n\n%s", len(branches), repo.FullName(), o.page, displayBranches(o.io, branches))
+	return nil
+}
+
+func displayBranches(io *iostreams.IOStreams, branches []*gitlab.Branch) string {
+	c := io.Color()
+	table := tableprinter.NewTablePrinter()
+
+	for _, branch := range branches {
+		var status []string
+		if branch.Default {
+			status = append(status, c.Green("default"))
+		}
+		if branch.Protected {
+			status = append(status, c.Yellow("protected"))
+		}
+		if branch.Merged {
+			status = append(status, c.Blue("merged"))
+		}
+
+		table.AddCell(branch.Name)
+		table.AddCell(strings.Join(status, ", "))
+		if branch.Commit != nil {
+			table.AddCell(c.Gray(branch.Commit.ShortID))
+			if branch.Commit.CommittedDate != nil {
+				table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(*branch.Commit.CommittedDate)))
+			}
+		}
+		table.EndRow()
+	}
+
+	return table.String()
+}
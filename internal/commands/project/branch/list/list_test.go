@@ -0,0 +1,46 @@
+//go:build !integration
+
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestBranchList(t *testing.T) {
+	t.Run("text", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockBranches.EXPECT().ListBranches("OWNER/REPO", &gitlab.ListBranchesOptions{
+			ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+			Search:      gitlab.Ptr("feat"),
+		}).Return([]*gitlab.Branch{
+			{Name: "main", Default: true, Protected: true, Commit: &gitlab.Commit{ShortID: "abc1234"}},
+			{Name: "feature", Merged: true, Commit: &gitlab.Commit{ShortID: "def5678"}},
+		}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("--search feat")
+		require.NoError(t, err)
+		assert.Equal(t, "Showing 2 branches on OWNER/REPO. (Page 1)\n\nmain\tdefault, protected\tabc1234\nfeature\tmerged\tdef5678\n", out.OutBuf.String())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockBranches.EXPECT().ListBranches("OWNER/REPO", gomock.Any()).Return(nil, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("")
+		require.NoError(t, err)
+		assert.Equal(t, "No branches available on OWNER/REPO.\n", out.OutBuf.String())
+	})
+}
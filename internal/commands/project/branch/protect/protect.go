@@ -0,0 +1,217 @@
+package protect
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/accesslevel"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	branch            string
+	allowedToPush     accesslevel.AccessLevel
+	allowedToMerge    accesslevel.AccessLevel
+	allowForcePush    bool
+	codeOwnerApproval bool
+
+	allowedToPushChanged     bool
+	allowedToMergeChanged    bool
+	forcePushChanged         bool
+	codeOwnerApprovalChanged bool
+}
+
+// protectionLevels are the access levels GitLab accepts for protected branches and tags.
+var protectionLevels = map[gitlab.AccessLevelValue]bool{
+	gitlab.NoPermissions:         true,
+	gitlab.DeveloperPermissions:  true,
+	gitlab.MaintainerPermissions: true,
+	gitlab.AdminPermissions:      true,
+}
+
+func NewCmdProtect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:             f.IO(),
+		gitlabClient:   f.GitLabClient,
+		baseRepo:       f.BaseRepo,
+		allowedToPush:  accesslevel.AccessLevel{Value: gitlab.MaintainerPermissions},
+		allowedToMerge: accesslevel.AccessLevel{Value: gitlab.MaintainerPermissions},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "protect <branch> [flags]",
+		Short: `Protect a branch, or update the protection of a protected branch.`,
+		Long: heredoc.Doc(`
+			Protect a branch, or a group of branches with a wildcard like 'release/*'.
+
+			Access levels are one of: no, developer, maintainer, admin. 'developer' allows
+			developers and maintainers, 'no' allows no one.
+
+			If the branch is already protected, only the settings given with flags change:
+			--allowed-to-push and --allowed-to-merge replace the role-based push and merge
+			access levels. Access granted to specific users, groups, or deploy keys is kept.
+		`),
+		Example: heredoc.Doc(`
+			# Only maintainers can push and merge
+			$ glab repo branch protect main
+
+			# Developers can merge, no one can push, and code owners must approve
+			$ glab repo branch protect 'release/*' --allowed-to-merge developer --allowed-to-push no --code-owner-approval
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "false",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.branch = args[0]
+			opts.allowedToPushChanged = cmd.Flags().Changed("allowed-to-push")
+			opts.allowedToMergeChanged = cmd.Flags().Changed("allowed-to-merge")
+			opts.forcePushChanged = cmd.Flags().Changed("allow-force-push")
+			opts.codeOwnerApprovalChanged = cmd.Flags().Changed("code-owner-approval")
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.Var(&opts.allowedToPush, "allowed-to-push", "Access level allowed to push: no, developer, maintainer, admin.")
+	fl.Var(&opts.allowedToMerge, "allowed-to-merge", "Access level allowed to merge: no, developer, maintainer, admin.")
+	fl.BoolVar(&opts.allowForcePush, "allow-force-push", false, "Allow users who can push to force push.")
+	fl.BoolVar(&opts.codeOwnerApproval, "code-owner-approval", false, "Require approval from code owners for changes to files they own.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if !protectionLevels[o.allowedToPush.Value] {
+		return &cmdutils.FlagError{Err: errors.New("--allowed-to-push must be one of: no, developer, maintainer, admin.")}
+	}
+
+	if !protectionLevels[o.allowedToMerge.Value] {
+		return &cmdutils.FlagError{Err: errors.New("--allowed-to-merge must be one of: no, developer, maintainer, admin.")}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	current, resp, err := client.ProtectedBranches.GetProtectedBranch(repo.FullName(), o.branch)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return cmdutils.WrapError(err, "failed to get protected branch.")
+	}
+
+	c := o.io.Color()
+
+	if current == nil {
+		_, _, err = client.ProtectedBranches.ProtectRepositoryBranches(repo.FullName(), &gitlab.ProtectRepositoryBranchesOptions{
+			Name:                      gitlab.Ptr(o.branch),
+			PushAccessLevel:           gitlab.Ptr(o.allowedToPush.Value),
+			MergeAccessLevel:          gitlab.Ptr(o.allowedToMerge.Value),
+			AllowForcePush:            gitlab.Ptr(o.allowForcePush),
+			CodeOwnerApprovalRequired: gitlab.Ptr(o.codeOwnerApproval),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to protect branch.")
+		}
+
+		fmt.Fprintf(o.io.StdOut, "%s Protected branch %s (push: %s, merge: %s).\n", c.GreenCheck(), o.branch, o.allowedToPush.String(), o.allowedToMerge.String())
+		return nil
+	}
+
+	updateOpts := &gitlab.UpdateProtectedBranchOptions{}
+	if o.allowedToPushChanged {
+		updateOpts.AllowedToPush = accessLevelChanges(current.PushAccessLevels, o.allowedToPush.Value)
+	} else {
+		o.allowedToPush.Value = roleAccessLevel(current.PushAccessLevels)
+	}
+	if o.allowedToMergeChanged {
+		updateOpts.AllowedToMerge = accessLevelChanges(current.MergeAccessLevels, o.allowedToMerge.Value)
+	} else {
+		o.allowedToMerge.Value = roleAccessLevel(current.MergeAccessLevels)
+	}
+	if o.forcePushChanged {
+		updateOpts.AllowForcePush = gitlab.Ptr(o.allowForcePush)
+	}
+	if o.codeOwnerApprovalChanged {
+		updateOpts.CodeOwnerApprovalRequired = gitlab.Ptr(o.codeOwnerApproval)
+	}
+
+	_, _, err = client.ProtectedBranches.UpdateProtectedBranch(repo.FullName(), o.branch, updateOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to update protected branch.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated protection of branch %s (push: %s, merge: %s).\n", c.GreenCheck(), o.branch, o.allowedToPush.String(), o.allowedToMerge.String())
+	return nil
+}
+
+// accessLevelChanges returns the changes that replace the role-based rules of
+// current with level, or nil if there are none. Rules for specific users,
+// groups, and deploy keys are kept.
+func accessLevelChanges(current []*gitlab.BranchAccessDescription, level gitlab.AccessLevelValue) *[]*gitlab.BranchPermissionOptions {
+	var changes []*gitlab.BranchPermissionOptions
+	found := false
+
+	for _, rule := range current {
+		if rule.UserID != 0 || rule.GroupID != 0 || rule.DeployKeyID != 0 {
+			continue
+		}
+		if rule.AccessLevel == level {
+			found = true
+			continue
+		}
+		changes = append(changes, &gitlab.BranchPermissionOptions{
+			ID:      gitlab.Ptr(rule.ID),
+			Destroy: gitlab.Ptr(true),
+		})
+	}
+
+	if !found {
+		changes = append(changes, &gitlab.BranchPermissionOptions{AccessLevel: gitlab.Ptr(level)})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	return &changes
+}
+
+// roleAccessLevel returns the role-based access level of current, or
+// NoPermissions if only specific users, groups, or deploy keys have access.
+func roleAccessLevel(current []*gitlab.BranchAccessDescription) gitlab.AccessLevelValue {
+	for _, rule := range current {
+		if rule.UserID == 0 && rule.GroupID == 0 && rule.DeployKeyID == 0 {
+			return rule.AccessLevel
+		}
+	}
+	return gitlab.NoPermissions
+}
@@ -0,0 +1,116 @@
+//go:build !integration
+
+package protect
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestBranchProtect(t *testing.T) {
+	notFound := &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	tests := []struct {
+		name       string
+		cli        string
+		setup      func(tc *gitlabtesting.TestClient)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "protects an unprotected branch",
+			cli:  "release/* --allowed-to-push no --allowed-to-merge developer --code-owner-approval",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "release/*").Return(nil, notFound, gitlab.ErrNotFound)
+				tc.MockProtectedBranches.EXPECT().ProtectRepositoryBranches("OWNER/REPO", &gitlab.ProtectRepositoryBranchesOptions{
+					Name:                      gitlab.Ptr("release/*"),
+					PushAccessLevel:           gitlab.Ptr(gitlab.NoPermissions),
+					MergeAccessLevel:          gitlab.Ptr(gitlab.DeveloperPermissions),
+					AllowForcePush:            gitlab.Ptr(false),
+					CodeOwnerApprovalRequired: gitlab.Ptr(true),
+				}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+			},
+			wantStdout: "✓ Protected branch release/* (push: no, merge: developer).\n",
+		},
+		{
+			name: "updates the role-based rules of a protected branch",
+			cli:  "main --allowed-to-merge developer",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+					Name: "main",
+					PushAccessLevels: []*gitlab.BranchAccessDescription{
+						{ID: 1, AccessLevel: gitlab.MaintainerPermissions},
+						{ID: 2, AccessLevel: gitlab.MaintainerPermissions, UserID: 42},
+					},
+					MergeAccessLevels: []*gitlab.BranchAccessDescription{
+						{ID: 3, AccessLevel: gitlab.MaintainerPermissions},
+					},
+				}, nil, nil)
+				tc.MockProtectedBranches.EXPECT().UpdateProtectedBranch("OWNER/REPO", "main", &gitlab.UpdateProtectedBranchOptions{
+					AllowedToMerge: &[]*gitlab.BranchPermissionOptions{
+						{ID: gitlab.Ptr(int64(3)), Destroy: gitlab.Ptr(true)},
+						{AccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions)},
+					},
+				}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+			},
+			wantStdout: "✓ Updated protection of branch main (push: maintainer, merge: developer).\n",
+		},
+		{
+			name: "keeps the access level that isn't given",
+			cli:  "main --allowed-to-push no",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProtectedBranches.EXPECT().GetProtectedBranch("OWNER/REPO", "main").Return(&gitlab.ProtectedBranch{
+					Name: "main",
+					PushAccessLevels: []*gitlab.BranchAccessDescription{
+						{ID: 1, AccessLevel: gitlab.MaintainerPermissions},
+					},
+					MergeAccessLevels: []*gitlab.BranchAccessDescription{
+						{ID: 2, AccessLevel: gitlab.MaintainerPermissions, GroupID: 9},
+						{ID: 3, AccessLevel: gitlab.DeveloperPermissions},
+					},
+				}, nil, nil)
+				tc.MockProtectedBranches.EXPECT().UpdateProtectedBranch("OWNER/REPO", "main", &gitlab.UpdateProtectedBranchOptions{
+					AllowedToPush: &[]*gitlab.BranchPermissionOptions{
+						{ID: gitlab.Ptr(int64(1)), Destroy: gitlab.Ptr(true)},
+						{AccessLevel: gitlab.Ptr(gitlab.NoPermissions)},
+					},
+				}).Return(&gitlab.ProtectedBranch{}, nil, nil)
+			},
+			wantStdout: "✓ Updated protection of branch main (push: no, merge: developer).\n",
+		},
+		{
+			name:    "invalid access level",
+			cli:     "main --allowed-to-push guest",
+			wantErr: "--allowed-to-push must be one of: no, developer, maintainer, admin.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc := gitlabtesting.NewTestClient(t)
+			if tt.setup != nil {
+				tt.setup(tc)
+			}
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, out.OutBuf.String())
+		})
+	}
+}
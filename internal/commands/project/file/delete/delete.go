@@ -0,0 +1,126 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	path        string
+	branch      string
+	message     string
+	forceDelete bool
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <path> [flags]",
+		Short: `Delete a file from a repository.`,
+		Example: heredoc.Doc(`
+			$ glab repo file delete old-config.yml
+			$ glab repo file delete old-config.yml --branch cleanup -m "Remove old config" --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to commit to. (default: the default branch)")
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. (default: 'Delete <path>')")
+	fl.BoolVarP(&opts.forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if !o.forceDelete && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--yes or -y flag is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := o.branch
+	if branch == "" {
+		project, err := repo.Project(client)
+		if err != nil {
+			return err
+		}
+		branch = project.DefaultBranch
+	}
+
+	if !o.forceDelete {
+		err = o.io.Confirm(ctx, &o.forceDelete, fmt.Sprintf("Delete %s from branch %s of %s?", o.path, branch, repo.FullName()))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceDelete {
+		return cmdutils.CancelError()
+	}
+
+	message := o.message
+	if message == "" {
+		message = "Delete " + o.path
+	}
+
+	resp, err := client.RepositoryFiles.DeleteFile(repo.FullName(), o.path, &gitlab.DeleteFileOptions{
+		Branch:        gitlab.Ptr(branch),
+		CommitMessage: gitlab.Ptr(message),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("file %s not found on branch %s of %s.", o.path, branch, repo.FullName())
+		}
+		return cmdutils.WrapError(err, "failed to delete file.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Deleted %s from branch %s.\n", o.io.Color().RedCheck(), o.path, branch)
+	return nil
+}
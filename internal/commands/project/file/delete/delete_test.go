@@ -0,0 +1,38 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestFileDelete(t *testing.T) {
+	t.Run("deletes the file", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositoryFiles.EXPECT().DeleteFile("OWNER/REPO", "old.yml", &gitlab.DeleteFileOptions{
+			Branch:        gitlab.Ptr("cleanup"),
+			CommitMessage: gitlab.Ptr("Delete old.yml"),
+		}).Return(nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("old.yml --branch cleanup --yes")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Deleted old.yml from branch cleanup.\n", out.OutBuf.String())
+	})
+
+	t.Run("requires --yes when not interactive", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false)
+
+		_, err := exec("old.yml")
+		assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+	})
+}
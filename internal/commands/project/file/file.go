@@ -0,0 +1,27 @@
+package file
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	fileDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/project/file/delete"
+	fileGetCmd "gitlab.com/gitlab-org/cli/internal/commands/project/file/get"
+	filePutCmd "gitlab.com/gitlab-org/cli/internal/commands/project/file/put"
+)
+
+func NewCmdFile(f cmdutils.Factory) *cobra.Command {
+	fileCmd := &cobra.Command{
+		Use:   "file <command> [flags]",
+		Short: `Work with files in a repository, without cloning it.`,
+		Long: heredoc.Doc(`
+			Read, write, and delete single files of a repository through the GitLab API.
+		`),
+	}
+
+	fileCmd.AddCommand(fileGetCmd.NewCmdGet(f))
+	fileCmd.AddCommand(filePutCmd.NewCmdPut(f))
+	fileCmd.AddCommand(fileDeleteCmd.NewCmdDelete(f))
+
+	return fileCmd
+}
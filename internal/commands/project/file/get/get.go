@@ -0,0 +1,103 @@
+package get
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	path   string
+	ref    string
+	output string
+}
+
+func NewCmdGet(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "get <path> [flags]",
+		Short: `Get the content of a file in a repository.`,
+		Long: heredoc.Doc(`
+			Get the content of a file in a repository, and write it to standard output or to a local file.
+		`),
+		Example: heredoc.Doc(`
+			# Print a file from the default branch
+			$ glab repo file get README.md
+
+			# Download a file from a tag of another project
+			$ glab repo file get config/settings.yml --ref v1.2.0 -R group/project --output settings.yml
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVar(&opts.ref, "ref", "", "Branch, tag, or commit to get the file from. (default: the default branch)")
+	fl.StringVarP(&opts.output, "output", "o", "", "Write the file to this path instead of standard output.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	getOpts := &gitlab.GetRawFileOptions{}
+	if o.ref != "" {
+		getOpts.Ref = gitlab.Ptr(o.ref)
+	}
+
+	content, resp, err := client.RepositoryFiles.GetRawFile(repo.FullName(), o.path, getOpts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("file %s not found in %s.", o.path, repo.FullName())
+		}
+		return cmdutils.WrapError(err, "failed to get file.")
+	}
+
+	if o.output == "" {
+		_, err = o.io.StdOut.Write(content)
+		return err
+	}
+
+	if err := os.WriteFile(o.output, content, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Downloaded %s to %s.\n", o.io.Color().GreenCheck(), o.path, o.output)
+	return nil
+}
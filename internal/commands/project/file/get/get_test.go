@@ -0,0 +1,64 @@
+//go:build !integration
+
+package get
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestFileGet(t *testing.T) {
+	t.Run("writes to stdout", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositoryFiles.EXPECT().
+			GetRawFile("OWNER/REPO", "config/settings.yml", &gitlab.GetRawFileOptions{Ref: gitlab.Ptr("v1.0.0")}).
+			Return([]byte("key: value\n"), nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdGet, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("config/settings.yml --ref v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, "key: value\n", out.OutBuf.String())
+	})
+
+	t.Run("writes to a file", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositoryFiles.EXPECT().
+			GetRawFile("OWNER/REPO", "README.md", &gitlab.GetRawFileOptions{}).
+			Return([]byte("# Readme\n"), nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdGet, false, cmdtest.WithGitLabClient(tc.Client))
+
+		output := filepath.Join(t.TempDir(), "README.md")
+		out, err := exec("README.md --output " + output)
+		require.NoError(t, err)
+		assert.Empty(t, out.OutBuf.String())
+		assert.Equal(t, "✓ Downloaded README.md to "+output+".\n", out.ErrBuf.String())
+
+		content, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.Equal(t, "# Readme\n", string(content))
+	})
+
+	t.Run("file not found", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositoryFiles.EXPECT().
+			GetRawFile("OWNER/REPO", "missing.txt", &gitlab.GetRawFileOptions{}).
+			Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, gitlab.ErrNotFound)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdGet, false, cmdtest.WithGitLabClient(tc.Client))
+
+		_, err := exec("missing.txt")
+		assert.EqualError(t, err, "file missing.txt not found in OWNER/REPO.")
+	})
+}
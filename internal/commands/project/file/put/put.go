@@ -0,0 +1,242 @@
+package put
+
+import (
+	"cmp"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"unicode/utf8"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	path         string
+	file         string
+	branch       string
+	startBranch  string
+	message      string
+	createMR     bool
+	targetBranch string
+}
+
+func NewCmdPut(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "put <path> [flags]",
+		Short: `Create or update a file in a repository.`,
+		Long: heredoc.Doc(`
+			Commit local content to a file in a repository. The file is created if it
+			doesn't exist, and updated otherwise.
+
+			The content is read from the file set with --file, or from standard input.
+			If the branch doesn't exist, it is created from --start-branch, or from the
+			default branch of the project.
+		`),
+		Example: heredoc.Doc(`
+			# Update a file on the default branch
+			$ glab repo file put config/settings.yml --file settings.yml -m "Update settings"
+
+			# Commit to a new branch and open a merge request
+			$ cat settings.yml | glab repo file put config/settings.yml -R group/project --branch update-settings --mr
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.path = args[0]
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.file, "file", "f", "", "Read the content from this local file instead of standard input.")
+	fl.StringVarP(&opts.branch, "branch", "b", "", "Branch to commit to. (default: the default branch)")
+	fl.StringVar(&opts.startBranch, "start-branch", "", "Branch to create --branch from, if it doesn't exist. (default: the default branch)")
+	fl.StringVarP(&opts.message, "message", "m", "", "Commit message. (default: 'Add <path>' or 'Update <path>')")
+	fl.BoolVar(&opts.createMR, "mr", false, "Open a merge request for the commit.")
+	fl.StringVar(&opts.targetBranch, "target-branch", "", "Target branch of the merge request. (default: --start-branch or the default branch)")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if o.file == "" && o.io.IsInTTY {
+		return &cmdutils.FlagError{Err: errors.New("no content specified with --file and nothing on STDIN.")}
+	}
+
+	if o.targetBranch != "" && !o.createMR {
+		return &cmdutils.FlagError{Err: errors.New("--target-branch can only be used with --mr.")}
+	}
+
+	// Empty branches are the default branch. When they are set, the branches
+	// are compared again in run, before the commit.
+	if o.createMR && o.branch == cmp.Or(o.targetBranch, o.startBranch) {
+		return &cmdutils.FlagError{Err: errors.New("--mr requires --branch to be different from the target branch of the merge request.")}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	c := o.io.Color()
+
+	content, err := o.readContent()
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return err
+	}
+
+	branch := o.branch
+	if branch == "" {
+		branch = project.DefaultBranch
+	}
+	baseBranch := o.startBranch
+	if baseBranch == "" {
+		baseBranch = project.DefaultBranch
+	}
+	targetBranch := o.targetBranch
+	if targetBranch == "" {
+		targetBranch = baseBranch
+	}
+	if o.createMR && targetBranch == branch {
+		return fmt.Errorf("cannot open a merge request: source and target branch are both %s.", branch)
+	}
+
+	// The ref to check the current file against is the branch, if it
+	// already exists, or the branch it will be created from.
+	ref := branch
+	var startBranch *string
+	_, resp, err := client.Branches.GetBranch(project.ID, branch)
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return cmdutils.WrapError(err, "failed to get branch.")
+		}
+		ref = baseBranch
+		startBranch = gitlab.Ptr(baseBranch)
+	}
+
+	exists := true
+	_, resp, err = client.RepositoryFiles.GetFileMetaData(project.ID, o.path, &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr(ref)})
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return cmdutils.WrapError(err, "failed to get file.")
+		}
+		exists = false
+	}
+
+	message := o.message
+	encoding, encoded := encodeContent(content)
+
+	if exists {
+		if message == "" {
+			message = "Update " + o.path
+		}
+		_, _, err = client.RepositoryFiles.UpdateFile(project.ID, o.path, &gitlab.UpdateFileOptions{
+			Branch:        gitlab.Ptr(branch),
+			StartBranch:   startBranch,
+			Encoding:      gitlab.Ptr(encoding),
+			Content:       gitlab.Ptr(encoded),
+			CommitMessage: gitlab.Ptr(message),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to update file.")
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Updated %s on branch %s.\n", c.GreenCheck(), o.path, branch)
+	} else {
+		if message == "" {
+			message = "Add " + o.path
+		}
+		_, _, err = client.RepositoryFiles.CreateFile(project.ID, o.path, &gitlab.CreateFileOptions{
+			Branch:        gitlab.Ptr(branch),
+			StartBranch:   startBranch,
+			Encoding:      gitlab.Ptr(encoding),
+			Content:       gitlab.Ptr(encoded),
+			CommitMessage: gitlab.Ptr(message),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to create file.")
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Created %s on branch %s.\n", c.GreenCheck(), o.path, branch)
+	}
+
+	if !o.createMR {
+		return nil
+	}
+
+	mr, _, err := client.MergeRequests.CreateMergeRequest(project.ID, &gitlab.CreateMergeRequestOptions{
+		Title:        gitlab.Ptr(message),
+		SourceBranch: gitlab.Ptr(branch),
+		TargetBranch: gitlab.Ptr(targetBranch),
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create merge request.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Opened merge request !%d: %s\n", c.GreenCheck(), mr.IID, mr.WebURL)
+	return nil
+}
+
+func (o *options) readContent() ([]byte, error) {
+	if o.file != "" {
+		return os.ReadFile(o.file)
+	}
+
+	defer o.io.In.Close()
+	content, err := io.ReadAll(o.io.In)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content from STDIN: %w", err)
+	}
+	return content, nil
+}
+
+// encodeContent returns the encoding and the content to send to the API.
+// Binary content must be base64-encoded.
+func encodeContent(content []byte) (string, string) {
+	if utf8.Valid(content) {
+		return "text", string(content)
+	}
+	return "base64", base64.StdEncoding.EncodeToString(content)
+}
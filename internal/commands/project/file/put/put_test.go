@@ -0,0 +1,122 @@
+//go:build !integration
+
+package put
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var notFound = &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+func TestFilePut(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		stdin      string
+		setup      func(tc *gitlabtesting.TestClient)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name:  "updates an existing file on the default branch",
+			cli:   "config.yml",
+			stdin: "key: value\n",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().GetBranch(int64(1), "main").Return(&gitlab.Branch{Name: "main"}, nil, nil)
+				tc.MockRepositoryFiles.EXPECT().
+					GetFileMetaData(int64(1), "config.yml", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("main")}).
+					Return(&gitlab.File{}, nil, nil)
+				tc.MockRepositoryFiles.EXPECT().UpdateFile(int64(1), "config.yml", &gitlab.UpdateFileOptions{
+					Branch:        gitlab.Ptr("main"),
+					Encoding:      gitlab.Ptr("text"),
+					Content:       gitlab.Ptr("key: value\n"),
+					CommitMessage: gitlab.Ptr("Update config.yml"),
+				}).Return(&gitlab.FileInfo{}, nil, nil)
+			},
+			wantStdout: "✓ Updated config.yml on branch main.\n",
+		},
+		{
+			name:  "creates a file on a new branch and opens a merge request",
+			cli:   "config.yml --branch update-config -m 'Add config' --mr",
+			stdin: "\xff\xfe",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockBranches.EXPECT().GetBranch(int64(1), "update-config").Return(nil, notFound, gitlab.ErrNotFound)
+				tc.MockRepositoryFiles.EXPECT().
+					GetFileMetaData(int64(1), "config.yml", &gitlab.GetFileMetaDataOptions{Ref: gitlab.Ptr("main")}).
+					Return(nil, notFound, gitlab.ErrNotFound)
+				tc.MockRepositoryFiles.EXPECT().CreateFile(int64(1), "config.yml", &gitlab.CreateFileOptions{
+					Branch:        gitlab.Ptr("update-config"),
+					StartBranch:   gitlab.Ptr("main"),
+					Encoding:      gitlab.Ptr("base64"),
+					Content:       gitlab.Ptr("//4="),
+					CommitMessage: gitlab.Ptr("Add config"),
+				}).Return(&gitlab.FileInfo{}, nil, nil)
+				tc.MockMergeRequests.EXPECT().CreateMergeRequest(int64(1), &gitlab.CreateMergeRequestOptions{
+					Title:        gitlab.Ptr("Add config"),
+					SourceBranch: gitlab.Ptr("update-config"),
+					TargetBranch: gitlab.Ptr("main"),
+				}).Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 3, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/3"}}, nil, nil)
+			},
+			wantStdout: "✓ Created config.yml on branch update-config.\n✓ Opened merge request !3: https://gitlab.com/OWNER/REPO/-/merge_requests/3\n",
+		},
+		{
+			name:    "merge request from the default branch",
+			cli:     "config.yml --mr",
+			stdin:   "x",
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "--mr requires --branch to be different from the target branch of the merge request.",
+		},
+		{
+			name:    "merge request to the same branch",
+			cli:     "config.yml --branch fix --target-branch fix --mr",
+			stdin:   "x",
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "--mr requires --branch to be different from the target branch of the merge request.",
+		},
+		{
+			name:    "merge request from the default branch set with --branch",
+			cli:     "config.yml --branch main --mr",
+			stdin:   "x",
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "cannot open a merge request: source and target branch are both main.",
+		},
+		{
+			name:    "target branch without merge request",
+			cli:     "config.yml --target-branch main",
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "--target-branch can only be used with --mr.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).
+				Return(&gitlab.Project{ID: 1, DefaultBranch: "main"}, nil, nil).AnyTimes()
+			tt.setup(tc)
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdPut, false,
+				cmdtest.WithGitLabClient(tc.Client),
+				cmdtest.WithStdin(tt.stdin),
+			)
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, out.OutBuf.String())
+		})
+	}
+}
@@ -5,17 +5,21 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	repoCmdArchive "gitlab.com/gitlab-org/cli/internal/commands/project/archive"
+	repoCmdBranch "gitlab.com/gitlab-org/cli/internal/commands/project/branch"
 	repoCmdClone "gitlab.com/gitlab-org/cli/internal/commands/project/clone"
 	repoCmdContributors "gitlab.com/gitlab-org/cli/internal/commands/project/contributors"
 	repoCmdCreate "gitlab.com/gitlab-org/cli/internal/commands/project/create"
 	repoCmdDelete "gitlab.com/gitlab-org/cli/internal/commands/project/delete"
+	repoCmdFile "gitlab.com/gitlab-org/cli/internal/commands/project/file"
 	repoCmdFork "gitlab.com/gitlab-org/cli/internal/commands/project/fork"
 	repoCmdList "gitlab.com/gitlab-org/cli/internal/commands/project/list"
 	repoCmdMembers "gitlab.com/gitlab-org/cli/internal/commands/project/members"
 	repoCmdMirror "gitlab.com/gitlab-org/cli/internal/commands/project/mirror"
 	repoCmdPublish "gitlab.com/gitlab-org/cli/internal/commands/project/publish"
 	repoCmdSearch "gitlab.com/gitlab-org/cli/internal/commands/project/search"
+	repoCmdTag "gitlab.com/gitlab-org/cli/internal/commands/project/tag"
 	repoCmdTransfer "gitlab.com/gitlab-org/cli/internal/commands/project/transfer"
+	repoCmdTree "gitlab.com/gitlab-org/cli/internal/commands/project/tree"
 	repoCmdUpdate "gitlab.com/gitlab-org/cli/internal/commands/project/update"
 	repoCmdView "gitlab.com/gitlab-org/cli/internal/commands/project/view"
 )
@@ -42,6 +46,10 @@ func NewCmdRepo(f cmdutils.Factory) *cobra.Command {
 	repoCmd.AddCommand(repoCmdView.NewCmdView(f))
 	repoCmd.AddCommand(repoCmdMirror.NewCmdMirror(f))
 	repoCmd.AddCommand(repoCmdPublish.NewCmdPublish(f))
+	repoCmd.AddCommand(repoCmdFile.NewCmdFile(f))
+	repoCmd.AddCommand(repoCmdTree.NewCmdTree(f))
+	repoCmd.AddCommand(repoCmdBranch.NewCmdBranch(f))
+	repoCmd.AddCommand(repoCmdTag.NewCmdTag(f))
 
 	return repoCmd
 }
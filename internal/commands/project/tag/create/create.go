@@ -0,0 +1,105 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	name    string
+	ref     string
+	message string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> [flags]",
+		Short: `Create a tag in a repository.`,
+		Long: heredoc.Doc(`
+			Create a tag in a repository. With --message, an annotated tag is created.
+		`),
+		Example: heredoc.Doc(`
+			# Tag the head of the default branch
+			$ glab repo tag create v1.2.0
+
+			# Create an annotated tag from a commit
+			$ glab repo tag create v1.2.0 --ref 4f2a1c3 -m "Release 1.2.0"
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "false",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVar(&opts.ref, "ref", "", "Branch, tag, or commit to create the tag from. (default: the default branch)")
+	fl.StringVarP(&opts.message, "message", "m", "", "Message of an annotated tag.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	ref := o.ref
+	if ref == "" {
+		project, err := repo.Project(client)
+		if err != nil {
+			return err
+		}
+		ref = project.DefaultBranch
+	}
+
+	createOpts := &gitlab.CreateTagOptions{
+		TagName: gitlab.Ptr(o.name),
+		Ref:     gitlab.Ptr(ref),
+	}
+	if o.message != "" {
+		createOpts.Message = gitlab.Ptr(o.message)
+	}
+
+	tag, _, err := client.Tags.CreateTag(repo.FullName(), createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create tag.")
+	}
+
+	target := ref
+	if tag.Commit != nil {
+		target = tag.Commit.ShortID
+	}
+	fmt.Fprintf(o.io.StdOut, "%s Created tag %s at %s.\n", o.io.Color().GreenCheck(), tag.Name, target)
+	return nil
+}
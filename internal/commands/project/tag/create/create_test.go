@@ -0,0 +1,48 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTagCreate(t *testing.T) {
+	t.Run("from the default branch", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+		tc.MockTags.EXPECT().CreateTag("OWNER/REPO", &gitlab.CreateTagOptions{
+			TagName: gitlab.Ptr("v1.0.0"),
+			Ref:     gitlab.Ptr("main"),
+		}).Return(&gitlab.Tag{Name: "v1.0.0", Commit: &gitlab.Commit{ShortID: "abc1234"}}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("v1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created tag v1.0.0 at abc1234.\n", out.OutBuf.String())
+	})
+
+	t.Run("annotated tag from a ref", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockTags.EXPECT().CreateTag("OWNER/REPO", &gitlab.CreateTagOptions{
+			TagName: gitlab.Ptr("v1.0.0"),
+			Ref:     gitlab.Ptr("def5678"),
+			Message: gitlab.Ptr("Release 1.0.0"),
+		}).Return(&gitlab.Tag{Name: "v1.0.0", Commit: &gitlab.Commit{ShortID: "def5678"}}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("v1.0.0 --ref def5678 -m 'Release 1.0.0'")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Created tag v1.0.0 at def5678.\n", out.OutBuf.String())
+	})
+}
@@ -0,0 +1,109 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	tags        []string
+	forceDelete bool
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <tag>... [flags]",
+		Short: `Delete tags of a repository.`,
+		Example: heredoc.Doc(`
+			$ glab repo tag delete v1.2.0-rc1
+			$ glab repo tag delete v1.2.0-rc1 v1.2.0-rc2 --yes
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.tags = args
+
+			if !opts.forceDelete && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.Flags().BoolVarP(&opts.forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	if !o.forceDelete {
+		err = o.io.Confirm(ctx, &o.forceDelete, fmt.Sprintf("Delete %s from %s?", utils.Pluralize(len(o.tags), "tag"), repo.FullName()))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceDelete {
+		return cmdutils.CancelError()
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, tag := range o.tags {
+		resp, err := client.Tags.DeleteTag(repo.FullName(), tag)
+		if err != nil {
+			failed++
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				fmt.Fprintf(o.io.StdErr, "%s Tag %s not found.\n", c.FailedIcon(), tag)
+			} else {
+				fmt.Fprintf(o.io.StdErr, "%s Failed to delete tag %s: %s\n", c.FailedIcon(), tag, err)
+			}
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted tag %s.\n", c.RedCheck(), tag)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %s.", utils.Pluralize(failed, "tag"))
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTagDelete(t *testing.T) {
+	t.Run("deletes tags", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockTags.EXPECT().DeleteTag("OWNER/REPO", "v1.0.0-rc1").Return(nil, nil)
+		tc.MockTags.EXPECT().DeleteTag("OWNER/REPO", "v1.0.0-rc2").Return(nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("v1.0.0-rc1 v1.0.0-rc2 --yes")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Deleted tag v1.0.0-rc1.\n✓ Deleted tag v1.0.0-rc2.\n", out.OutBuf.String())
+	})
+
+	t.Run("requires --yes when not interactive", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false)
+
+		_, err := exec("v1.0.0")
+		assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+	})
+}
@@ -0,0 +1,134 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	search       string
+	orderBy      string
+	sort         string
+	page         int
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the tags of a repository.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab repo tag list
+			$ glab repo tag list --search v1. --order-by version
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.search, "search", "s", "", "Only list tags whose name contains this string.")
+	fl.Var(cmdutils.NewEnumValue([]string{"name", "updated", "version"}, "updated", &opts.orderBy), "order-by", "Order tags by: name, updated, version.")
+	fl.Var(cmdutils.NewEnumValue([]string{"asc", "desc"}, "desc", &opts.sort), "sort", "Sort tags in asc or desc order.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	fl.StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &gitlab.ListTagsOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    int64(o.page),
+			PerPage: int64(o.perPage),
+		},
+		OrderBy: gitlab.Ptr(o.orderBy),
+		Sort:    gitlab.Ptr(o.sort),
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+
+	tags, _, err := client.Tags.ListTags(repo.FullName(), listOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list tags.")
+	}
+
+	if o.outputFormat == "json" {
+		tagsJSON, _ := json.Marshal(tags)
+		fmt.Fprintln(o.io.StdOut, string(tagsJSON))
+		return nil
+	}
+
+	title := utils.NewListTitle("tag")
+	title.RepoName = repo.FullName()
+	title.Page = o.page
+	title.CurrentPageTotal = len(tags)
+
+	fmt.Fprintf(o.io.StdOut, "%s\n%s", title.Describe(), displayTags(o.io, tags))
+	return nil
+}
+
+func displayTags(io *iostreams.IOStreams, tags []*gitlab.Tag) string {
+	c := io.Color()
+	table := tableprinter.NewTablePrinter()
+
+	for _, tag := range tags {
+		table.AddCell(tag.Name)
+		if tag.Protected {
+			table.AddCell(c.Yellow("protected"))
+		} else {
+			table.AddCell("")
+		}
+		if tag.Commit != nil {
+			table.AddCell(c.Gray(tag.Commit.ShortID))
+			if tag.Commit.CommittedDate != nil {
+				table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(*tag.Commit.CommittedDate)))
+			}
+		}
+		table.EndRow()
+	}
+
+	return table.String()
+}
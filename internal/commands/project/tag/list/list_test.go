@@ -0,0 +1,82 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTagList(t *testing.T) {
+	committed := time.Now().Add(-2 * time.Hour)
+	tags := []*gitlab.Tag{
+		{Name: "v1.1.0", Protected: true, Commit: &gitlab.Commit{ShortID: "abc1234", CommittedDate: &committed}},
+		{Name: "v1.0.0", Commit: &gitlab.Commit{ShortID: "def5678"}},
+	}
+
+	t.Run("lists tags", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockTags.EXPECT().ListTags("OWNER/REPO", &gitlab.ListTagsOptions{
+			ListOptions: gitlab.ListOptions{Page: 1, PerPage: 30},
+			OrderBy:     gitlab.Ptr("updated"),
+			Sort:        gitlab.Ptr("desc"),
+		}).Return(tags, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("")
+		require.NoError(t, err)
+		assert.Contains(t, out.OutBuf.String(), "Showing 2 tags on OWNER/REPO.")
+		assert.Regexp(t, `v1\.1\.0\s+protected\s+abc1234\s+about 2 hours ago`, out.OutBuf.String())
+		assert.Regexp(t, `v1\.0\.0\s+def5678`, out.OutBuf.String())
+	})
+
+	t.Run("search, order, and page", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockTags.EXPECT().ListTags("OWNER/REPO", &gitlab.ListTagsOptions{
+			ListOptions: gitlab.ListOptions{Page: 2, PerPage: 10},
+			OrderBy:     gitlab.Ptr("version"),
+			Sort:        gitlab.Ptr("asc"),
+			Search:      gitlab.Ptr("v1."),
+		}).Return(tags[1:], nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("--search v1. --order-by version --sort asc --page 2 --per-page 10")
+		require.NoError(t, err)
+		assert.Contains(t, out.OutBuf.String(), "v1.0.0")
+		assert.NotContains(t, out.OutBuf.String(), "v1.1.0")
+	})
+
+	t.Run("JSON output", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockTags.EXPECT().ListTags("OWNER/REPO", gomock.Any()).Return(tags[1:], nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("--output json")
+		require.NoError(t, err)
+		var got []*gitlab.Tag
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &got))
+		require.Len(t, got, 1)
+		assert.Equal(t, "v1.0.0", got[0].Name)
+		assert.Equal(t, "def5678", got[0].Commit.ShortID)
+	})
+
+	t.Run("invalid order", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+
+		_, err := exec("--order-by date")
+		assert.ErrorContains(t, err, "invalid argument \"date\" for \"--order-by\" flag")
+	})
+}
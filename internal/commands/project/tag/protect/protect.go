@@ -0,0 +1,101 @@
+package protect
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/accesslevel"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	tag             string
+	allowedToCreate accesslevel.AccessLevel
+}
+
+func NewCmdProtect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		baseRepo:        f.BaseRepo,
+		allowedToCreate: accesslevel.AccessLevel{Value: gitlab.MaintainerPermissions},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "protect <tag> [flags]",
+		Short: `Protect a tag.`,
+		Long: heredoc.Doc(`
+			Protect a tag, or a group of tags with a wildcard like 'v*'.
+
+			Access levels are one of: no, developer, maintainer, admin. 'developer' allows
+			developers and maintainers, 'no' allows no one.
+		`),
+		Example: heredoc.Doc(`
+			# Only maintainers can create release tags
+			$ glab repo tag protect 'v*'
+
+			# Developers can create release candidate tags
+			$ glab repo tag protect '*-rc*' --allowed-to-create developer
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "false",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.tag = args[0]
+
+			switch opts.allowedToCreate.Value {
+			case gitlab.NoPermissions, gitlab.DeveloperPermissions, gitlab.MaintainerPermissions, gitlab.AdminPermissions:
+			default:
+				return &cmdutils.FlagError{Err: errors.New("--allowed-to-create must be one of: no, developer, maintainer, admin.")}
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.Flags().Var(&opts.allowedToCreate, "allowed-to-create", "Access level allowed to create the tag: no, developer, maintainer, admin.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	_, resp, err := client.ProtectedTags.ProtectRepositoryTags(repo.FullName(), &gitlab.ProtectRepositoryTagsOptions{
+		Name:              gitlab.Ptr(o.tag),
+		CreateAccessLevel: gitlab.Ptr(o.allowedToCreate.Value),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			return fmt.Errorf("tag %s is already protected. To change its protection, run `glab repo tag unprotect %s` first.", o.tag, o.tag)
+		}
+		return cmdutils.WrapError(err, "failed to protect tag.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Protected tag %s (create: %s).\n", o.io.Color().GreenCheck(), o.tag, o.allowedToCreate.String())
+	return nil
+}
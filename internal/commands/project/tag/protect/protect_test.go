@@ -0,0 +1,51 @@
+//go:build !integration
+
+package protect
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTagProtect(t *testing.T) {
+	t.Run("protects a tag", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProtectedTags.EXPECT().ProtectRepositoryTags("OWNER/REPO", &gitlab.ProtectRepositoryTagsOptions{
+			Name:              gitlab.Ptr("v*"),
+			CreateAccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions),
+		}).Return(&gitlab.ProtectedTag{}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("'v*' --allowed-to-create developer")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Protected tag v* (create: developer).\n", out.OutBuf.String())
+	})
+
+	t.Run("already protected", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProtectedTags.EXPECT().ProtectRepositoryTags("OWNER/REPO", gomock.Any()).
+			Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusConflict}}, gitlab.ErrNotFound)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false, cmdtest.WithGitLabClient(tc.Client))
+
+		_, err := exec("v1.0.0")
+		assert.EqualError(t, err, "tag v1.0.0 is already protected. To change its protection, run `glab repo tag unprotect v1.0.0` first.")
+	})
+
+	t.Run("invalid access level", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdProtect, false)
+
+		_, err := exec("v1.0.0 --allowed-to-create reporter")
+		assert.EqualError(t, err, "--allowed-to-create must be one of: no, developer, maintainer, admin.")
+	})
+}
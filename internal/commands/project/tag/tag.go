@@ -0,0 +1,31 @@
+package tag
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	tagCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/project/tag/create"
+	tagDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/project/tag/delete"
+	tagListCmd "gitlab.com/gitlab-org/cli/internal/commands/project/tag/list"
+	tagProtectCmd "gitlab.com/gitlab-org/cli/internal/commands/project/tag/protect"
+	tagUnprotectCmd "gitlab.com/gitlab-org/cli/internal/commands/project/tag/unprotect"
+)
+
+func NewCmdTag(f cmdutils.Factory) *cobra.Command {
+	tagCmd := &cobra.Command{
+		Use:   "tag <command> [flags]",
+		Short: `Manage tags and tag protection of a repository.`,
+		Long: heredoc.Doc(`
+			List, create, delete, and protect the tags of a repository on GitLab.
+		`),
+	}
+
+	tagCmd.AddCommand(tagListCmd.NewCmdList(f))
+	tagCmd.AddCommand(tagCreateCmd.NewCmdCreate(f))
+	tagCmd.AddCommand(tagDeleteCmd.NewCmdDelete(f))
+	tagCmd.AddCommand(tagProtectCmd.NewCmdProtect(f))
+	tagCmd.AddCommand(tagUnprotectCmd.NewCmdUnprotect(f))
+
+	return tagCmd
+}
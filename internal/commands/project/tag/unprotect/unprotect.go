@@ -0,0 +1,76 @@
+package unprotect
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	tag string
+}
+
+func NewCmdUnprotect(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unprotect <tag> [flags]",
+		Short: `Remove the protection of a tag.`,
+		Example: heredoc.Doc(`
+			$ glab repo tag unprotect v1.2.0
+			$ glab repo tag unprotect 'v*'
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.tag = args[0]
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ProtectedTags.UnprotectRepositoryTags(repo.FullName(), o.tag)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("tag %s is not protected in %s.", o.tag, repo.FullName())
+		}
+		return cmdutils.WrapError(err, "failed to unprotect tag.")
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Unprotected tag %s.\n", o.io.Color().GreenCheck(), o.tag)
+	return nil
+}
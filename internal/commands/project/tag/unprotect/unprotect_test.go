@@ -0,0 +1,63 @@
+//go:build !integration
+
+package unprotect
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTagUnprotect(t *testing.T) {
+	t.Run("unprotects a tag", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProtectedTags.EXPECT().UnprotectRepositoryTags("OWNER/REPO", "v*").Return(nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdUnprotect, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("'v*'")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Unprotected tag v*.\n", out.OutBuf.String())
+	})
+
+	t.Run("tag not protected", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProtectedTags.EXPECT().UnprotectRepositoryTags("OWNER/REPO", "v1.0.0").
+			Return(&gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, gitlab.ErrNotFound)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdUnprotect, false, cmdtest.WithGitLabClient(tc.Client))
+
+		_, err := exec("v1.0.0")
+		assert.EqualError(t, err, "tag v1.0.0 is not protected in OWNER/REPO.")
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockProtectedTags.EXPECT().UnprotectRepositoryTags("OWNER/REPO", "v1.0.0").
+			Return(&gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errors.New("403 Forbidden"))
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdUnprotect, false, cmdtest.WithGitLabClient(tc.Client))
+
+		_, err := exec("v1.0.0")
+		var exitErr *cmdutils.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, "failed to unprotect tag.", exitErr.Details)
+		assert.EqualError(t, err, "403 Forbidden")
+	})
+
+	t.Run("requires a tag", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, NewCmdUnprotect, false)
+
+		_, err := exec("")
+		assert.EqualError(t, err, "accepts 1 arg(s), received 0")
+	})
+}
@@ -0,0 +1,119 @@
+package tree
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	path         string
+	ref          string
+	recursive    bool
+	outputFormat string
+}
+
+func NewCmdTree(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "tree [<path>] [flags]",
+		Short: `List files and directories in a repository.`,
+		Example: heredoc.Doc(`
+			# List the root directory of the default branch
+			$ glab repo tree
+
+			# List all files under a directory, at a tag
+			$ glab repo tree docs --ref v1.2.0 --recursive
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.path = args[0]
+			}
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	fl := cmd.Flags()
+	fl.StringVar(&opts.ref, "ref", "", "Branch, tag, or commit to list. (default: the default branch)")
+	fl.BoolVarP(&opts.recursive, "recursive", "r", false, "List the content of subdirectories.")
+	fl.StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	treeOpts := &gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Recursive:   gitlab.Ptr(o.recursive),
+	}
+	if o.path != "" {
+		treeOpts.Path = gitlab.Ptr(o.path)
+	}
+	if o.ref != "" {
+		treeOpts.Ref = gitlab.Ptr(o.ref)
+	}
+
+	nodes, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.TreeNode, *gitlab.Response, error) {
+		return client.Repositories.ListTree(repo.FullName(), treeOpts, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list repository tree.")
+	}
+
+	if o.outputFormat == "json" {
+		treeJSON, _ := json.Marshal(nodes)
+		fmt.Fprintln(o.io.StdOut, string(treeJSON))
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, node := range nodes {
+		table.AddCell(c.Gray(node.Mode))
+		table.AddCell(node.Type)
+		if node.Type == "tree" {
+			table.AddCell(c.Blue(node.Path + "/"))
+		} else {
+			table.AddCell(node.Path)
+		}
+		table.EndRow()
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+
+	return nil
+}
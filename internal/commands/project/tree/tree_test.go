@@ -0,0 +1,50 @@
+//go:build !integration
+
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTree(t *testing.T) {
+	nodes := []*gitlab.TreeNode{
+		{Name: "cmd", Path: "docs/cmd", Type: "tree", Mode: "040000"},
+		{Name: "index.md", Path: "docs/index.md", Type: "blob", Mode: "100644"},
+	}
+
+	t.Run("text", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositories.EXPECT().ListTree("OWNER/REPO", &gitlab.ListTreeOptions{
+			ListOptions: gitlab.ListOptions{PerPage: 100},
+			Path:        gitlab.Ptr("docs"),
+			Ref:         gitlab.Ptr("v1.0.0"),
+			Recursive:   gitlab.Ptr(true),
+		}, gomock.Any()).Return(nodes, &gitlab.Response{}, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdTree, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("docs --ref v1.0.0 --recursive")
+		require.NoError(t, err)
+		assert.Equal(t, "040000\ttree\tdocs/cmd/\n100644\tblob\tdocs/index.md\n", out.OutBuf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockRepositories.EXPECT().ListTree("OWNER/REPO", gomock.Any(), gomock.Any()).Return(nodes[1:], &gitlab.Response{}, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, NewCmdTree, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("--output json")
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"id":"","name":"index.md","type":"blob","path":"docs/index.md","mode":"100644"}]`, out.OutBuf.String())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -22,6 +23,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	catalog "gitlab.com/gitlab-org/cli/internal/commands/project/publish/catalog"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
 	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/git"
@@ -60,6 +62,9 @@ type options struct {
 	notes                       string
 	notesFile                   string
 	experimentalNotesTextOrFile string
+	generateNotes               bool
+	notesCategoriesFile         string
+	previousTag                 string
 	milestone                   []string
 	assetLinksAsJSON            string
 	releasedAt                  string
@@ -76,6 +81,9 @@ type options struct {
 	usePackageRegistry bool
 	packageName        string
 
+	checksums bool
+	signing   releaseutils.ChecksumsSigning
+
 	ctx          context.Context
 	io           *iostreams.IOStreams
 	gitlabClient func() (*gitlab.Client, error)
@@ -111,6 +119,26 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 		   can be a commit SHA, another tag name, or a branch name.
 		4. Optional. To fetch the new tag locally after the release, run
 		   %[1]sgit fetch --tags origin%[1]s.
+
+		With %[1]s--generate-notes%[1]s, the release notes list the merge requests merged
+		into the default branch since the previous tag, grouped by label, and credit
+		their authors. When running interactively, the notes open in your editor before
+		the release is published. To change how merge requests are grouped, pass a
+		YAML file with %[1]s--notes-categories%[1]s:
+
+		%[1]s%[1]s%[1]syaml
+		categories:
+		  - title: Features
+		    labels: [feature, "type::feature"]
+		  - title: Bug fixes
+		    labels: [bug, "type::bug"]
+		  - title: Security
+		    labels: [security]
+		# Merge requests with these labels are left out.
+		exclude_labels: [skip-changelog]
+		# Section for merge requests in no category.
+		other_title: Other changes
+		%[1]s%[1]s%[1]s
 		`, "`"),
 		Args: cmdutils.MinimumArgs(1, "no tag name provided."),
 		Example: heredoc.Docf(`
@@ -123,6 +151,10 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			# Use release notes from a file
 			$ glab release create v1.0.1 -F changelog.md
 
+			# Generate release notes from the merge requests merged since the previous tag,
+			# and edit them before publishing
+			$ glab release create v1.0.1 --generate-notes
+
 			# Upload a release asset with a display name (type will default to 'other')
 			$ glab release create v1.0.1 '/path/to/asset.zip#My display label'
 
@@ -135,6 +167,9 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			# Upload all tarballs in a specified folder (types default to 'other')
 			$ glab release create v1.0.1 ./dist/*.tar.gz
 
+			# Upload all tarballs, and publish their SHA-256 checksums signed with GPG
+			$ glab release create v1.0.1 ./dist/*.tar.gz --checksums --sign gpg
+
 			# Create a release with assets specified as JSON object
 			$ glab release create v1.0.1 --assets-links='
 			  [
@@ -184,6 +219,9 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	fl.StringVarP(&opts.tagMessage, "tag-message", "T", "", "Message to use if creating a new annotated tag.")
 	fl.StringVarP(&opts.notes, "notes", "N", "", "The release notes or description. Accepts Markdown.")
 	fl.StringVarP(&opts.notesFile, "notes-file", "F", "", "Read release notes 'file'. To read from stdin, use '-'.")
+	fl.BoolVar(&opts.generateNotes, "generate-notes", false, "Generate the release notes from the merge requests merged into the default branch since the previous tag.")
+	fl.StringVar(&opts.notesCategoriesFile, "notes-categories", "", "With --generate-notes, a YAML file that defines how merge requests are grouped by label. See the command help for the format.")
+	fl.StringVar(&opts.previousTag, "previous-tag", "", "With --generate-notes, the tag to generate the notes from. Defaults to the most recent tag before the release.")
 	fl.StringVarP(&opts.releasedAt, "released-at", "D", "", "ISO 8601 datetime when the release was ready. Defaults to the current datetime.")
 	fl.StringSliceVarP(&opts.milestone, "milestone", "m", []string{}, "The title of each milestone the release is associated with. Multiple milestones can be comma-separated or specified by repeating the flag.")
 	fl.StringVarP(&opts.assetLinksAsJSON, "assets-links", "a", "", "JSON string representation of assets links. See documentation for example.")
@@ -193,12 +231,18 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	fl.StringVar(&opts.experimentalNotesTextOrFile, "experimental-notes-text-or-file", "", "(EXPERIMENTAL) Value to use as release notes. If a file exists with this value as path, its content will be used. Otherwise, the value itself will be used as text.")
 	fl.BoolVar(&opts.usePackageRegistry, "use-package-registry", false, "Upload release assets to the generic package registry of the project. Overrides the GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY environment variable.")
 	fl.StringVar(&opts.packageName, "package-name", upload.DefaultReleasePackageName, "The package name, when uploading assets to the generic package release with --use-package-registry.")
+	fl.BoolVar(&opts.checksums, "checksums", false, "Add the SHA-256 checksums of the uploaded files to the 'checksums.txt' asset of the release.")
+	fl.Var(cmdutils.NewEnumValue(checksums.SigningMethods, "", &opts.signing.Method), "sign", "Sign the checksums file with: cosign, gpg. Implies --checksums.")
+	fl.StringVar(&opts.signing.Key, "signing-key", "", "Key to sign the checksums file with. A private key file for cosign, or a key ID for GPG.")
 	cobra.CheckErr(fl.MarkHidden("experimental-notes-text-or-file"))
 
 	// These two need to be separately exclusive to avoid a breaking change
 	// because there may be existing scripts that already use both notes and notes-file.
 	cmd.MarkFlagsMutuallyExclusive("experimental-notes-text-or-file", "notes")
 	cmd.MarkFlagsMutuallyExclusive("experimental-notes-text-or-file", "notes-file")
+	cmd.MarkFlagsMutuallyExclusive("generate-notes", "notes")
+	cmd.MarkFlagsMutuallyExclusive("generate-notes", "notes-file")
+	cmd.MarkFlagsMutuallyExclusive("generate-notes", "experimental-notes-text-or-file")
 
 	return cmd
 }
@@ -227,6 +271,22 @@ func (o *options) complete(flags *pflag.FlagSet, args []string) error {
 	}
 	o.noteProvided = o.notes != ""
 
+	if o.signing.Method != "" {
+		o.checksums = true
+	}
+
+	if o.checksums && o.assetFiles == nil {
+		return &cmdutils.FlagError{Err: errors.New("--checksums requires files to upload.")}
+	}
+
+	if o.signing.Key != "" && o.signing.Method == "" {
+		return &cmdutils.FlagError{Err: errors.New("--signing-key can only be used with --sign.")}
+	}
+
+	if !o.generateNotes && (o.notesCategoriesFile != "" || o.previousTag != "") {
+		return &cmdutils.FlagError{Err: errors.New("--notes-categories and --previous-tag can only be used with --generate-notes.")}
+	}
+
 	if !flags.Changed("use-package-registry") {
 		if usePackageRegistry, err := strconv.ParseBool(os.Getenv("GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY")); err != nil {
 			o.usePackageRegistry = usePackageRegistry
@@ -348,6 +408,35 @@ func createRun(opts *options) error {
 		}
 	}
 
+	if opts.generateNotes {
+		if tag == nil {
+			// The tag doesn't exist when the release creates it.
+			tag, resp, err = client.Tags.GetTag(repo.FullName(), opts.tagName)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return cmdutils.WrapError(err, "could not fetch tag")
+			}
+		}
+
+		generatedNotes, err := opts.releaseNotesFromMergeRequests(client, repo, tag)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to generate release notes.")
+		}
+		opts.notes = generatedNotes
+
+		if opts.io.PromptEnabled() {
+			editorCommand, err := cmdutils.GetEditor(opts.config)
+			if err != nil {
+				return err
+			}
+
+			err = opts.io.Editor(opts.ctx, &opts.notes, "Release notes", "", generatedNotes, editorCommand)
+			if err != nil {
+				return err
+			}
+		}
+		opts.noteProvided = true
+	}
+
 	if opts.io.PromptEnabled() && !opts.noteProvided {
 		editorCommand, err := cmdutils.GetEditor(opts.config)
 		if err != nil {
@@ -530,6 +619,13 @@ func createRun(opts *options) error {
 		return releaseFailedErr(err, start)
 	}
 
+	if opts.checksums {
+		err = releaseutils.PublishChecksums(opts.ctx, opts.io, client, repo.FullName(), release, opts.assetFiles, opts.signing, opts.packageName, opts.usePackageRegistry)
+		if err != nil {
+			return releaseFailedErr(err, start)
+		}
+	}
+
 	if opts.noCloseMilestone {
 		opts.io.LogInfof("%s Skipping closing milestones\n", color.GreenCheck())
 	} else {
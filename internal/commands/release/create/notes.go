@@ -0,0 +1,242 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// notesCategory is a section of generated release notes. A merge request is
+// listed in the first category that has one of its labels.
+type notesCategory struct {
+	Title  string   `yaml:"title"`
+	Labels []string `yaml:"labels"`
+}
+
+type notesConfig struct {
+	Categories []notesCategory `yaml:"categories"`
+	// ExcludeLabels are labels of merge requests to leave out of the notes.
+	ExcludeLabels []string `yaml:"exclude_labels"`
+	// OtherTitle is the title of the section for merge requests in no category.
+	OtherTitle string `yaml:"other_title"`
+}
+
+var defaultNotesConfig = notesConfig{
+	Categories: []notesCategory{
+		{Title: "Features", Labels: []string{"feature", "type::feature"}},
+		{Title: "Bug fixes", Labels: []string{"bug", "type::bug"}},
+		{Title: "Security", Labels: []string{"security"}},
+	},
+	ExcludeLabels: []string{"skip-changelog"},
+	OtherTitle:    "Other changes",
+}
+
+func readNotesConfig(path string) (notesConfig, error) {
+	if path == "" {
+		return defaultNotesConfig, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return notesConfig{}, fmt.Errorf("failed to read release notes categories: %w", err)
+	}
+
+	var cfg notesConfig
+	if err := yaml.Unmarshal(b, &cfg); err != nil {
+		return notesConfig{}, fmt.Errorf("failed to parse release notes categories %s: %w", path, err)
+	}
+	if cfg.OtherTitle == "" {
+		cfg.OtherTitle = defaultNotesConfig.OtherTitle
+	}
+
+	return cfg, nil
+}
+
+// previousTag returns the most recent tag, other than tagName, whose commit is
+// older than before. Tags are listed by commit date, newest first, so only the
+// pages up to the previous tag are requested.
+func previousTag(client *gitlab.Client, project any, tagName string, before time.Time) (*gitlab.Tag, error) {
+	listOpts := &gitlab.ListTagsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		OrderBy:     gitlab.Ptr("updated"),
+		Sort:        gitlab.Ptr("desc"),
+	}
+	tags := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Tag, *gitlab.Response, error) {
+		return client.Tags.ListTags(project, listOpts, p)
+	})
+
+	for tag, err := range tags {
+		if err != nil {
+			return nil, err
+		}
+		if tag.Name == tagName || tag.Commit == nil || tag.Commit.CommittedDate == nil {
+			continue
+		}
+		if tag.Commit.CommittedDate.Before(before) {
+			return tag, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// mergedMergeRequests returns the merge requests merged into targetBranch
+// after since and up to until, oldest first.
+func mergedMergeRequests(client *gitlab.Client, project any, targetBranch string, since, until time.Time) ([]*gitlab.BasicMergeRequest, error) {
+	listOpts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: 100},
+		State:        gitlab.Ptr("merged"),
+		TargetBranch: gitlab.Ptr(targetBranch),
+	}
+	if !since.IsZero() {
+		// A merge request is updated when it is merged, so this never misses one.
+		listOpts.UpdatedAfter = gitlab.Ptr(since)
+	}
+
+	mrs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return client.MergeRequests.ListProjectMergeRequests(project, listOpts, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []*gitlab.BasicMergeRequest
+	for _, mr := range mrs {
+		if mr.MergedAt == nil || !mr.MergedAt.After(since) || mr.MergedAt.After(until) {
+			continue
+		}
+		merged = append(merged, mr)
+	}
+
+	slices.SortFunc(merged, func(a, b *gitlab.BasicMergeRequest) int {
+		return a.MergedAt.Compare(*b.MergedAt)
+	})
+
+	return merged, nil
+}
+
+// releaseNotesFromMergeRequests generates release notes from the merge requests merged into the
+// default branch since the previous tag.
+func (o *options) releaseNotesFromMergeRequests(client *gitlab.Client, repo glrepo.Interface, tag *gitlab.Tag) (string, error) {
+	cfg, err := readNotesConfig(o.notesCategoriesFile)
+	if err != nil {
+		return "", err
+	}
+
+	until := time.Now()
+	if tag != nil && tag.Commit != nil && tag.Commit.CommittedDate != nil {
+		until = *tag.Commit.CommittedDate
+	}
+
+	var prev *gitlab.Tag
+	if o.previousTag != "" {
+		prev, _, err = client.Tags.GetTag(repo.FullName(), o.previousTag)
+		if api.Is404(err) {
+			return "", fmt.Errorf("previous tag %s not found in %s.", o.previousTag, repo.FullName())
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not get previous tag %s: %w", o.previousTag, err)
+		}
+	} else {
+		prev, err = previousTag(client, repo.FullName(), o.tagName, until)
+		if err != nil {
+			return "", fmt.Errorf("could not find the previous tag: %w", err)
+		}
+	}
+
+	var since time.Time
+	if prev != nil && prev.Commit != nil && prev.Commit.CommittedDate != nil {
+		since = *prev.Commit.CommittedDate
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return "", err
+	}
+
+	mrs, err := mergedMergeRequests(client, repo.FullName(), project.DefaultBranch, since, until)
+	if err != nil {
+		return "", fmt.Errorf("could not list merged merge requests: %w", err)
+	}
+
+	color := o.io.Color()
+	if prev != nil {
+		o.io.LogInfof("%s Generating release notes from %d merge requests merged since %s\n", color.ProgressIcon(), len(mrs), prev.Name)
+	} else {
+		o.io.LogInfof("%s No previous tag found. Generating release notes from %d merge requests\n", color.DotWarnIcon(), len(mrs))
+	}
+
+	return renderNotes(cfg, mrs), nil
+}
+
+// renderNotes renders merge requests as Markdown release notes, grouped by category.
+func renderNotes(cfg notesConfig, mrs []*gitlab.BasicMergeRequest) string {
+	sections := make([][]string, len(cfg.Categories)+1)
+	var authors []string
+
+	for _, mr := range mrs {
+		if hasAnyLabel(mr.Labels, cfg.ExcludeLabels) {
+			continue
+		}
+
+		i := slices.IndexFunc(cfg.Categories, func(c notesCategory) bool {
+			return hasAnyLabel(mr.Labels, c.Labels)
+		})
+		if i < 0 {
+			i = len(cfg.Categories)
+		}
+
+		line := fmt.Sprintf("- %s (!%d)", mr.Title, mr.IID)
+		if mr.Author != nil {
+			line += " by @" + mr.Author.Username
+			if !slices.Contains(authors, mr.Author.Username) {
+				authors = append(authors, mr.Author.Username)
+			}
+		}
+		sections[i] = append(sections[i], line)
+	}
+
+	var b strings.Builder
+	for i, lines := range sections {
+		if len(lines) == 0 {
+			continue
+		}
+
+		title := cfg.OtherTitle
+		if i < len(cfg.Categories) {
+			title = cfg.Categories[i].Title
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n\n%s\n", title, strings.Join(lines, "\n"))
+	}
+
+	if len(authors) > 0 {
+		slices.Sort(authors)
+		for i := range authors {
+			authors[i] = "@" + authors[i]
+		}
+		fmt.Fprintf(&b, "\n## Contributors\n\n%s\n", strings.Join(authors, ", "))
+	}
+
+	return b.String()
+}
+
+func hasAnyLabel(labels, want []string) bool {
+	for _, label := range labels {
+		if slices.Contains(want, label) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+//go:build !integration
+
+package create
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func mergedMR(iid int64, title, author string, mergedAt time.Time, labels ...string) *gitlab.BasicMergeRequest {
+	return &gitlab.BasicMergeRequest{
+		IID:      iid,
+		Title:    title,
+		Author:   &gitlab.BasicUser{Username: author},
+		Labels:   labels,
+		MergedAt: gitlab.Ptr(mergedAt),
+	}
+}
+
+func Test_renderNotes(t *testing.T) {
+	now := time.Now()
+	mrs := []*gitlab.BasicMergeRequest{
+		mergedMR(1, "Add dark mode", "alice", now, "feature"),
+		mergedMR(2, "Fix crash on start", "bob", now, "type::bug"),
+		mergedMR(3, "Update dependencies", "alice", now),
+		mergedMR(4, "Internal refactoring", "carol", now, "skip-changelog"),
+		mergedMR(5, "Patch XSS", "dave", now, "security", "bug"),
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+		## Features
+
+		- Add dark mode (!1) by @alice
+
+		## Bug fixes
+
+		- Fix crash on start (!2) by @bob
+		- Patch XSS (!5) by @dave
+
+		## Other changes
+
+		- Update dependencies (!3) by @alice
+
+		## Contributors
+
+		@alice, @bob, @dave
+	`), renderNotes(defaultNotesConfig, mrs))
+
+	assert.Empty(t, renderNotes(defaultNotesConfig, nil))
+}
+
+func Test_readNotesConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "categories.yml")
+	require.NoError(t, os.WriteFile(path, []byte(heredoc.Doc(`
+		categories:
+		  - title: Security fixes
+		    labels: [security]
+		  - title: New features
+		    labels: [feature, enhancement]
+		exclude_labels: [internal]
+	`)), 0o600))
+
+	cfg, err := readNotesConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, notesConfig{
+		Categories: []notesCategory{
+			{Title: "Security fixes", Labels: []string{"security"}},
+			{Title: "New features", Labels: []string{"feature", "enhancement"}},
+		},
+		ExcludeLabels: []string{"internal"},
+		OtherTitle:    "Other changes",
+	}, cfg)
+
+	cfg, err = readNotesConfig("")
+	require.NoError(t, err)
+	assert.Equal(t, defaultNotesConfig, cfg)
+}
+
+func Test_previousTag(t *testing.T) {
+	before := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tc := gitlabtesting.NewTestClient(t)
+	gomock.InOrder(
+		tc.MockTags.EXPECT().ListTags("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.Tag{
+			{Name: "v2.0.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(before.Add(time.Hour))}},
+			{Name: "v1.1.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(before)}},
+		}, &gitlab.Response{NextPage: 2}, nil),
+		tc.MockTags.EXPECT().ListTags("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.Tag{
+			{Name: "v1.0.1", Commit: &gitlab.Commit{}},
+			{Name: "v1.0.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(before.Add(-time.Hour))}},
+			{Name: "v0.9.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(before.Add(-2 * time.Hour))}},
+		}, &gitlab.Response{NextPage: 3}, nil),
+	)
+
+	// The third page is not requested.
+	tag, err := previousTag(tc.Client, "OWNER/REPO", "v1.1.0", before)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0", tag.Name)
+}
+
+func Test_releaseNotesFromMergeRequests_previousTagNotFound(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTags.EXPECT().GetTag("OWNER/REPO", "v0.1.0").Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, &gitlab.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusNotFound},
+	})
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	opts := &options{io: ios, tagName: "v1.1.0", previousTag: "v0.1.0"}
+
+	_, err := opts.releaseNotesFromMergeRequests(tc.Client, glrepo.NewWithHost("OWNER", "REPO", glinstance.DefaultHostname), nil)
+	assert.EqualError(t, err, "previous tag v0.1.0 not found in OWNER/REPO.")
+}
+
+func Test_releaseNotesFromMergeRequests(t *testing.T) {
+	prevDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tagDate := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTags.EXPECT().ListTags("OWNER/REPO", gomock.Any(), gomock.Any()).Return([]*gitlab.Tag{
+		{Name: "v1.1.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(tagDate)}},
+		{Name: "v1.0.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(prevDate)}},
+	}, &gitlab.Response{}, nil)
+	tc.MockProjects.EXPECT().GetProject("OWNER/REPO", gomock.Any()).Return(&gitlab.Project{DefaultBranch: "main"}, nil, nil)
+	tc.MockMergeRequests.EXPECT().ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: 100},
+		State:        gitlab.Ptr("merged"),
+		TargetBranch: gitlab.Ptr("main"),
+		UpdatedAfter: gitlab.Ptr(prevDate),
+	}, gomock.Any()).Return([]*gitlab.BasicMergeRequest{
+		mergedMR(3, "Merged after the tag", "alice", tagDate.Add(time.Hour), "feature"),
+		mergedMR(2, "Fix login", "bob", tagDate.Add(-time.Hour), "bug"),
+		mergedMR(1, "Add search", "alice", prevDate.Add(time.Hour), "feature"),
+	}, &gitlab.Response{}, nil)
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	opts := &options{io: ios, tagName: "v1.1.0"}
+	tag := &gitlab.Tag{Name: "v1.1.0", Commit: &gitlab.Commit{CommittedDate: gitlab.Ptr(tagDate)}}
+
+	notes, err := opts.releaseNotesFromMergeRequests(tc.Client, glrepo.NewWithHost("OWNER", "REPO", glinstance.DefaultHostname), tag)
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		## Features
+
+		- Add search (!1) by @alice
+
+		## Bug fixes
+
+		- Fix login (!2) by @bob
+
+		## Contributors
+
+		@alice, @bob
+	`), notes)
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -19,16 +17,20 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
 type options struct {
 	tagName    string
 	assetNames []string
 	dir        string
+	noVerify   bool
 
 	io           *iostreams.IOStreams
 	apiClient    func(repoHost string) (*api.Client, error)
@@ -52,6 +54,9 @@ func NewCmdDownload(f cmdutils.Factory) *cobra.Command {
 			If no tag is specified, downloads assets from the latest release.
 			To specify a file name to download from the release assets, use %[1]s--asset-name%[1]s.
 			%[1]s--asset-name%[1]s flag accepts glob patterns.
+
+			If the release has a %[1]schecksums.txt%[1]s asset, the downloaded assets are
+			verified against it. To skip the verification, use %[1]s--no-verify%[1]s.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -76,6 +81,7 @@ func NewCmdDownload(f cmdutils.Factory) *cobra.Command {
 
 	cmd.Flags().StringArrayVarP(&opts.assetNames, "asset-name", "n", []string{}, "Download only assets that match the name or a glob pattern.")
 	cmd.Flags().StringVarP(&opts.dir, "dir", "D", ".", "Directory to download the release assets to.")
+	cmd.Flags().BoolVar(&opts.noVerify, "no-verify", false, "Skip verifying the downloaded assets against the checksums of the release.")
 
 	return cmd
 }
@@ -99,6 +105,7 @@ func (o *options) run(ctx context.Context) error {
 	var resp *gitlab.Response
 	var release *gitlab.Release
 	var downloadableAssets []*upload.ReleaseAsset
+	var downloadedLinks []*gitlab.ReleaseLink
 
 	if o.tagName == "" {
 		o.io.LogInfof("%s fetching latest release %s=%s\n",
@@ -137,6 +144,7 @@ func (o *options) run(ctx context.Context) error {
 			Name: &link.Name,
 			URL:  &link.URL,
 		})
+		downloadedLinks = append(downloadedLinks, link)
 	}
 
 	for _, source := range release.Assets.Sources {
@@ -165,6 +173,12 @@ func (o *options) run(ctx context.Context) error {
 		return cmdutils.WrapError(err, "failed to download release.")
 	}
 
+	if !o.noVerify {
+		if err := o.verifyDownloads(ctx, client, release, downloadedLinks); err != nil {
+			return cmdutils.WrapError(err, "failed to verify release assets.")
+		}
+	}
+
 	o.io.LogInfof(color.Bold("%s release %q downloaded\n"), color.RedCheck(), release.Name)
 
 	return nil
@@ -227,36 +241,58 @@ func downloadAsset(ctx context.Context, client *gitlab.Client, assetURL, destina
 	}
 	defer f.Close()
 
-	// check if authenticated GitLab client should be used or not.
-	baseURL, _ := url.Parse(assetURL)
-	gitlabBaseURL := client.BaseURL()
-	if gitlabBaseURL.Scheme == baseURL.Scheme && gitlabBaseURL.Host == baseURL.Host {
-		r, err := client.NewRequestToURL(http.MethodGet, baseURL, http.NoBody, []gitlab.RequestOptionFunc{gitlab.WithHeader("Accept", "application/octet-stream")})
-		if err != nil {
-			return err
-		}
-		_, err = client.Do(r, f)
-		if err != nil {
-			return err
-		}
+	return releaseutils.DownloadAsset(ctx, client, assetURL, f)
+}
+
+// verifyDownloads checks the downloaded asset links against the checksums
+// asset of the release, if it has one.
+func (o *options) verifyDownloads(ctx context.Context, client *gitlab.Client, release *gitlab.Release, links []*gitlab.ReleaseLink) error {
+	content, err := releaseutils.FetchChecksums(ctx, client, release)
+	if err != nil {
+		return err
+	}
+	if content == nil {
 		return nil
-	} else {
-		r, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.String(), http.NoBody)
-		if err != nil {
-			return err
+	}
+
+	sums, err := checksums.Parse(content)
+	if err != nil {
+		return err
+	}
+
+	destDir, err := filepath.Abs(o.dir)
+	if err != nil {
+		return fmt.Errorf("resolving absolute download directory path: %v", err)
+	}
+
+	color := o.io.Color()
+	failed := 0
+	for _, link := range links {
+		if checksums.IsChecksumsAsset(link.Name) {
+			continue
 		}
-		r.Header.Add("Accept", "application/octet-stream")
 
-		resp, err := http.DefaultClient.Do(r)
+		_, want, ok := sums.Lookup(link)
+		if !ok {
+			o.io.LogInfof("%s %s is not listed in %s\n", color.DotWarnIcon(), link.Name, checksums.FileName)
+			continue
+		}
+
+		got, err := checksums.SumFile(filepath.Join(destDir, sanitizeAssetName(link.Name)))
 		if err != nil {
 			return err
 		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode > 299 {
-			return errors.New(resp.Status)
+		if got != want {
+			failed++
+			o.io.LogError(color.FailedIcon(), fmt.Sprintf("checksum mismatch for %s", link.Name))
+			continue
 		}
-		_, err = io.Copy(f, resp.Body)
-		return err
+		o.io.LogInfof("%s verified checksum of %s\n", color.GreenCheck(), link.Name)
 	}
+
+	if failed > 0 {
+		return fmt.Errorf("checksum verification failed for %s. Do not use the downloaded files.", utils.Pluralize(failed, "asset"))
+	}
+
+	return nil
 }
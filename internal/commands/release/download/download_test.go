@@ -12,6 +12,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
 )
@@ -83,3 +84,53 @@ func Test_downloadAssets(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseDownload_VerifiesChecksums(t *testing.T) {
+	tests := []struct {
+		name      string
+		checksums string
+		wantErr   string
+	}{
+		{
+			name:      "matching checksum",
+			checksums: "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb  tool.tar.gz\n",
+		},
+		{
+			name:      "mismatching checksum",
+			checksums: "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d  tool.tar.gz\n",
+			wantErr:   "checksum verification failed for 1 asset. Do not use the downloaded files.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+
+			fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0",
+				httpmock.NewStringResponse(http.StatusOK, `{
+					"name": "v1.0.0",
+					"tag_name": "v1.0.0",
+					"assets": {"links": [
+						{"id": 1, "name": "Tool", "url": "https://gitlab.com/OWNER/REPO/uploads/tool.tar.gz", "direct_asset_url": "https://gitlab.com/OWNER/REPO/-/releases/v1.0.0/downloads/tool.tar.gz"},
+						{"id": 2, "name": "checksums.txt", "url": "https://gitlab.com/OWNER/REPO/uploads/checksums.txt"}
+					]}
+				}`))
+			fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/uploads/tool.tar.gz", httpmock.NewStringResponse(http.StatusOK, "a"))
+			fakeHTTP.RegisterReusableResponder(http.MethodGet, "/OWNER/REPO/uploads/checksums.txt", httpmock.NewStringResponse(http.StatusOK, tt.checksums))
+
+			ios, _, stdout, stderr := cmdtest.TestIOStreams()
+			factory := cmdtest.NewTestFactory(ios,
+				cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", glinstance.DefaultHostname).Lab()),
+			)
+
+			dir := t.TempDir()
+			_, err := cmdtest.ExecuteCommand(NewCmdDownload(factory), "v1.0.0 --dir "+dir, stdout, stderr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Contains(t, stdout.String(), "✓ verified checksum of Tool")
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package releaseutils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// ChecksumsSigning configures the signature of the checksums asset.
+// An empty Method leaves the checksums unsigned.
+type ChecksumsSigning struct {
+	Method string
+	Key    string
+}
+
+// DownloadAsset writes the content of the release asset at assetURL to w.
+// Assets hosted on the GitLab instance are downloaded with the authenticated client.
+func DownloadAsset(ctx context.Context, client *gitlab.Client, assetURL string, w io.Writer) error {
+	baseURL, _ := url.Parse(assetURL)
+	gitlabBaseURL := client.BaseURL()
+	if gitlabBaseURL.Scheme == baseURL.Scheme && gitlabBaseURL.Host == baseURL.Host {
+		r, err := client.NewRequestToURL(http.MethodGet, baseURL, http.NoBody, []gitlab.RequestOptionFunc{gitlab.WithHeader("Accept", "application/octet-stream")})
+		if err != nil {
+			return err
+		}
+		_, err = client.Do(r, w)
+		return err
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	r.Header.Add("Accept", "application/octet-stream")
+
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return errors.New(resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// FindLink returns the asset link of a release with the given name, or nil.
+func FindLink(release *gitlab.Release, name string) *gitlab.ReleaseLink {
+	for _, link := range release.Assets.Links {
+		if link.Name == name {
+			return link
+		}
+	}
+	return nil
+}
+
+// FetchChecksums returns the content of the checksums asset of a release, or nil
+// if the release has none.
+func FetchChecksums(ctx context.Context, client *gitlab.Client, release *gitlab.Release) ([]byte, error) {
+	link := FindLink(release, checksums.FileName)
+	if link == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := DownloadAsset(ctx, client, link.URL, &b); err != nil {
+		return nil, fmt.Errorf("could not download %s: %w", checksums.FileName, err)
+	}
+	return b.Bytes(), nil
+}
+
+// PublishChecksums adds the SHA-256 checksums of files to the checksums asset of
+// the release, and signs it. The previous checksums asset and its signature are
+// replaced, so assets uploaded earlier stay listed.
+func PublishChecksums(ctx context.Context, io *iostreams.IOStreams, client *gitlab.Client, repoName string, release *gitlab.Release, files []*upload.ReleaseFile, signing ChecksumsSigning, packageName string, usePackageRegistry bool) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	sums := checksums.Checksums{}
+	previous, err := FetchChecksums(ctx, client, release)
+	if err != nil {
+		return err
+	}
+	if previous != nil {
+		sums, err = checksums.Parse(previous)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, file := range files {
+		r, err := file.Open()
+		if err != nil {
+			return err
+		}
+		sum, err := checksums.Sum(r)
+		r.Close()
+		if err != nil {
+			return fmt.Errorf("could not compute the checksum of %s: %w", file.Path, err)
+		}
+		sums[file.Name] = sum
+	}
+
+	content := sums.Bytes()
+	assets := []*upload.ReleaseFile{bytesReleaseFile(checksums.FileName, content)}
+
+	if signing.Method != "" {
+		signature, err := checksums.Sign(signing.Method, signing.Key, content)
+		if err != nil {
+			return err
+		}
+		assets = append(assets, bytesReleaseFile(checksums.SignatureName(signing.Method), signature))
+	}
+
+	for _, link := range release.Assets.Links {
+		if !checksums.IsChecksumsAsset(link.Name) {
+			continue
+		}
+		if _, _, err := client.ReleaseLinks.DeleteReleaseLink(repoName, release.TagName, link.ID); err != nil {
+			return fmt.Errorf("could not replace %s: %w", link.Name, err)
+		}
+	}
+
+	uploadCtx := upload.Context{
+		IO:         io,
+		Client:     client,
+		AssetFiles: assets,
+	}
+	return uploadCtx.UploadFiles(repoName, release.TagName, packageName, usePackageRegistry)
+}
+
+func bytesReleaseFile(name string, content []byte) *upload.ReleaseFile {
+	return &upload.ReleaseFile{
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(content)), nil
+		},
+		Name:  name,
+		Label: name,
+		Path:  name,
+	}
+}
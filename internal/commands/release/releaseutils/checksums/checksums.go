@@ -0,0 +1,111 @@
+// Package checksums reads, writes, and signs the SHA-256 checksums file of
+// release assets. The file uses the format of sha256sum, so it can also be
+// checked with `sha256sum --check`.
+package checksums
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// FileName is the name of the checksums asset of a release.
+const FileName = "checksums.txt"
+
+// Checksums maps asset file names to their hex-encoded SHA-256 checksum.
+type Checksums map[string]string
+
+// Parse parses the content of a checksums file.
+func Parse(content []byte) (Checksums, error) {
+	c := Checksums{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		sum, name, ok := strings.Cut(line, " ")
+		if !ok || len(sum) != sha256.Size*2 {
+			return nil, fmt.Errorf("%s line %d: expected '<sha256>  <file name>'.", FileName, n)
+		}
+		// sha256sum marks files read in binary mode with '*'.
+		name = strings.TrimPrefix(strings.TrimLeft(name, " "), "*")
+		c[name] = strings.ToLower(sum)
+	}
+
+	return c, scanner.Err()
+}
+
+// Bytes returns the content of the checksums file, sorted by file name.
+func (c Checksums) Bytes() []byte {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s  %s\n", c[name], name)
+	}
+	return b.Bytes()
+}
+
+// Lookup returns the checksum of a release asset link. Assets are listed by
+// their file name, which is the last element of the direct asset URL, or by
+// the link name.
+func (c Checksums) Lookup(link *gitlab.ReleaseLink) (string, string, bool) {
+	if link.DirectAssetURL != "" {
+		name := path.Base(link.DirectAssetURL)
+		if sum, ok := c[name]; ok {
+			return name, sum, true
+		}
+	}
+
+	sum, ok := c[link.Name]
+	return link.Name, sum, ok
+}
+
+// Sum returns the hex-encoded SHA-256 checksum of r.
+func Sum(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// SumFile returns the hex-encoded SHA-256 checksum of the file at path.
+func SumFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return Sum(f)
+}
+
+// IsChecksumsAsset reports whether name is the checksums file or one of its signatures.
+func IsChecksumsAsset(name string) bool {
+	if name == FileName {
+		return true
+	}
+	for _, method := range SigningMethods {
+		if name == SignatureName(method) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+//go:build !integration
+
+package checksums
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const (
+	sumA = "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	sumB = "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d"
+)
+
+func TestParse(t *testing.T) {
+	c, err := Parse([]byte(sumB + "  b.txt\n\n" + strings.ToUpper(sumA) + " *a.txt\n"))
+	require.NoError(t, err)
+	assert.Equal(t, Checksums{"a.txt": sumA, "b.txt": sumB}, c)
+	assert.Equal(t, sumA+"  a.txt\n"+sumB+"  b.txt\n", string(c.Bytes()))
+
+	_, err = Parse([]byte("not-a-checksum a.txt\n"))
+	assert.EqualError(t, err, "checksums.txt line 1: expected '<sha256>  <file name>'.")
+}
+
+func TestSum(t *testing.T) {
+	sum, err := Sum(strings.NewReader("a"))
+	require.NoError(t, err)
+	assert.Equal(t, sumA, sum)
+}
+
+func TestLookup(t *testing.T) {
+	c := Checksums{"tool.tar.gz": sumA, "Display name": sumB}
+
+	name, sum, ok := c.Lookup(&gitlab.ReleaseLink{Name: "Tool", DirectAssetURL: "https://gitlab.com/o/r/-/releases/v1/downloads/tool.tar.gz"})
+	assert.True(t, ok)
+	assert.Equal(t, "tool.tar.gz", name)
+	assert.Equal(t, sumA, sum)
+
+	_, sum, ok = c.Lookup(&gitlab.ReleaseLink{Name: "Display name"})
+	assert.True(t, ok)
+	assert.Equal(t, sumB, sum)
+
+	_, _, ok = c.Lookup(&gitlab.ReleaseLink{Name: "other"})
+	assert.False(t, ok)
+}
+
+func TestIsChecksumsAsset(t *testing.T) {
+	assert.True(t, IsChecksumsAsset("checksums.txt"))
+	assert.True(t, IsChecksumsAsset("checksums.txt.sig"))
+	assert.True(t, IsChecksumsAsset("checksums.txt.asc"))
+	assert.False(t, IsChecksumsAsset("tool.tar.gz"))
+}
+
+func TestVerifySignature(t *testing.T) {
+	var args []string
+	restore := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		args = cmd.Args
+		return &test.OutputStub{}
+	})
+	defer restore()
+
+	require.NoError(t, VerifySignature(SigningMethodCosign, "cosign.pub", []byte("content"), []byte("signature")))
+	assert.Equal(t, []string{"cosign", "verify-blob", "--key", "cosign.pub", "--signature"}, args[:5])
+
+	require.NoError(t, VerifySignature(SigningMethodGPG, "", []byte("content"), []byte("signature")))
+	assert.Equal(t, []string{"gpg", "--batch", "--verify"}, args[:3])
+	assert.True(t, strings.HasSuffix(args[3], "checksums.txt.asc"))
+
+	assert.EqualError(t, VerifySignature(SigningMethodCosign, "", nil, nil), "a public key is required to verify a cosign signature.")
+}
@@ -0,0 +1,105 @@
+package checksums
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+const (
+	SigningMethodCosign = "cosign"
+	SigningMethodGPG    = "gpg"
+)
+
+// SigningMethods are the supported tools to sign the checksums file with.
+var SigningMethods = []string{SigningMethodCosign, SigningMethodGPG}
+
+// SignatureName returns the asset name of the detached signature of the
+// checksums file made with method.
+func SignatureName(method string) string {
+	if method == SigningMethodGPG {
+		return FileName + ".asc"
+	}
+	return FileName + ".sig"
+}
+
+// Sign returns a detached signature of content. For cosign, key is the private
+// key to sign with. For GPG, key is the optional ID of the key to sign with.
+func Sign(method, key string, content []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "glab-checksums")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, FileName)
+	if err := os.WriteFile(file, content, 0o600); err != nil {
+		return nil, err
+	}
+	signature := filepath.Join(dir, SignatureName(method))
+
+	var cmd *exec.Cmd
+	switch method {
+	case SigningMethodCosign:
+		if key == "" {
+			return nil, fmt.Errorf("a key is required to sign with cosign.")
+		}
+		cmd = exec.Command("cosign", "sign-blob", "--yes", "--key", key, "--output-signature", signature, file)
+	case SigningMethodGPG:
+		args := []string{"--batch", "--yes", "--armor", "--detach-sign", "--output", signature}
+		if key != "" {
+			args = append(args, "--local-user", key)
+		}
+		cmd = exec.Command("gpg", append(args, file)...)
+	default:
+		return nil, fmt.Errorf("unsupported signing method %q.", method)
+	}
+
+	if err := run.PrepareCmd(cmd).Run(); err != nil {
+		return nil, fmt.Errorf("%s failed to sign %s: %w", method, FileName, err)
+	}
+
+	return os.ReadFile(signature)
+}
+
+// VerifySignature verifies a detached signature of content. For cosign, key is
+// the public key to verify with. For GPG, the signature is verified with the
+// keys of the user's keyring.
+func VerifySignature(method, key string, content, signature []byte) error {
+	dir, err := os.MkdirTemp("", "glab-checksums")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, FileName)
+	if err := os.WriteFile(file, content, 0o600); err != nil {
+		return err
+	}
+	signatureFile := filepath.Join(dir, SignatureName(method))
+	if err := os.WriteFile(signatureFile, signature, 0o600); err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch method {
+	case SigningMethodCosign:
+		if key == "" {
+			return fmt.Errorf("a public key is required to verify a cosign signature.")
+		}
+		cmd = exec.Command("cosign", "verify-blob", "--key", key, "--signature", signatureFile, file)
+	case SigningMethodGPG:
+		cmd = exec.Command("gpg", "--batch", "--verify", signatureFile, file)
+	default:
+		return fmt.Errorf("unsupported signing method %q.", method)
+	}
+
+	if err := run.PrepareCmd(cmd).Run(); err != nil {
+		return fmt.Errorf("invalid signature of %s: %w", FileName, err)
+	}
+
+	return nil
+}
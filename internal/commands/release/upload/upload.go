@@ -1,6 +1,7 @@
 package upload
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +18,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
 	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/upload"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
@@ -33,6 +35,9 @@ type options struct {
 	usePackageRegistry bool
 	packageName        string
 
+	checksums bool
+	signing   releaseutils.ChecksumsSigning
+
 	io           *iostreams.IOStreams
 	gitlabClient func() (*gitlab.Client, error)
 	baseRepo     func() (glrepo.Interface, error)
@@ -52,6 +57,11 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 
 		Define the display name by appending '#' after the filename.
 		The link type comes after the display name, like this: 'myfile.tar.gz#My display name#package'
+
+		With --checksums, the SHA-256 checksums of the uploaded files are added to the
+		'checksums.txt' asset of the release. With --sign, the checksums file is also signed
+		with a detached signature, uploaded as 'checksums.txt.sig' for cosign, or as
+		'checksums.txt.asc' for GPG. Use 'glab release verify' to check downloaded files.
 		`),
 		Args: func() cobra.PositionalArgs {
 			return func(cmd *cobra.Command, args []string) error {
@@ -77,6 +87,9 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 			# Upload all tarballs in a specified folder. 'Type' defaults to 'other'.
 			$ glab release upload v1.0.1 ./dist/*.tar.gz
 
+			# Upload all tarballs, and publish their checksums signed with a cosign key
+			$ glab release upload v1.0.1 ./dist/*.tar.gz --checksums --sign cosign --signing-key cosign.key
+
 			# Upload release assets links specified as JSON string
 			$ glab release upload v1.0.1 --assets-links='
 			  [
@@ -100,7 +113,7 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
-			return opts.run()
+			return opts.run(cmd.Context())
 		},
 	}
 
@@ -108,6 +121,9 @@ func NewCmdUpload(f cmdutils.Factory) *cobra.Command {
 	fl.StringVarP(&opts.assetLinksAsJSON, "assets-links", "a", "", "`JSON` string representation of assets links, like: `--assets-links='[{\"name\": \"Asset1\", \"url\":\"https://<domain>/some/location/1\", \"link_type\": \"other\", \"direct_asset_path\": \"path/to/file\"}]'.`")
 	fl.BoolVar(&opts.usePackageRegistry, "use-package-registry", false, "Upload release assets to the generic package registry of the project. Alternatively to this flag you may also set the GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY environment variable to either the value true or 1. The flag takes precedence over this environment variable.")
 	fl.StringVar(&opts.packageName, "package-name", upload.DefaultReleasePackageName, "The package name to use when uploading the assets to the generic package release with --use-package-registry.")
+	fl.BoolVar(&opts.checksums, "checksums", false, "Add the SHA-256 checksums of the uploaded files to the 'checksums.txt' asset of the release.")
+	fl.Var(cmdutils.NewEnumValue(checksums.SigningMethods, "", &opts.signing.Method), "sign", "Sign the checksums file with: cosign, gpg. Implies --checksums.")
+	fl.StringVar(&opts.signing.Key, "signing-key", "", "Key to sign the checksums file with. A private key file for cosign, or a key ID for GPG.")
 
 	return cmd
 }
@@ -121,6 +137,10 @@ func (o *options) complete(flags *pflag.FlagSet, args []string) error {
 	}
 	o.assetFiles = assetFiles
 
+	if o.signing.Method != "" {
+		o.checksums = true
+	}
+
 	if !flags.Changed("use-package-registry") {
 		if usePackageRegistry, err := strconv.ParseBool(os.Getenv("GITLAB_RELEASE_ASSETS_USE_PACKAGE_REGISTRY")); err != nil {
 			o.usePackageRegistry = usePackageRegistry
@@ -135,6 +155,14 @@ func (o *options) validate() error {
 		return cmdutils.FlagError{Err: errors.New("no files specified.")}
 	}
 
+	if o.checksums && o.assetFiles == nil {
+		return cmdutils.FlagError{Err: errors.New("--checksums requires files to upload.")}
+	}
+
+	if o.signing.Key != "" && o.signing.Method == "" {
+		return cmdutils.FlagError{Err: errors.New("--signing-key can only be used with --sign.")}
+	}
+
 	if o.assetLinksAsJSON != "" {
 		err := json.Unmarshal([]byte(o.assetLinksAsJSON), &o.assetLinks)
 		if err != nil {
@@ -145,7 +173,7 @@ func (o *options) validate() error {
 	return nil
 }
 
-func (o *options) run() error {
+func (o *options) run(ctx context.Context) error {
 	start := time.Now()
 
 	client, err := o.gitlabClient()
@@ -179,6 +207,13 @@ func (o *options) run() error {
 		return cmdutils.WrapError(err, "creating release assets failed.")
 	}
 
+	if o.checksums {
+		err = releaseutils.PublishChecksums(ctx, o.io, client, repo.FullName(), release, o.assetFiles, o.signing, o.packageName, o.usePackageRegistry)
+		if err != nil {
+			return cmdutils.WrapError(err, "publishing checksums failed.")
+		}
+	}
+
 	o.io.LogInfof(color.Bold("%s Upload succeeded after %0.2fs.\n"), color.GreenCheck(), time.Since(start).Seconds())
 	return nil
 }
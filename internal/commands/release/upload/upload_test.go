@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
@@ -192,3 +193,54 @@ func TestReleaseUpload_WithAssetsLinksJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseUpload_Checksums(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/0%2E0%2E1",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"name": "test1",
+			"tag_name": "0.0.1",
+			"assets": {"links": [
+				{"id": 7, "name": "checksums.txt", "url": "https://gitlab.com/OWNER/REPO/-/releases/0.0.1/downloads/checksums.txt"}
+			]}
+		}`))
+
+	// The previous checksums are merged and replaced.
+	fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/0.0.1/downloads/checksums.txt",
+		httpmock.NewStringResponse(http.StatusOK, "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d  other.txt\n"))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/releases/0%2E0%2E1/assets/links/7",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 7}`))
+
+	var uploads, links []string
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/uploads",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			uploads = append(uploads, string(body))
+			return httpmock.NewStringResponse(http.StatusCreated, `{"full_path": "/namespace1/project1/uploads/66dbcd21ec5d24ed6ea225176098d52b/file"}`)(req)
+		})
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/releases/0%2E0%2E1/assets/links",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			links = append(links, string(body))
+			return httpmock.NewStringResponse(http.StatusCreated, `{"id": 8, "name": "file"}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, "0.0.1 testdata/test_file.txt --checksums")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Uploading to release\tfile=checksums.txt name=checksums.txt")
+
+	require.Len(t, uploads, 2)
+	assert.Contains(t, uploads[1], "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d  other.txt\n")
+	assert.Contains(t, uploads[1], "  test_file.txt\n")
+	require.Len(t, links, 2)
+	assert.Contains(t, links[1], `"name":"checksums.txt"`)
+}
+
+func TestReleaseUpload_ChecksumsWithoutFiles(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `0.0.1 --assets-links '[{"name": "a", "url": "https://example.com/a"}]' --checksums`)
+	assert.EqualError(t, err, "--checksums requires files to upload.")
+}
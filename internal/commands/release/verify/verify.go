@@ -0,0 +1,225 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	tagName          string
+	files            []string
+	dir              string
+	key              string
+	requireSignature bool
+}
+
+func NewCmdVerify(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify <tag> [<files>...] [flags]",
+		Short: "Verify local files against the published checksums of a release.",
+		Long: heredoc.Docf(`
+			Verify local files against the %[1]schecksums.txt%[1]s asset of a release, published
+			with %[1]sglab release upload --checksums%[1]s.
+
+			Without file arguments, every file listed in the checksums that exists in
+			%[1]s--dir%[1]s is verified.
+
+			If the checksums are signed, the signature is verified first. GPG signatures are
+			verified with the keys of your keyring. Cosign signatures require the public key
+			set with %[1]s--key%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Verify all downloaded assets of a release in the current directory
+			$ glab release verify v1.0.1
+
+			# Verify a file, and require a valid cosign signature of the checksums
+			$ glab release verify v1.0.1 ./tool-linux-amd64.tar.gz --key cosign.pub --require-signature
+		`),
+		Args: cmdutils.MinimumArgs(1, "no tag name provided."),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.tagName = args[0]
+			opts.files = args[1:]
+
+			if len(opts.files) > 0 && cmd.Flags().Changed("dir") {
+				return &cmdutils.FlagError{Err: errors.New("specify either files or --dir, not both.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.dir, "dir", "D", ".", "Directory with the files to verify.")
+	fl.StringVar(&opts.key, "key", "", "Public key to verify a cosign signature of the checksums with.")
+	fl.BoolVar(&opts.requireSignature, "require-signature", false, "Fail if the checksums are not signed, or the signature can't be verified.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	release, resp, err := client.Releases.GetRelease(repo.FullName(), o.tagName)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) {
+			return cmdutils.WrapError(err, "release does not exist.")
+		}
+		return cmdutils.WrapError(err, "failed to fetch release.")
+	}
+
+	content, err := releaseutils.FetchChecksums(ctx, client, release)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return fmt.Errorf("release %s has no %s asset. Upload its assets with `glab release upload --checksums` to publish checksums.", o.tagName, checksums.FileName)
+	}
+
+	if err := o.verifySignature(ctx, client, release, content); err != nil {
+		return err
+	}
+
+	sums, err := checksums.Parse(content)
+	if err != nil {
+		return err
+	}
+
+	files, err := o.filesToVerify(sums)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, file := range files {
+		name := filepath.Base(file)
+		want, ok := sums[name]
+		if !ok {
+			failed++
+			fmt.Fprintf(o.io.StdOut, "%s %s: not listed in %s\n", c.FailedIcon(), file, checksums.FileName)
+			continue
+		}
+
+		got, err := checksums.SumFile(file)
+		if err != nil {
+			return err
+		}
+		if got != want {
+			failed++
+			fmt.Fprintf(o.io.StdOut, "%s %s: checksum mismatch\n", c.FailedIcon(), file)
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s %s\n", c.GreenCheck(), file)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("verification failed for %s of %d.", utils.Pluralize(failed, "file"), len(files))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "Verified %s against release %s.\n", utils.Pluralize(len(files), "file"), o.tagName)
+	return nil
+}
+
+// filesToVerify returns the files set as arguments, or the files listed in the
+// checksums that exist in the directory.
+func (o *options) filesToVerify(sums checksums.Checksums) ([]string, error) {
+	if len(o.files) > 0 {
+		return o.files, nil
+	}
+
+	var files []string
+	for name := range sums {
+		file := filepath.Join(o.dir, name)
+		if _, err := os.Stat(file); err == nil {
+			files = append(files, file)
+		}
+	}
+	slices.Sort(files)
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("none of the files listed in %s of release %s exist in %s.", checksums.FileName, o.tagName, o.dir)
+	}
+
+	return files, nil
+}
+
+func (o *options) verifySignature(ctx context.Context, client *gitlab.Client, release *gitlab.Release, content []byte) error {
+	c := o.io.Color()
+
+	var method string
+	var link *gitlab.ReleaseLink
+	for _, m := range checksums.SigningMethods {
+		if link = releaseutils.FindLink(release, checksums.SignatureName(m)); link != nil {
+			method = m
+			break
+		}
+	}
+
+	if link == nil {
+		if o.requireSignature {
+			return fmt.Errorf("%s of release %s is not signed.", checksums.FileName, o.tagName)
+		}
+		fmt.Fprintf(o.io.StdErr, "%s %s is not signed.\n", c.WarnIcon(), checksums.FileName)
+		return nil
+	}
+
+	if method == checksums.SigningMethodCosign && o.key == "" {
+		if o.requireSignature {
+			return &cmdutils.FlagError{Err: fmt.Errorf("%s is signed with cosign. Set the public key to verify it with --key.", checksums.FileName)}
+		}
+		fmt.Fprintf(o.io.StdErr, "%s %s is signed with cosign, but no --key was set. Skipping signature verification.\n", c.WarnIcon(), checksums.FileName)
+		return nil
+	}
+
+	var signature bytes.Buffer
+	if err := releaseutils.DownloadAsset(ctx, client, link.URL, &signature); err != nil {
+		return fmt.Errorf("could not download %s: %w", link.Name, err)
+	}
+
+	if err := checksums.VerifySignature(method, o.key, content, signature.Bytes()); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Verified the %s signature of %s.\n", c.GreenCheck(), method, checksums.FileName)
+	return nil
+}
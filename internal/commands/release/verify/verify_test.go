@@ -0,0 +1,134 @@
+//go:build !integration
+
+package verify
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const (
+	sumA = "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb"
+	sumB = "3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", glinstance.DefaultHostname).Lab()),
+	)
+	cmd := NewCmdVerify(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func releaseResponse(links string) httpmock.Responder {
+	return httpmock.NewStringResponse(http.StatusOK, `{
+		"name": "v1.0.0",
+		"tag_name": "v1.0.0",
+		"assets": {"links": [`+links+`]}
+	}`)
+}
+
+const checksumsLink = `{"id": 1, "name": "checksums.txt", "url": "https://gitlab.com/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt"}`
+
+func TestReleaseVerify(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("not b"), 0o600))
+
+	t.Run("verifies the files in a directory", func(t *testing.T) {
+		fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0", releaseResponse(checksumsLink))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt",
+			httpmock.NewStringResponse(http.StatusOK, sumA+"  a.txt\n"+sumB+"  missing.txt\n"))
+
+		out, err := runCommand(t, fakeHTTP, "v1.0.0 --dir "+dir)
+		require.NoError(t, err)
+		assert.Equal(t, "✓ "+filepath.Join(dir, "a.txt")+"\nVerified 1 file against release v1.0.0.\n", out.String())
+		assert.Equal(t, "! checksums.txt is not signed.\n", out.Stderr())
+	})
+
+	t.Run("reports mismatches and unlisted files", func(t *testing.T) {
+		fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0", releaseResponse(checksumsLink))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt",
+			httpmock.NewStringResponse(http.StatusOK, sumB+"  b.txt\n"))
+
+		a, b := filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")
+		out, err := runCommand(t, fakeHTTP, "v1.0.0 "+a+" "+b)
+		assert.EqualError(t, err, "verification failed for 2 files of 2.")
+		assert.Equal(t, "x "+a+": not listed in checksums.txt\nx "+b+": checksum mismatch\n", out.String())
+	})
+
+	t.Run("verifies a GPG signature", func(t *testing.T) {
+		fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0", releaseResponse(checksumsLink+`,
+			{"id": 2, "name": "checksums.txt.asc", "url": "https://gitlab.com/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt.asc"}`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt",
+			httpmock.NewStringResponse(http.StatusOK, sumA+"  a.txt\n"))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt.asc",
+			httpmock.NewStringResponse(http.StatusOK, "signature"))
+
+		var gpgArgs []string
+		restore := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+			gpgArgs = cmd.Args
+			return &test.OutputStub{}
+		})
+		defer restore()
+
+		out, err := runCommand(t, fakeHTTP, "v1.0.0 --require-signature --dir "+dir)
+		require.NoError(t, err)
+		assert.Equal(t, "gpg", gpgArgs[0])
+		assert.Equal(t, "✓ Verified the gpg signature of checksums.txt.\n", out.Stderr())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0", releaseResponse(checksumsLink+`,
+			{"id": 2, "name": "checksums.txt.sig", "url": "https://gitlab.com/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt.sig"}`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt",
+			httpmock.NewStringResponse(http.StatusOK, sumA+"  a.txt\n"))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/OWNER/REPO/-/releases/v1.0.0/downloads/checksums.txt.sig",
+			httpmock.NewStringResponse(http.StatusOK, "signature"))
+
+		restore := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+			return &test.OutputStub{Error: errors.New("exit status 1")}
+		})
+		defer restore()
+
+		_, err := runCommand(t, fakeHTTP, "v1.0.0 --key cosign.pub --dir "+dir)
+		assert.EqualError(t, err, "invalid signature of checksums.txt: exit status 1")
+	})
+
+	t.Run("release without checksums", func(t *testing.T) {
+		fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/releases/v1%2E0%2E0", releaseResponse(""))
+
+		_, err := runCommand(t, fakeHTTP, "v1.0.0")
+		assert.EqualError(t, err, "release v1.0.0 has no checksums.txt asset. Upload its assets with `glab release upload --checksums` to publish checksums.")
+	})
+}
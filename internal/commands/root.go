@@ -18,6 +18,9 @@ import (
 	configCmd "gitlab.com/gitlab-org/cli/internal/commands/config"
 	deployKeyCmd "gitlab.com/gitlab-org/cli/internal/commands/deploy-key"
 	duoCmd "gitlab.com/gitlab-org/cli/internal/commands/duo"
+	epicCmd "gitlab.com/gitlab-org/cli/internal/commands/epic"
+	extensionCmd "gitlab.com/gitlab-org/cli/internal/commands/extension"
+	"gitlab.com/gitlab-org/cli/internal/commands/extension/extensionutils"
 	gpgCmd "gitlab.com/gitlab-org/cli/internal/commands/gpg-key"
 	"gitlab.com/gitlab-org/cli/internal/commands/help"
 	incidentCmd "gitlab.com/gitlab-org/cli/internal/commands/incident"
@@ -29,9 +32,11 @@ import (
 	milestoneCmd "gitlab.com/gitlab-org/cli/internal/commands/milestone"
 	mrCmd "gitlab.com/gitlab-org/cli/internal/commands/mr"
 	opentofuCmd "gitlab.com/gitlab-org/cli/internal/commands/opentofu"
+	profileCmd "gitlab.com/gitlab-org/cli/internal/commands/profile"
 	projectCmd "gitlab.com/gitlab-org/cli/internal/commands/project"
 	releaseCmd "gitlab.com/gitlab-org/cli/internal/commands/release"
 	scheduleCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule"
+	searchCmd "gitlab.com/gitlab-org/cli/internal/commands/search"
 	securefileCmd "gitlab.com/gitlab-org/cli/internal/commands/securefile"
 	snippetCmd "gitlab.com/gitlab-org/cli/internal/commands/snippet"
 	sshCmd "gitlab.com/gitlab-org/cli/internal/commands/ssh-key"
@@ -41,6 +46,9 @@ import (
 	userCmd "gitlab.com/gitlab-org/cli/internal/commands/user"
 	variableCmd "gitlab.com/gitlab-org/cli/internal/commands/variable"
 	versionCmd "gitlab.com/gitlab-org/cli/internal/commands/version"
+	webhookCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook"
+	wikiCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki"
+	workItemCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem"
 )
 
 // NewCmdRoot is the main root/parent command
@@ -79,6 +87,9 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 
 			GLAB_DEBUG_HTTP: Set to true to output HTTP transport information (request / response).
 
+			GLAB_PROFILE: The configuration profile to use. Overrides the profile set with 'glab profile use'.
+			The --profile flag overrides this variable.
+
 			GLAB_SEND_TELEMETRY: Set to false to disable telemetry being sent to your GitLab instance.
 			Can be set in the config with 'glab config set telemetry false'.
 			See https://docs.gitlab.com/administration/settings/usage_statistics/ for more information
@@ -149,6 +160,8 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.AddCommand(clusterCmd.NewCmdCluster(f))
 	rootCmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
 	rootCmd.AddCommand(duoCmd.NewCmdDuo(f))
+	rootCmd.AddCommand(epicCmd.NewCmdEpic(f))
+	rootCmd.AddCommand(extensionCmd.NewCmdExtension(f))
 	rootCmd.AddCommand(gpgCmd.NewCmdGPGKey(f))
 	rootCmd.AddCommand(incidentCmd.NewCmdIncident(f))
 	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
@@ -160,9 +173,11 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.AddCommand(mrCmd.NewCmdMR(f))
 	rootCmd.AddCommand(opentofuCmd.NewCmd(f))
 	rootCmd.AddCommand(pipelineCmd.NewCmdCI(f))
+	rootCmd.AddCommand(profileCmd.NewCmdProfile(f))
 	rootCmd.AddCommand(projectCmd.NewCmdRepo(f))
 	rootCmd.AddCommand(releaseCmd.NewCmdRelease(f))
 	rootCmd.AddCommand(scheduleCmd.NewCmdSchedule(f))
+	rootCmd.AddCommand(searchCmd.NewCmdSearch(f))
 	rootCmd.AddCommand(securefileCmd.NewCmdSecurefile(f))
 	rootCmd.AddCommand(snippetCmd.NewCmdSnippet(f))
 	rootCmd.AddCommand(sshCmd.NewCmdSSHKey(f))
@@ -170,11 +185,24 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.AddCommand(tokenCmd.NewTokenCmd(f))
 	rootCmd.AddCommand(userCmd.NewCmdUser(f))
 	rootCmd.AddCommand(variableCmd.NewVariableCmd(f))
+	rootCmd.AddCommand(webhookCmd.NewCmdWebhook(f))
+	rootCmd.AddCommand(wikiCmd.NewCmdWiki(f))
+	rootCmd.AddCommand(workItemCmd.NewCmdWorkItem(f))
+
+	// Installed extensions, unless a built-in command has the same name
+	extensions, _ := extensionutils.NewManager(extensionutils.Dir()).List()
+	for _, ext := range extensions {
+		if cmd, _, err := rootCmd.Find([]string{ext.Name}); err == nil && cmd != rootCmd {
+			continue
+		}
+		rootCmd.AddCommand(extensionCmd.NewCmdRun(f, ext))
+	}
 
 	// TODO: This can probably be removed by GitLab 18.3
 	// See: https://gitlab.com/gitlab-org/cli/-/issues/7885
 	// Add global repo override flag but keep it hidden
 	cmdutils.AddGlobalRepoOverride(rootCmd, f)
+	cmdutils.AddGlobalProfileFlag(rootCmd)
 
 	rootCmd.Flags().BoolP("version", "v", false, "show glab version information")
 	return rootCmd
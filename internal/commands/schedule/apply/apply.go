@@ -0,0 +1,444 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/schedule/manifest"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	file          string
+	prune         bool
+	dryRun        bool
+	takeOwnership bool
+	yes           bool
+
+	currentUser *gitlab.User
+}
+
+type summary struct {
+	created, updated, unchanged, deleted int
+}
+
+func (s summary) changed() bool {
+	return s.created > 0 || s.updated > 0 || s.deleted > 0
+}
+
+func NewCmdApply(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file> [flags]",
+		Short: `Create, update, or delete pipeline schedules to match a YAML file.`,
+		Long: heredoc.Docf(`
+			Reconcile the pipeline schedules of a project with a YAML file, in the format
+			written by %[1]sglab schedule export%[1]s:
+
+			%[1]s%[1]s%[1]syaml
+			schedules:
+			  - description: Nightly build
+			    ref: main
+			    cron: 0 2 * * *
+			    cron_timezone: Europe/Berlin  # Default: UTC
+			    active: true                  # Default: true
+			    variables:
+			      - key: TARGET
+			        value: nightly
+			        variable_type: env_var    # Default: env_var
+			%[1]s%[1]s%[1]s
+
+			Schedules are matched by their description. Schedules in the file that don't
+			exist are created, and existing schedules and their variables are updated to
+			match the file. With %[1]s--prune%[1]s, schedules that are not in the file are deleted.
+
+			A schedule stops running when its owner is blocked or deactivated, for example
+			after they leave the company. When applying, you take ownership of such schedules,
+			and of schedules without an owner. To take ownership of all schedules in the file,
+			use %[1]s--take-ownership%[1]s. The variables of a schedule are exposed to its owner,
+			and pipelines run with the permissions of the owner.
+
+			The command prints the changes, and applies them after confirmation. When not
+			running interactively, %[1]s--yes%[1]s is required.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Show what would change, without changing anything
+			$ glab schedule apply -f schedules.yml --dry-run
+
+			# Apply the schedules, and delete all schedules that are not in the file
+			$ glab schedule apply -f schedules.yml --prune
+
+			# Apply the schedules without confirmation, for example in CI/CD
+			$ glab schedule apply -f schedules.yml --yes
+
+			# Apply the schedules, and make the current user the owner of all of them
+			$ glab schedule apply -f schedules.yml --take-ownership
+		`),
+		Args: cobra.ExactArgs(0),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.yes && !opts.dryRun && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Path to the YAML file with the schedules.")
+	cmd.Flags().BoolVar(&opts.prune, "prune", false, "Delete schedules that are not in the file.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the changes without applying them.")
+	cmd.Flags().BoolVar(&opts.takeOwnership, "take-ownership", false, "Take ownership of all schedules in the file, not only of those with an inactive owner.")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Apply the changes without asking for confirmation.")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	m, err := manifest.Read(o.file)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	project := repo.FullName()
+
+	if o.takeOwnership {
+		o.currentUser, _, err = client.Users.CurrentUser()
+		if err != nil {
+			return err
+		}
+	}
+
+	existing, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PipelineSchedule, *gitlab.Response, error) {
+		return client.PipelineSchedules.ListPipelineSchedules(project, &gitlab.ListPipelineSchedulesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list pipeline schedules.")
+	}
+
+	if !o.dryRun && !o.yes {
+		// Show the changes first: applying can delete schedules, and take
+		// ownership of them.
+		o.dryRun = true
+		sum, err := o.apply(client, project, m, existing)
+		if err != nil {
+			return err
+		}
+		o.printSummary(m, project, sum)
+		if !sum.changed() {
+			return nil
+		}
+
+		err = o.io.Confirm(ctx, &o.yes, "Apply these changes?")
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !o.yes {
+			return cmdutils.CancelError()
+		}
+		o.dryRun = false
+	}
+
+	sum, err := o.apply(client, project, m, existing)
+	if err != nil {
+		return err
+	}
+	o.printSummary(m, project, sum)
+
+	return nil
+}
+
+// apply reconciles the existing schedules with the schedules of the manifest,
+// or only reports the changes in a dry run.
+func (o *options) apply(client *gitlab.Client, project string, m *manifest.Manifest, existing []*gitlab.PipelineSchedule) (summary, error) {
+	byDescription := map[string][]*gitlab.PipelineSchedule{}
+	for _, s := range existing {
+		byDescription[s.Description] = append(byDescription[s.Description], s)
+	}
+
+	var sum summary
+	for _, desired := range m.Schedules {
+		matches := byDescription[desired.Description]
+		switch len(matches) {
+		case 0:
+			if err := o.create(client, project, desired); err != nil {
+				return sum, cmdutils.WrapError(err, fmt.Sprintf("failed to create schedule %q.", desired.Description))
+			}
+			sum.created++
+		case 1:
+			changed, err := o.reconcile(client, project, matches[0].ID, desired)
+			if err != nil {
+				return sum, cmdutils.WrapError(err, fmt.Sprintf("failed to update schedule %q.", desired.Description))
+			}
+			if changed {
+				sum.updated++
+			} else {
+				sum.unchanged++
+			}
+		default:
+			return sum, fmt.Errorf("%d schedules are named %q. Rename or delete the duplicates first.", len(matches), desired.Description)
+		}
+	}
+
+	if o.prune {
+		desired := map[string]bool{}
+		for _, s := range m.Schedules {
+			desired[s.Description] = true
+		}
+		for _, s := range existing {
+			if desired[s.Description] {
+				continue
+			}
+			if err := o.delete(client, project, s); err != nil {
+				return sum, cmdutils.WrapError(err, fmt.Sprintf("failed to delete schedule %q.", s.Description))
+			}
+			sum.deleted++
+		}
+	}
+
+	return sum, nil
+}
+
+func (o *options) printSummary(m *manifest.Manifest, project string, sum summary) {
+	verb := "Applied"
+	if o.dryRun {
+		verb = "Would apply"
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s to %s: %d created, %d updated, %d unchanged, %d deleted.\n",
+		verb, scheduleCount(len(m.Schedules)), project, sum.created, sum.updated, sum.unchanged, sum.deleted)
+}
+
+func scheduleCount(n int) string {
+	if n == 1 {
+		return "1 schedule"
+	}
+	return fmt.Sprintf("%d schedules", n)
+}
+
+// report prints what was done, or what would be done in a dry run.
+func (o *options) report(done, wouldDo string) {
+	if o.dryRun {
+		fmt.Fprintln(o.io.StdOut, wouldDo)
+		return
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s\n", o.io.Color().GreenCheck(), done)
+}
+
+func (o *options) create(client *gitlab.Client, project string, desired *manifest.Schedule) error {
+	if o.dryRun {
+		fmt.Fprintf(o.io.StdOut, "Would create schedule %q.\n", desired.Description)
+		return nil
+	}
+
+	schedule, _, err := client.PipelineSchedules.CreatePipelineSchedule(project, &gitlab.CreatePipelineScheduleOptions{
+		Description:  gitlab.Ptr(desired.Description),
+		Ref:          gitlab.Ptr(desired.Ref),
+		Cron:         gitlab.Ptr(desired.Cron),
+		CronTimezone: gitlab.Ptr(desired.Timezone()),
+		Active:       gitlab.Ptr(desired.IsActive()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range desired.Variables {
+		_, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(project, schedule.ID, &gitlab.CreatePipelineScheduleVariableOptions{
+			Key:          gitlab.Ptr(v.Key),
+			Value:        gitlab.Ptr(v.Value),
+			VariableType: gitlab.Ptr(gitlab.VariableTypeValue(v.Type())),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Created schedule %q with ID %d.\n", o.io.Color().GreenCheck(), desired.Description, schedule.ID)
+	return nil
+}
+
+func (o *options) delete(client *gitlab.Client, project string, schedule *gitlab.PipelineSchedule) error {
+	if !o.dryRun {
+		if _, err := client.PipelineSchedules.DeletePipelineSchedule(project, schedule.ID); err != nil {
+			return err
+		}
+	}
+	o.report(
+		fmt.Sprintf("Deleted schedule %q with ID %d.", schedule.Description, schedule.ID),
+		fmt.Sprintf("Would delete schedule %q with ID %d.", schedule.Description, schedule.ID),
+	)
+	return nil
+}
+
+// ownershipReason returns why the current user should take ownership of the
+// schedule, or an empty string if they should not.
+func (o *options) ownershipReason(owner *gitlab.User) string {
+	switch {
+	case owner == nil:
+		return "it has no owner"
+	case owner.State != "" && owner.State != "active":
+		return fmt.Sprintf("its owner %s is %s", owner.Username, owner.State)
+	case o.currentUser != nil && owner.ID != o.currentUser.ID:
+		return "of --take-ownership"
+	}
+	return ""
+}
+
+// reconcile updates the schedule with the given ID to match desired, and
+// reports whether anything changed.
+func (o *options) reconcile(client *gitlab.Client, project string, id int64, desired *manifest.Schedule) (bool, error) {
+	// Only the API to get a single schedule returns its variables.
+	current, _, err := client.PipelineSchedules.GetPipelineSchedule(project, id)
+	if err != nil {
+		return false, err
+	}
+
+	var changes []string
+
+	// Take ownership first, because only the owner can edit a schedule.
+	if reason := o.ownershipReason(current.Owner); reason != "" {
+		if !o.dryRun {
+			if _, _, err := client.PipelineSchedules.TakeOwnershipOfPipelineSchedule(project, id); err != nil {
+				return false, err
+			}
+		}
+		o.report(
+			fmt.Sprintf("Took ownership of schedule %q with ID %d, because %s.", desired.Description, id, reason),
+			fmt.Sprintf("Would take ownership of schedule %q with ID %d, because %s.", desired.Description, id, reason),
+		)
+		changes = append(changes, "owner")
+	}
+
+	opts := &gitlab.EditPipelineScheduleOptions{}
+	if current.Ref != desired.Ref {
+		opts.Ref = gitlab.Ptr(desired.Ref)
+		changes = append(changes, "ref")
+	}
+	if current.Cron != desired.Cron {
+		opts.Cron = gitlab.Ptr(desired.Cron)
+		changes = append(changes, "cron")
+	}
+	if current.CronTimezone != desired.Timezone() {
+		opts.CronTimezone = gitlab.Ptr(desired.Timezone())
+		changes = append(changes, "cron_timezone")
+	}
+	if current.Active != desired.IsActive() {
+		opts.Active = gitlab.Ptr(desired.IsActive())
+		changes = append(changes, "active")
+	}
+	if opts.Ref != nil || opts.Cron != nil || opts.CronTimezone != nil || opts.Active != nil {
+		if !o.dryRun {
+			if _, _, err := client.PipelineSchedules.EditPipelineSchedule(project, id, opts); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	variablesChanged, err := o.reconcileVariables(client, project, id, current.Variables, desired.Variables)
+	if err != nil {
+		return false, err
+	}
+	if variablesChanged {
+		changes = append(changes, "variables")
+	}
+
+	if len(changes) == 0 {
+		return false, nil
+	}
+	o.report(
+		fmt.Sprintf("Updated schedule %q with ID %d: %s.", desired.Description, id, strings.Join(changes, ", ")),
+		fmt.Sprintf("Would update schedule %q with ID %d: %s.", desired.Description, id, strings.Join(changes, ", ")),
+	)
+	return true, nil
+}
+
+func (o *options) reconcileVariables(client *gitlab.Client, project string, id int64, current []*gitlab.PipelineVariable, desired []*manifest.Variable) (bool, error) {
+	currentByKey := map[string]*gitlab.PipelineVariable{}
+	for _, v := range current {
+		currentByKey[v.Key] = v
+	}
+
+	changed := false
+	for _, v := range desired {
+		variableType := gitlab.VariableTypeValue(v.Type())
+		cur, ok := currentByKey[v.Key]
+		delete(currentByKey, v.Key)
+
+		switch {
+		case !ok:
+			changed = true
+			if o.dryRun {
+				continue
+			}
+			_, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(project, id, &gitlab.CreatePipelineScheduleVariableOptions{
+				Key:          gitlab.Ptr(v.Key),
+				Value:        gitlab.Ptr(v.Value),
+				VariableType: gitlab.Ptr(variableType),
+			})
+			if err != nil {
+				return false, err
+			}
+		case cur.Value != v.Value || cur.VariableType != variableType:
+			changed = true
+			if o.dryRun {
+				continue
+			}
+			_, _, err := client.PipelineSchedules.EditPipelineScheduleVariable(project, id, v.Key, &gitlab.EditPipelineScheduleVariableOptions{
+				Value:        gitlab.Ptr(v.Value),
+				VariableType: gitlab.Ptr(variableType),
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// The variables left are not in the file anymore.
+	for _, v := range current {
+		if _, ok := currentByKey[v.Key]; !ok {
+			continue
+		}
+		changed = true
+		if o.dryRun {
+			continue
+		}
+		if _, _, err := client.PipelineSchedules.DeletePipelineScheduleVariable(project, id, v.Key); err != nil {
+			return false, err
+		}
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,247 @@
+//go:build !integration
+
+package apply
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/survivorbat/huhtest"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdApply(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+// expectBody returns a responder that checks the JSON body of the request.
+func expectBody(t *testing.T, body string, resp httpmock.Responder) httpmock.Responder {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		got, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, body, string(got))
+		return resp(req)
+	}
+}
+
+func writeFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "schedules.yml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+const schedulesFile = `
+schedules:
+  - description: Nightly build
+    ref: main
+    cron: 0 3 * * *
+    variables:
+      - key: TARGET
+        value: nightly
+      - key: NEW
+        value: "1"
+  - description: Weekly cleanup
+    ref: main
+    cron: 0 0 * * 0
+    active: false
+    variables:
+      - key: MODE
+        value: full
+  - description: Same
+    ref: main
+    cron: 0 1 * * *
+`
+
+func registerExisting(fakeHTTP *httpmock.Mocker) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1, "description": "Nightly build"},
+			{"id": 2, "description": "Old"},
+			{"id": 4, "description": "Same"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 1, "description": "Nightly build", "ref": "main", "cron": "0 2 * * *", "cron_timezone": "UTC",
+			"active": true, "owner": {"id": 7, "username": "alice", "state": "blocked"},
+			"variables": [
+				{"key": "TARGET", "value": "old", "variable_type": "env_var"},
+				{"key": "STALE", "value": "x", "variable_type": "env_var"}
+			]
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules/4",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 4, "description": "Same", "ref": "main", "cron": "0 1 * * *", "cron_timezone": "UTC",
+			"active": true, "owner": {"id": 8, "username": "bob", "state": "active"}, "variables": []
+		}`))
+}
+
+func TestScheduleApply(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerExisting(fakeHTTP)
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1/take_ownership",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1",
+		expectBody(t, `{"cron": "0 3 * * *"}`, httpmock.NewStringResponse(http.StatusOK, `{"id": 1}`)))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1/variables/TARGET",
+		expectBody(t, `{"value": "nightly", "variable_type": "env_var"}`, httpmock.NewStringResponse(http.StatusOK, `{}`)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1/variables",
+		expectBody(t, `{"key": "NEW", "value": "1", "variable_type": "env_var"}`, httpmock.NewStringResponse(http.StatusCreated, `{}`)))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1/variables/STALE",
+		httpmock.NewStringResponse(http.StatusOK, `{}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		expectBody(t, `{"description": "Weekly cleanup", "ref": "main", "cron": "0 0 * * 0", "cron_timezone": "UTC", "active": false}`, httpmock.NewStringResponse(http.StatusCreated, `{"id": 3}`)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules/3/variables",
+		expectBody(t, `{"key": "MODE", "value": "full", "variable_type": "env_var"}`, httpmock.NewStringResponse(http.StatusCreated, `{}`)))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/pipeline_schedules/2",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(t, fakeHTTP, "--prune --yes -f "+writeFile(t, schedulesFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ Took ownership of schedule "Nightly build" with ID 1, because its owner alice is blocked.
+		✓ Updated schedule "Nightly build" with ID 1: owner, cron, variables.
+		✓ Created schedule "Weekly cleanup" with ID 3.
+		✓ Deleted schedule "Old" with ID 2.
+		Applied 3 schedules to OWNER/REPO: 1 created, 1 updated, 1 unchanged, 1 deleted.
+	`), output.String())
+}
+
+func TestScheduleApplyDryRun(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerExisting(fakeHTTP)
+
+	output, err := runCommand(t, fakeHTTP, "--prune --dry-run -f "+writeFile(t, schedulesFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Would take ownership of schedule "Nightly build" with ID 1, because its owner alice is blocked.
+		Would update schedule "Nightly build" with ID 1: owner, cron, variables.
+		Would create schedule "Weekly cleanup".
+		Would delete schedule "Old" with ID 2.
+		Would apply 3 schedules to OWNER/REPO: 1 created, 1 updated, 1 unchanged, 1 deleted.
+	`), output.String())
+}
+
+func TestScheduleApplyTakeOwnership(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/user",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "username": "me"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "description": "Same"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules/4",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 4, "description": "Same", "ref": "main", "cron": "0 1 * * *", "cron_timezone": "UTC",
+			"active": true, "owner": {"id": 8, "username": "bob", "state": "active"}, "variables": []
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules/4/take_ownership",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 4}`))
+
+	output, err := runCommand(t, fakeHTTP, "--take-ownership --yes -f "+writeFile(t, heredoc.Doc(`
+		schedules:
+		  - description: Same
+		    ref: main
+		    cron: 0 1 * * *
+	`)))
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), `✓ Took ownership of schedule "Same" with ID 4, because of --take-ownership.`)
+	assert.Contains(t, output.String(), "Applied 1 schedule to OWNER/REPO: 0 created, 1 updated, 0 unchanged, 0 deleted.")
+}
+
+func TestScheduleApplyRequiresYesWhenNotInteractive(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "--prune -f "+writeFile(t, schedulesFile))
+	assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+}
+
+func TestScheduleApplyCancelled(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	// Only the schedules are read: nothing is changed when the changes are not confirmed.
+	registerExisting(fakeHTTP)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, true,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", "gitlab.com").Lab()),
+		cmdtest.WithResponder(t, huhtest.NewResponder().AddConfirm("Apply these changes?", huhtest.ConfirmNegative)),
+	)
+
+	_, err := exec("--prune -f " + writeFile(t, schedulesFile))
+	var exitErr *cmdutils.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, "action cancelled", exitErr.Details)
+}
+
+func TestScheduleApplyDuplicateDescriptions(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "description": "Same"}, {"id": 5, "description": "Same"}]`))
+
+	_, err := runCommand(t, fakeHTTP, "--yes -f "+writeFile(t, heredoc.Doc(`
+		schedules:
+		  - description: Same
+		    ref: main
+		    cron: 0 1 * * *
+	`)))
+	require.Error(t, err)
+	assert.Equal(t, `2 schedules are named "Same". Rename or delete the duplicates first.`, err.Error())
+}
+
+func TestScheduleApplyInvalidFile(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "--yes -f "+writeFile(t, heredoc.Doc(`
+		schedules:
+		  - description: No cron
+		    ref: main
+	`)))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `schedule "No cron" has no cron.`)
+}
+
+func TestScheduleApplyInvalidCron(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "--yes -f "+writeFile(t, heredoc.Doc(`
+		schedules:
+		  - description: Typo
+		    ref: main
+		    cron: 0 3 * * * *
+	`)))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `schedule "Typo": invalid cron expression "0 3 * * * *": expected 5 fields`)
+}
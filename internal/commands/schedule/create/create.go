@@ -1,8 +1,11 @@
 package create
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -10,6 +13,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/cronexpr"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -23,12 +27,64 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			# Create a scheduled pipeline that runs every hour
 			$ glab schedule create --cron "0 * * * *" --description "Describe your pipeline here" --ref "main" --variable "foo:bar" --variable "baz:baz"
 			> Created schedule
+
+			# Print the next 10 runs of a schedule, without creating it
+			$ glab schedule create --cron "0 3 * * mon-fri" --cronTimeZone "Europe/Berlin" --description "Nightly" --preview --preview-count 10
 		`),
-		Long: ``,
+		Long: heredoc.Docf(`
+			Schedule a new pipeline.
+
+			The cron pattern is validated before the schedule is created. It supports the
+			same syntax as GitLab: five fields, lists, ranges, steps, month and weekday
+			names, and macros like %[1]s@daily%[1]s. Use %[1]s--preview%[1]s to print the next
+			runs in the %[1]s--cronTimeZone%[1]s timezone, without creating the schedule. With
+			%[1]s--preview%[1]s, %[1]s--ref%[1]s and %[1]s--description%[1]s are not required.
+		`, "`"),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			cron, _ := cmd.Flags().GetString("cron")
+			cronTimeZone, _ := cmd.Flags().GetString("cronTimeZone")
+			preview, _ := cmd.Flags().GetBool("preview")
+			previewCount, _ := cmd.Flags().GetInt("preview-count")
+
+			if cmd.Flags().Changed("preview-count") && !preview {
+				return cmdutils.FlagError{Err: errors.New("--preview-count can only be used with --preview.")}
+			}
+			if preview && previewCount < 1 {
+				return cmdutils.FlagError{Err: errors.New("--preview-count must be at least 1.")}
+			}
+			if !preview {
+				var missing []string
+				for _, name := range []string{"description", "ref"} {
+					if !cmd.Flags().Changed(name) {
+						missing = append(missing, fmt.Sprintf("%q", name))
+					}
+				}
+				if len(missing) > 0 {
+					return cmdutils.FlagError{Err: fmt.Errorf("required flag(s) %s not set", strings.Join(missing, ", "))}
+				}
+			}
+
+			expr, err := cronexpr.Parse(cron)
+			if err != nil {
+				return cmdutils.FlagError{Err: err}
+			}
+
+			if preview {
+				loc, err := cronexpr.LoadLocation(cronTimeZone)
+				if err != nil {
+					return cmdutils.FlagError{Err: err}
+				}
+				return printPreview(f.IO().StdOut, expr, cron, loc, previewCount)
+			}
+
+			c := f.IO().Color()
+			if warning := expr.Warning(time.Now()); warning != "" {
+				fmt.Fprintf(f.IO().StdErr, "%s %s\n", c.WarnIcon(), warning)
+			}
+
 			client, err := f.GitLabClient()
 			if err != nil {
 				return err
@@ -45,8 +101,6 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 
 			description, _ := cmd.Flags().GetString("description")
 			ref, _ := cmd.Flags().GetString("ref")
-			cron, _ := cmd.Flags().GetString("cron")
-			cronTimeZone, _ := cmd.Flags().GetString("cronTimeZone")
 			active, _ := cmd.Flags().GetBool("active")
 			variableList, _ = cmd.Flags().GetStringSlice("variable")
 
@@ -84,11 +138,28 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	scheduleCreateCmd.Flags().String("cron", "", "Cron interval pattern.")
 	scheduleCreateCmd.Flags().String("cronTimeZone", "UTC", "Cron timezone.")
 	scheduleCreateCmd.Flags().Bool("active", true, "Whether or not the schedule is active.")
+	scheduleCreateCmd.Flags().Bool("preview", false, "Print the next runs of the schedule in its timezone, without creating it.")
+	scheduleCreateCmd.Flags().Int("preview-count", 5, "Number of runs to print with --preview.")
 	scheduleCreateCmd.Flags().StringSliceVar(&variableList, "variable", []string{}, "Pass variables to schedule in the format <key>:<value>. Repeat flag for multiple variables.")
 
-	_ = scheduleCreateCmd.MarkFlagRequired("ref")
+	// --ref and --description are checked in RunE: they aren't needed with --preview.
 	_ = scheduleCreateCmd.MarkFlagRequired("cron")
-	_ = scheduleCreateCmd.MarkFlagRequired("description")
 
 	return scheduleCreateCmd
 }
+
+func printPreview(w io.Writer, expr *cronexpr.Expression, cron string, loc *time.Location, n int) error {
+	runs := expr.NextN(time.Now().In(loc), n)
+	if len(runs) == 0 {
+		return fmt.Errorf("the cron pattern %q never runs.", cron)
+	}
+
+	fmt.Fprintf(w, "Next %d runs of %q in %s:\n", len(runs), cron, loc)
+	for _, run := range runs {
+		fmt.Fprintln(w, run.Format(cronexpr.TimeLayout))
+	}
+	if warning := expr.Warning(time.Now().In(loc)); warning != "" {
+		fmt.Fprintln(w, warning)
+	}
+	return nil
+}
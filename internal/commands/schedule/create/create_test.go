@@ -34,7 +34,7 @@ func Test_ScheduleCreate(t *testing.T) {
 		{
 			Name:        "Schedule created",
 			ExpectedMsg: []string{"Created schedule with ID 2"},
-			cli:         "--cron '*0 * * * *' --description 'example pipeline' --ref 'main'",
+			cli:         "--cron '0 * * * *' --description 'example pipeline' --ref 'main'",
 			httpMocks: []httpMock{
 				{
 					http.MethodPost,
@@ -49,13 +49,13 @@ func Test_ScheduleCreate(t *testing.T) {
 			wantStderr:  "required flag(s) \"ref\" not set",
 			wantErr:     true,
 			ExpectedMsg: []string{""},
-			cli:         "--cron '*0 * * * *' --description 'example pipeline'",
+			cli:         "--cron '0 * * * *' --description 'example pipeline'",
 		},
 		{
 			Name:       "Schedule created but with skipped variable",
 			wantStderr: "invalid format for --variable: foo",
 			wantErr:    true,
-			cli:        "--cron '*0 * * * *' --description 'example pipeline' --ref 'main'  --variable 'foo'",
+			cli:        "--cron '0 * * * *' --description 'example pipeline' --ref 'main'  --variable 'foo'",
 			httpMocks: []httpMock{
 				{
 					http.MethodPost,
@@ -68,7 +68,7 @@ func Test_ScheduleCreate(t *testing.T) {
 		{
 			Name:        "Schedule created with variable",
 			ExpectedMsg: []string{"Created schedule"},
-			cli:         "--cron '*0 * * * *' --description 'example pipeline' --ref 'main' --variable 'foo:bar'",
+			cli:         "--cron '0 * * * *' --description 'example pipeline' --ref 'main' --variable 'foo:bar'",
 			httpMocks: []httpMock{
 				{
 					http.MethodPost,
@@ -84,6 +84,40 @@ func Test_ScheduleCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:       "Schedule not created because of invalid cron",
+			wantStderr: `invalid cron expression "*0 * * * *": invalid minute "*0".`,
+			wantErr:    true,
+			cli:        "--cron '*0 * * * *' --description 'example pipeline' --ref 'main'",
+		},
+		{
+			Name:        "Schedule previewed",
+			ExpectedMsg: []string{`Next 3 runs of "0 3 * * mon-fri" in Europe/Berlin:`, ":00 CE"},
+			cli:         "--cron '0 3 * * mon-fri' --cronTimeZone 'Europe/Berlin' --preview --preview-count 3",
+		},
+		{
+			Name:        "Schedule previewed with the default count",
+			ExpectedMsg: []string{`Next 5 runs of "@daily" in Europe/Berlin:`},
+			cli:         "--cron '@daily' --cronTimeZone 'Europe/Berlin' --preview",
+		},
+		{
+			Name:       "Schedule not created without ref",
+			wantStderr: `required flag(s) "ref" not set`,
+			wantErr:    true,
+			cli:        "--cron '0 3 * * *' --description 'example pipeline'",
+		},
+		{
+			Name:       "Preview count without preview",
+			wantStderr: "--preview-count can only be used with --preview.",
+			wantErr:    true,
+			cli:        "--cron '0 3 * * *' --description 'example pipeline' --ref 'main' --preview-count 3",
+		},
+		{
+			Name:       "Schedule not previewed because of unknown timezone",
+			wantStderr: `unknown timezone "Berlin". Use an IANA timezone name, like UTC or Europe/Berlin.`,
+			wantErr:    true,
+			cli:        "--cron '0 3 * * *' --cronTimeZone 'Berlin' --description 'example pipeline' --ref 'main' --preview",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -114,6 +148,20 @@ func Test_ScheduleCreate(t *testing.T) {
 	}
 }
 
+func Test_ScheduleCreateWarnsAboutFrequentRuns(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 2}`))
+
+	out, err := runCommand(t, fakeHTTP, "--cron '* 3 * * *' --description 'example pipeline' --ref 'main'")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Created schedule with ID 2\n", out.String())
+	assert.Equal(t, "! The schedule runs every minute. Check that this is intended, each run uses CI/CD minutes.\n", out.Stderr())
+}
+
 func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
 	t.Helper()
 
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/schedule/manifest"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+}
+
+func NewCmdExport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: `Export the pipeline schedules of a project as YAML.`,
+		Long: heredoc.Docf(`
+			Export all pipeline schedules of a project, including their variables, as YAML.
+
+			The output can be changed and applied to the same, or another, project with
+			%[1]sglab schedule apply%[1]s. The owner of each schedule is included for reference,
+			but is ignored when applying.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Export the schedules of the current project
+			$ glab schedule export > schedules.yml
+
+			# Copy the schedules of one project to another
+			$ glab schedule export -R group/source > schedules.yml
+			$ glab schedule apply -R group/target -f schedules.yml
+		`),
+		Args: cobra.ExactArgs(0),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	schedules, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PipelineSchedule, *gitlab.Response, error) {
+		return client.PipelineSchedules.ListPipelineSchedules(repo.FullName(), &gitlab.ListPipelineSchedulesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to list pipeline schedules.")
+	}
+
+	m := &manifest.Manifest{Schedules: []*manifest.Schedule{}}
+	for _, s := range schedules {
+		// Only the API to get a single schedule returns its variables.
+		schedule, _, err := client.PipelineSchedules.GetPipelineSchedule(repo.FullName(), s.ID)
+		if err != nil {
+			return cmdutils.WrapError(err, "failed to get pipeline schedule.")
+		}
+		m.Schedules = append(m.Schedules, manifest.FromPipelineSchedule(schedule))
+	}
+
+	out, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = o.io.StdOut.Write(out)
+	return err
+}
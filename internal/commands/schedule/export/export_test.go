@@ -0,0 +1,82 @@
+//go:build !integration
+
+package export
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdExport(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestScheduleExport(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1}, {"id": 2}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules/1",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 1, "description": "Nightly build", "ref": "main", "cron": "0 2 * * *",
+			"cron_timezone": "Europe/Berlin", "active": true, "owner": {"id": 7, "username": "alice"},
+			"variables": [{"key": "TARGET", "value": "nightly", "variable_type": "env_var"}]
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules/2",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 2, "description": "Weekly cleanup", "ref": "main", "cron": "0 0 * * 0",
+			"cron_timezone": "UTC", "active": false, "owner": {"id": 8, "username": "bob"}, "variables": []
+		}`))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		schedules:
+		  - description: Nightly build
+		    ref: main
+		    cron: 0 2 * * *
+		    cron_timezone: Europe/Berlin
+		    active: true
+		    variables:
+		      - key: TARGET
+		        value: nightly
+		        variable_type: env_var
+		    owner: alice
+		  - description: Weekly cleanup
+		    ref: main
+		    cron: 0 0 * * 0
+		    cron_timezone: UTC
+		    active: false
+		    owner: bob
+	`), output.String())
+	assert.Empty(t, output.Stderr())
+}
+
+func TestScheduleExportEmpty(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/pipeline_schedules",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+	assert.Equal(t, "schedules: []\n", output.String())
+}
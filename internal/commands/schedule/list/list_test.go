@@ -4,10 +4,12 @@ package list
 
 import (
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/acarl005/stripansi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
@@ -94,3 +96,46 @@ func Test_NoScheduleList(t *testing.T) {
 		assert.Equal(t, "", stderr.String())
 	})
 }
+
+func Test_ScheduleListNextRun(t *testing.T) {
+	io, _, stdout, _ := cmdtest.TestIOStreams(cmdtest.WithTestIOStreamsAsTTY(true))
+	f := cmdtest.NewTestFactory(io, cmdtest.WithConfig(config.NewFromString(heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    username: monalisa
+		    token: OTOKEN
+	`))))
+
+	nextRunAt := time.Date(2025, time.January, 2, 2, 0, 0, 0, time.UTC)
+	getSchedules = func(*gitlab.Client, *gitlab.ListPipelineSchedulesOptions, string) ([]*gitlab.PipelineSchedule, error) {
+		return []*gitlab.PipelineSchedule{
+			{
+				ID:           1,
+				Description:  "nightly",
+				Cron:         "0 3 * * *",
+				CronTimezone: "Europe/Berlin",
+				Owner:        &gitlab.User{Username: "bar"},
+				Active:       true,
+				NextRunAt:    &nextRunAt,
+			},
+			{
+				ID:          2,
+				Description: "paused",
+				Cron:        "0 4 * * *",
+				Owner:       &gitlab.User{Username: "bar"},
+				Active:      false,
+				NextRunAt:   &nextRunAt,
+			},
+		}, nil
+	}
+
+	cmd := NewCmdList(f)
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+
+	out := stripansi.Strip(stdout.String())
+	assert.Contains(t, out, "1\tnightly\t0 3 * * *\tbar\ttrue\t2025-01-02 03:00 CET")
+	assert.Contains(t, out, "2\tpaused\t0 4 * * *\tbar\tfalse\t-")
+}
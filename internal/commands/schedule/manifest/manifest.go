@@ -0,0 +1,155 @@
+// Package manifest defines the YAML file format of `glab schedule export` and
+// `glab schedule apply`.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/internal/cronexpr"
+)
+
+// Manifest is the desired set of pipeline schedules of a project.
+type Manifest struct {
+	Schedules []*Schedule `yaml:"schedules"`
+}
+
+// Schedule is a pipeline schedule. Schedules are identified by their description.
+type Schedule struct {
+	Description  string      `yaml:"description"`
+	Ref          string      `yaml:"ref"`
+	Cron         string      `yaml:"cron"`
+	CronTimezone string      `yaml:"cron_timezone,omitempty"`
+	Active       *bool       `yaml:"active,omitempty"`
+	Variables    []*Variable `yaml:"variables,omitempty"`
+	// Owner is the username of the schedule owner. It is informational only,
+	// and ignored by `glab schedule apply`.
+	Owner string `yaml:"owner,omitempty"`
+}
+
+// Variable is a pipeline schedule variable.
+type Variable struct {
+	Key          string `yaml:"key"`
+	Value        string `yaml:"value"`
+	VariableType string `yaml:"variable_type,omitempty"`
+}
+
+// IsActive returns whether the schedule is active. Schedules are active unless disabled.
+func (s *Schedule) IsActive() bool {
+	return s.Active == nil || *s.Active
+}
+
+// Timezone returns the cron timezone, which defaults to UTC like in the API.
+func (s *Schedule) Timezone() string {
+	if s.CronTimezone == "" {
+		return "UTC"
+	}
+	return s.CronTimezone
+}
+
+// Type returns the variable type, which defaults to env_var like in the API.
+func (v *Variable) Type() string {
+	if v.VariableType == "" {
+		return string(gitlab.EnvVariableType)
+	}
+	return v.VariableType
+}
+
+// FromPipelineSchedule converts a schedule returned by the API. The schedule
+// must include its variables, which only the API to get a single schedule returns.
+func FromPipelineSchedule(ps *gitlab.PipelineSchedule) *Schedule {
+	s := &Schedule{
+		Description:  ps.Description,
+		Ref:          ps.Ref,
+		Cron:         ps.Cron,
+		CronTimezone: ps.CronTimezone,
+		Active:       gitlab.Ptr(ps.Active),
+	}
+	if ps.Owner != nil {
+		s.Owner = ps.Owner.Username
+	}
+	for _, v := range ps.Variables {
+		s.Variables = append(s.Variables, &Variable{
+			Key:          v.Key,
+			Value:        v.Value,
+			VariableType: string(v.VariableType),
+		})
+	}
+	return s
+}
+
+// Read reads and validates a manifest file.
+func Read(path string) (*Manifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := yaml.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid schedule file %s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// Validate checks that all schedules have the required fields and a valid cron
+// pattern, and that descriptions and variable keys are unique.
+func (m *Manifest) Validate() error {
+	descriptions := map[string]bool{}
+	for i, s := range m.Schedules {
+		if s.Description == "" {
+			return fmt.Errorf("schedule %d has no description.", i+1)
+		}
+		if descriptions[s.Description] {
+			return fmt.Errorf("duplicate schedule %q. Descriptions must be unique.", s.Description)
+		}
+		descriptions[s.Description] = true
+
+		if s.Ref == "" {
+			return fmt.Errorf("schedule %q has no ref.", s.Description)
+		}
+		if s.Cron == "" {
+			return fmt.Errorf("schedule %q has no cron.", s.Description)
+		}
+		if _, err := cronexpr.Parse(s.Cron); err != nil {
+			return fmt.Errorf("schedule %q: %w", s.Description, err)
+		}
+
+		keys := map[string]bool{}
+		for _, v := range s.Variables {
+			if v.Key == "" {
+				return fmt.Errorf("schedule %q has a variable without a key.", s.Description)
+			}
+			if keys[v.Key] {
+				return fmt.Errorf("schedule %q has a duplicate variable %s.", s.Description, v.Key)
+			}
+			keys[v.Key] = true
+
+			if t := v.Type(); t != string(gitlab.EnvVariableType) && t != string(gitlab.FileVariableType) {
+				return fmt.Errorf("schedule %q: invalid type %q for variable %s. Must be one of `env_var` or `file`.", s.Description, t, v.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// Marshal returns the YAML representation of the manifest.
+func (m *Manifest) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(m); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
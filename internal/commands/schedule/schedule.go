@@ -4,8 +4,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	scheduleApplyCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/apply"
 	scheduleCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/create"
 	scheduleDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/delete"
+	scheduleExportCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/export"
 	scheduleListCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/list"
 	scheduleRunCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/run"
 	scheduleUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/schedule/update"
@@ -26,6 +28,8 @@ func NewCmdSchedule(f cmdutils.Factory) *cobra.Command {
 	scheduleCmd.AddCommand(scheduleCreateCmd.NewCmdCreate(f))
 	scheduleCmd.AddCommand(scheduleDeleteCmd.NewCmdDelete(f))
 	scheduleCmd.AddCommand(scheduleUpdateCmd.NewCmdUpdate(f))
+	scheduleCmd.AddCommand(scheduleExportCmd.NewCmdExport(f))
+	scheduleCmd.AddCommand(scheduleApplyCmd.NewCmdApply(f))
 
 	return scheduleCmd
 }
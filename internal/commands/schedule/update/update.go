@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
@@ -11,6 +12,7 @@ import (
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/cronexpr"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -42,6 +44,17 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			cron, _ := cmd.Flags().GetString("cron")
+			if cron != "" {
+				expr, err := cronexpr.Parse(cron)
+				if err != nil {
+					return cmdutils.FlagError{Err: err}
+				}
+				if warning := expr.Warning(time.Now()); warning != "" {
+					fmt.Fprintf(f.IO().StdErr, "%s %s\n", f.IO().Color().WarnIcon(), warning)
+				}
+			}
+
 			variablePairsToCreate := make([][2]string, 0, len(variablesToCreate))
 			for _, v := range variablesToCreate {
 				split := strings.SplitN(v, ":", 2)
@@ -83,7 +96,6 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 
 			description, _ := cmd.Flags().GetString("description")
 			ref, _ := cmd.Flags().GetString("ref")
-			cron, _ := cmd.Flags().GetString("cron")
 			cronTimeZone, _ := cmd.Flags().GetString("cronTimeZone")
 			active, _ := cmd.Flags().GetBool("active")
 
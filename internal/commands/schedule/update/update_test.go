@@ -33,7 +33,7 @@ func Test_ScheduleEdit(t *testing.T) {
 		{
 			Name:        "Schedule updated",
 			ExpectedMsg: []string{"Updated schedule with ID 1"},
-			cli:         "1 --cron '*0 * * * *' --description 'example pipeline' --ref 'main'",
+			cli:         "1 --cron '0 * * * *' --description 'example pipeline' --ref 'main'",
 			httpMocks: []httpMock{
 				{
 					http.MethodPut,
@@ -43,6 +43,12 @@ func Test_ScheduleEdit(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:       "Schedule not updated because of invalid cron",
+			wantStderr: `invalid cron expression "0 25 * * *": invalid hour 25: must be between 0 and 23.`,
+			wantErr:    true,
+			cli:        "1 --cron '0 25 * * *'",
+		},
 		{
 			Name:        "Schedule updated with new variable",
 			ExpectedMsg: []string{"Updated schedule with ID 1"},
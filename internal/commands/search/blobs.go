@@ -0,0 +1,120 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// projectPaths resolves the IDs of the projects of results to their paths.
+// Results of the whole instance or of groups are in many projects, but only
+// have their IDs.
+type projectPaths struct {
+	client *gitlab.Client
+	// fixed is the path of all the results, when searching a project.
+	fixed string
+	paths map[int64]string
+}
+
+func (p *projectPaths) path(id int64) string {
+	if p.fixed != "" {
+		return p.fixed
+	}
+	if path, ok := p.paths[id]; ok {
+		return path
+	}
+
+	// A project that can't be read is shown by its ID, instead of failing
+	// the whole search.
+	path := strconv.FormatInt(id, 10)
+	if project, _, err := p.client.Projects.GetProject(id, nil); err == nil {
+		path = project.PathWithNamespace
+	}
+	p.paths[id] = path
+	return path
+}
+
+// printBlobs prints the matches of code or wiki searches. Lines that match
+// the query are highlighted on terminals. Otherwise, they're printed like
+// 'grep -n', with ':' after matching lines, and '-' after the lines around them.
+func (o *options) printBlobs(projects *projectPaths, blobs []*gitlab.Blob) {
+	c := o.io.Color()
+	isTTY := o.io.IsOutputTTY()
+
+	for i, blob := range blobs {
+		project := projects.path(blob.ProjectID)
+		path := blob.Path
+		if path == "" {
+			path = blob.Filename
+		}
+
+		if isTTY {
+			if i > 0 {
+				fmt.Fprintln(o.io.StdOut)
+			}
+			header := c.Bold(project + " › " + path)
+			if blob.Ref != "" {
+				header += c.Gray(" @ " + blob.Ref)
+			}
+			fmt.Fprintln(o.io.StdOut, header)
+		}
+
+		lines := strings.Split(strings.TrimRight(blob.Data, "\n"), "\n")
+		width := len(strconv.FormatInt(blob.Startline+int64(len(lines))-1, 10))
+		for n, line := range lines {
+			lineNumber := blob.Startline + int64(n)
+			matches := containsFold(line, o.query)
+
+			if !isTTY {
+				sep := "-"
+				if matches {
+					sep = ":"
+				}
+				fmt.Fprintf(o.io.StdOut, "%s:%s%s%d%s%s\n", project, path, sep, lineNumber, sep, line)
+				continue
+			}
+
+			number := fmt.Sprintf("%*d", width, lineNumber)
+			if matches {
+				line = highlight(line, o.query, c.Yellow)
+				number = c.Green(number)
+			} else {
+				number = c.Gray(number)
+			}
+			fmt.Fprintf(o.io.StdOut, "  %s  %s\n", number, line)
+		}
+	}
+}
+
+func containsFold(s, substr string) bool {
+	return substr != "" && strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// highlight highlights the occurrences of query in line, ignoring case.
+func highlight(line, query string, color func(string) string) string {
+	if query == "" {
+		return line
+	}
+
+	lowerLine, lowerQuery := strings.ToLower(line), strings.ToLower(query)
+	// Lowercasing can change the length of some characters, which would
+	// make the offsets of the matches wrong.
+	if len(lowerLine) != len(line) || len(lowerQuery) != len(query) {
+		return line
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(lowerLine, lowerQuery)
+		if i < 0 {
+			b.WriteString(line)
+			return b.String()
+		}
+		end := i + len(query)
+		b.WriteString(line[:i])
+		b.WriteString(color(line[i:end]))
+		line, lowerLine = line[end:], lowerLine[end:]
+	}
+}
@@ -0,0 +1,427 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	scopeBlobs         = "blobs"
+	scopeCommits       = "commits"
+	scopeIssues        = "issues"
+	scopeMergeRequests = "merge_requests"
+	scopeNotes         = "notes"
+	scopeWikiBlobs     = "wiki_blobs"
+	scopeMilestones    = "milestones"
+	scopeUsers         = "users"
+)
+
+var scopes = []string{scopeBlobs, scopeCommits, scopeIssues, scopeMergeRequests, scopeNotes, scopeWikiBlobs, scopeMilestones, scopeUsers}
+
+// refScopes are the scopes that can be searched at a Git reference.
+var refScopes = []string{scopeBlobs, scopeCommits, scopeWikiBlobs}
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+
+	query        string
+	scope        string
+	group        string
+	ref          string
+	page         int
+	perPage      int
+	outputFormat string
+	web          bool
+
+	// repo is the project to search in, if the search is in a project.
+	repo glrepo.Interface
+}
+
+func NewCmdSearch(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "search <query> [flags]",
+		Short: `Search for code, commits, issues, merge requests, and more.`,
+		Long: heredoc.Docf(`
+			Search the whole GitLab instance, a group, or a project.
+
+			Searches the whole instance by default. Use %[1]s--group%[1]s to search the
+			projects of a group and its subgroups, or %[1]s--repo%[1]s to search a
+			single project. Notes can only be searched in a project.
+
+			Code results show the project, file, and branch of each match, with
+			the matching lines highlighted. When the output is not a terminal,
+			code results are printed like %[1]sgrep -n%[1]s: %[1]sPROJECT:FILE:LINE:TEXT%[1]s
+			for matching lines, and %[1]sPROJECT:FILE-LINE-TEXT%[1]s for the lines around them.
+
+			Searching code, commits, and wikis in groups or across the instance
+			requires advanced search, or exact code search.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Find where a configuration key is used in the projects of a group
+			$ glab search "DATABASE_POOL_SIZE" --group my-org
+
+			# Search the commits of a project
+			$ glab search "fix flaky test" --scope commits --repo my-org/api
+
+			# Search the code of a branch
+			$ glab search "func main" --repo gitlab-org/cli --ref main
+
+			# Open the results in the browser
+			$ glab search "api_key" --group my-org --web
+
+			# Find issues across the instance, as JSON
+			$ glab search "login timeout" --scope issues --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.query = args[0]
+			if err := opts.complete(cmd); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+	cmd.Flags().StringVarP(&opts.scope, "scope", "s", scopeBlobs, fmt.Sprintf("What to search: %s.", strings.Join(scopes, ", ")))
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Search the projects of a group and its subgroups.")
+	cmd.Flags().StringVar(&opts.ref, "ref", "", "Search code, commits, or wikis at a branch or tag. Requires '--repo'.")
+	cmd.Flags().IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	cmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 20, "Number of results to list per page.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the results in the browser.")
+	cmd.MarkFlagsMutuallyExclusive("group", "repo")
+	cmd.MarkFlagsMutuallyExclusive("web", "output")
+
+	return cmd
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	if !slices.Contains(scopes, o.scope) {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid scope %q. Use one of: %s.", o.scope, strings.Join(scopes, ", "))}
+	}
+	if o.outputFormat != "text" && o.outputFormat != "json" {
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", o.outputFormat)}
+	}
+
+	if cmd.Flags().Changed("repo") {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		o.repo = repo
+	}
+
+	if o.scope == scopeNotes && o.repo == nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("notes can only be searched in a project. Use '--repo' to select it.")}
+	}
+	if o.ref != "" {
+		if o.repo == nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("the '--ref' flag requires '--repo'.")}
+		}
+		if !slices.Contains(refScopes, o.scope) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("the '--ref' flag can only be used with the scopes: %s.", strings.Join(refScopes, ", "))}
+		}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	if o.web {
+		webURL := o.webURL(client)
+		if o.io.IsOutputTTY() {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(webURL))
+		}
+		browser, _ := o.config().Get(client.BaseURL().Hostname(), "browser")
+		return utils.OpenInBrowser(webURL, browser)
+	}
+
+	searchOpts := &gitlab.SearchOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+	}
+	if o.ref != "" {
+		searchOpts.Ref = gitlab.Ptr(o.ref)
+	}
+
+	results, err := o.search(client, searchOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to search %s.", strings.ReplaceAll(o.scope, "_", " ")))
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(results)
+	}
+
+	if o.io.IsOutputTTY() {
+		fmt.Fprintf(o.io.StdErr, "%s\n\n", o.title(resultCount(results)))
+	}
+	if resultCount(results) == 0 {
+		if !o.io.IsOutputTTY() {
+			fmt.Fprintln(o.io.StdErr, o.title(0))
+		}
+		return nil
+	}
+
+	projects := &projectPaths{client: client, paths: map[int64]string{}}
+	if o.repo != nil {
+		projects.fixed = o.repo.FullName()
+	}
+
+	switch r := results.(type) {
+	case []*gitlab.Blob:
+		o.printBlobs(projects, r)
+	default:
+		fmt.Fprint(o.io.StdOut, o.table(projects, results))
+	}
+	return nil
+}
+
+// search runs the search in the scope of the command, in the instance, a
+// group, or a project.
+func (o *options) search(client *gitlab.Client, opts *gitlab.SearchOptions) (any, error) {
+	s := client.Search
+	group, project := o.group, ""
+	if o.repo != nil {
+		project = o.repo.FullName()
+	}
+
+	switch o.scope {
+	case scopeBlobs:
+		return byScope(opts, o.query, group, project, s.Blobs, s.BlobsByGroup, s.BlobsByProject)
+	case scopeWikiBlobs:
+		// The wiki search returns blobs, but the client decodes them as wiki
+		// pages, which drops their paths and lines.
+		return o.searchWikiBlobs(client, opts, group, project)
+	case scopeCommits:
+		return byScope(opts, o.query, group, project, s.Commits, s.CommitsByGroup, s.CommitsByProject)
+	case scopeIssues:
+		return byScope(opts, o.query, group, project, s.Issues, s.IssuesByGroup, s.IssuesByProject)
+	case scopeMergeRequests:
+		return byScope(opts, o.query, group, project, s.MergeRequests, s.MergeRequestsByGroup, s.MergeRequestsByProject)
+	case scopeMilestones:
+		return byScope(opts, o.query, group, project, s.Milestones, s.MilestonesByGroup, s.MilestonesByProject)
+	case scopeUsers:
+		return byScope(opts, o.query, group, project, s.Users, s.UsersByGroup, s.UsersByProject)
+	case scopeNotes:
+		notes, _, err := s.NotesByProject(project, o.query, opts)
+		return notes, err
+	}
+	return nil, fmt.Errorf("unknown scope %q", o.scope)
+}
+
+type (
+	searchFunc[T any]     func(query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]T, *gitlab.Response, error)
+	searchByIDFunc[T any] func(id any, query string, opt *gitlab.SearchOptions, options ...gitlab.RequestOptionFunc) ([]T, *gitlab.Response, error)
+)
+
+func byScope[T any](opts *gitlab.SearchOptions, query, group, project string, global searchFunc[T], byGroup, byProject searchByIDFunc[T]) ([]T, error) {
+	var (
+		results []T
+		err     error
+	)
+	switch {
+	case project != "":
+		results, _, err = byProject(project, query, opts)
+	case group != "":
+		results, _, err = byGroup(group, query, opts)
+	default:
+		results, _, err = global(query, opts)
+	}
+	return results, err
+}
+
+func (o *options) searchWikiBlobs(client *gitlab.Client, opts *gitlab.SearchOptions, group, project string) ([]*gitlab.Blob, error) {
+	path := "search"
+	switch {
+	case project != "":
+		path = fmt.Sprintf("projects/%s/-/search", gitlab.PathEscape(project))
+	case group != "":
+		path = fmt.Sprintf("groups/%s/-/search", gitlab.PathEscape(group))
+	}
+
+	reqOpts := struct {
+		gitlab.SearchOptions
+		Scope  string `url:"scope"`
+		Search string `url:"search"`
+	}{*opts, scopeWikiBlobs, o.query}
+
+	req, err := client.NewRequest(http.MethodGet, path, reqOpts, nil)
+	if err != nil {
+		return nil, err
+	}
+	var blobs []*gitlab.Blob
+	if _, err := client.Do(req, &blobs); err != nil {
+		return nil, err
+	}
+	return blobs, nil
+}
+
+func resultCount(results any) int {
+	switch r := results.(type) {
+	case []*gitlab.Blob:
+		return len(r)
+	case []*gitlab.Commit:
+		return len(r)
+	case []*gitlab.Issue:
+		return len(r)
+	case []*gitlab.MergeRequest:
+		return len(r)
+	case []*gitlab.Note:
+		return len(r)
+	case []*gitlab.Milestone:
+		return len(r)
+	case []*gitlab.User:
+		return len(r)
+	}
+	return 0
+}
+
+func (o *options) title(count int) string {
+	where := "the instance"
+	switch {
+	case o.repo != nil:
+		where = o.repo.FullName()
+	case o.group != "":
+		where = o.group
+	}
+	thing := strings.ReplaceAll(o.scope, "_", " ")
+	if count == 0 {
+		return fmt.Sprintf("No %s found for %q in %s.", thing, o.query, where)
+	}
+	return fmt.Sprintf("Showing %s for %q in %s (page %d).", utils.Pluralize(count, "result"), o.query, where, o.page)
+}
+
+func (o *options) table(projects *projectPaths, results any) string {
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+
+	switch r := results.(type) {
+	case []*gitlab.Commit:
+		table.AddRow("Project", "Commit", "Title", "Author", "Created")
+		for _, commit := range r {
+			table.AddRow(projects.path(commit.ProjectID), c.Cyan(commit.ShortID), commit.Title, commit.AuthorName, c.Gray(timeAgo(commit.CreatedAt)))
+		}
+	case []*gitlab.Issue:
+		table.AddRow("Issue", "Title", "State", "Updated")
+		for _, issue := range r {
+			table.AddRow(c.Green(reference(issue.References, issue.IID, "#")), issue.Title, issue.State, c.Gray(timeAgo(issue.UpdatedAt)))
+		}
+	case []*gitlab.MergeRequest:
+		table.AddRow("Merge request", "Title", "State", "Updated")
+		for _, mr := range r {
+			table.AddRow(c.Green(reference(mr.References, mr.IID, "!")), mr.Title, mr.State, c.Gray(timeAgo(mr.UpdatedAt)))
+		}
+	case []*gitlab.Note:
+		table.AddRow("On", "Author", "Note", "Created")
+		for _, note := range r {
+			table.AddRow(noteable(note), "@"+note.Author.Username, firstLine(note.Body), c.Gray(timeAgo(note.CreatedAt)))
+		}
+	case []*gitlab.Milestone:
+		table.AddRow("Milestone", "State", "Due", "URL")
+		for _, milestone := range r {
+			due := ""
+			if milestone.DueDate != nil {
+				due = milestone.DueDate.String()
+			}
+			table.AddRow(milestone.Title, milestone.State, due, c.Gray(milestone.WebURL))
+		}
+	case []*gitlab.User:
+		table.AddRow("Username", "Name", "URL")
+		for _, user := range r {
+			table.AddRow("@"+user.Username, user.Name, c.Gray(user.WebURL))
+		}
+	}
+	return table.String()
+}
+
+// webURL returns the URL of the results in the web UI.
+func (o *options) webURL(client *gitlab.Client) string {
+	u := *client.BaseURL()
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api/v4") + "/"
+
+	switch {
+	case o.repo != nil:
+		u.Path += o.repo.FullName() + "/-/search"
+	case o.group != "":
+		u.Path += "groups/" + o.group + "/-/search"
+	default:
+		u.Path += "search"
+	}
+
+	query := url.Values{}
+	query.Set("search", o.query)
+	query.Set("scope", o.scope)
+	if o.ref != "" {
+		query.Set("repository_ref", o.ref)
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func reference(refs *gitlab.IssueReferences, iid int64, prefix string) string {
+	if refs != nil && refs.Full != "" {
+		return refs.Full
+	}
+	return fmt.Sprintf("%s%d", prefix, iid)
+}
+
+func noteable(note *gitlab.Note) string {
+	switch note.NoteableType {
+	case "Issue":
+		return fmt.Sprintf("#%d", note.NoteableIID)
+	case "MergeRequest":
+		return fmt.Sprintf("!%d", note.NoteableIID)
+	case "Commit":
+		return "commit " + note.CommitID
+	}
+	return note.NoteableType
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}
+
+func timeAgo(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return utils.TimeToPrettyTimeAgo(*t)
+}
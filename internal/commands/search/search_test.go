@@ -0,0 +1,168 @@
+//go:build !integration
+
+package search
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	t.Helper()
+	t.Setenv("NO_COLOR", "true")
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams(cmdtest.WithTestIOStreamsAsTTY(isTTY))
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdSearch(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const blobsJSON = `[
+	{"path": "config/database.yml", "ref": "main", "startline": 11, "project_id": 1,
+	 "data": "production:\n  pool: <%= ENV['DATABASE_POOL_SIZE'] %>\n  timeout: 5000\n"},
+	{"path": "deploy/values.yaml", "ref": "main", "startline": 4, "project_id": 2,
+	 "data": "env:\n  database_pool_size: 20\n"}
+]`
+
+func registerBlobSearch(fakeHTTP *httpmock.Mocker) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/-/search?page=1&per_page=20&scope=blobs&search=DATABASE_POOL_SIZE",
+		httpmock.NewStringResponse(http.StatusOK, blobsJSON))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "path_with_namespace": "my-org/api"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/2",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Project Not Found"}`))
+}
+
+func TestSearch_blobs(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	registerBlobSearch(fakeHTTP)
+
+	output, err := runCommand(t, fakeHTTP, true, `DATABASE_POOL_SIZE --group my-org`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Showing 2 results for \"DATABASE_POOL_SIZE\" in my-org (page 1).\n\n", output.Stderr())
+	assert.Equal(t, heredoc.Doc(`
+		my-org/api › config/database.yml @ main
+		  11  production:
+		  12    pool: <%= ENV['DATABASE_POOL_SIZE'] %>
+		  13    timeout: 5000
+
+		2 › deploy/values.yaml @ main
+		  4  env:
+		  5    database_pool_size: 20
+	`), output.String())
+}
+
+func TestSearch_blobsNotTTY(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	registerBlobSearch(fakeHTTP)
+
+	output, err := runCommand(t, fakeHTTP, false, `DATABASE_POOL_SIZE --group my-org`)
+	require.NoError(t, err)
+
+	assert.Empty(t, output.Stderr())
+	assert.Equal(t, heredoc.Doc(`
+		my-org/api:config/database.yml-11-production:
+		my-org/api:config/database.yml:12:  pool: <%= ENV['DATABASE_POOL_SIZE'] %>
+		my-org/api:config/database.yml-13-  timeout: 5000
+		2:deploy/values.yaml-4-env:
+		2:deploy/values.yaml:5:  database_pool_size: 20
+	`), output.String())
+}
+
+func TestSearch_issues(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/search?page=1&per_page=20&scope=issues&search=login+timeout",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 70, "iid": 7, "title": "Login times out behind a proxy", "state": "opened",
+			 "references": {"full": "my-org/api#7"}}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, false, `"login timeout" --scope issues`)
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "my-org/api#7\tLogin times out behind a proxy\topened")
+}
+
+func TestSearch_commitsInProject(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/-/search?page=1&per_page=20&ref=main&scope=commits&search=flaky",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"short_id": "a1b2c3d4", "title": "Fix flaky test", "author_name": "Alice", "project_id": 3}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, false, `flaky --scope commits --repo OWNER/REPO --ref main`)
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "OWNER/REPO\ta1b2c3d4\tFix flaky test\tAlice")
+}
+
+func TestSearch_noResults(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/search?page=1&per_page=20&scope=users&search=nobody",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, false, `nobody --scope users`)
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Equal(t, "No users found for \"nobody\" in the instance.\n", output.Stderr())
+}
+
+func TestSearch_flags(t *testing.T) {
+	tests := []struct {
+		cli  string
+		want string
+	}{
+		{`q --scope code`, `invalid scope "code". Use one of: blobs, commits, issues, merge_requests, notes, wiki_blobs, milestones, users.`},
+		{`q --scope notes`, "notes can only be searched in a project. Use '--repo' to select it."},
+		{`q --ref main`, "the '--ref' flag requires '--repo'."},
+		{`q --ref main --repo OWNER/REPO --scope issues`, "the '--ref' flag can only be used with the scopes: blobs, commits, wiki_blobs."},
+		{`q --output yaml`, `invalid output format "yaml". Use one of: text, json.`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.cli, func(t *testing.T) {
+			_, err := runCommand(t, &httpmock.Mocker{}, false, tc.cli)
+			assert.EqualError(t, err, tc.want)
+		})
+	}
+}
+
+func Test_webURL(t *testing.T) {
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL("https://gitlab.example.com/api/v4"))
+	require.NoError(t, err)
+
+	opts := &options{query: "api key", scope: scopeBlobs}
+	assert.Equal(t, "https://gitlab.example.com/search?scope=blobs&search=api+key", opts.webURL(client))
+
+	opts.group = "my-org/platform"
+	assert.Equal(t, "https://gitlab.example.com/groups/my-org/platform/-/search?scope=blobs&search=api+key", opts.webURL(client))
+
+	opts.group, opts.ref = "", "main"
+	opts.repo = glrepo.New("OWNER", "REPO", "gitlab.example.com")
+	assert.Equal(t, "https://gitlab.example.com/OWNER/REPO/-/search?repository_ref=main&scope=blobs&search=api+key", opts.webURL(client))
+}
+
+func Test_highlight(t *testing.T) {
+	brackets := func(s string) string { return "[" + s + "]" }
+	assert.Equal(t, "pool: [DATABASE_POOL_SIZE], [database_pool_size]", highlight("pool: DATABASE_POOL_SIZE, database_pool_size", "Database_Pool_Size", brackets))
+	assert.Equal(t, "no match", highlight("no match", "pool", brackets))
+}
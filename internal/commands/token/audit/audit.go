@@ -0,0 +1,465 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/rotate"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/tokenduration"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+const (
+	tokenTypePersonal = "personal"
+	tokenTypeGroup    = "group"
+	tokenTypeProject  = "project"
+)
+
+// broadScopes maps scopes that grant more access than most automation needs
+// to a narrower alternative. An empty alternative means there is none.
+var broadScopes = map[string]string{
+	"api":        "read_api",
+	"sudo":       "",
+	"admin_mode": "",
+}
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	user         string
+	group        string
+	days         int
+	outputFormat string
+	rotate       bool
+	duration     tokenduration.TokenDuration
+	sink         string
+
+	now func() time.Time
+}
+
+// Finding is an active access token with at least one hygiene issue.
+type Finding struct {
+	Type       string          `json:"type"`
+	Owner      string          `json:"owner"`
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	Scopes     []string        `json:"scopes"`
+	ExpiresAt  *gitlab.ISOTime `json:"expires_at"`
+	LastUsedAt *time.Time      `json:"last_used_at"`
+	Issues     []string        `json:"issues"`
+	Rotated    bool            `json:"rotated"`
+
+	expiring bool
+}
+
+func NewCmdAudit(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+		duration:  tokenduration.TokenDuration(30 * 24 * time.Hour), // Default: 30 days
+		now:       time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Find expiring, unused, and over-scoped access tokens.",
+		Args:  cobra.ExactArgs(0),
+		Long: heredoc.Docf(`
+			Audit the active access tokens of the current user and, with %[1]s--group%[1]s, of a
+			group, its subgroups, and all of their projects.
+
+			A token is reported when it:
+
+			- Expires within the number of days set with %[1]s--days%[1]s.
+			- Has never been used.
+			- Has a broad scope, like %[1]sapi%[1]s, where a narrower one, like %[1]sread_api%[1]s, might do.
+
+			Projects and groups where you are not allowed to list access tokens are skipped
+			with a warning.
+
+			With %[1]s--rotate%[1]s, the expiring tokens are rotated, and the new token values are
+			written to the sink set with %[1]s--sink%[1]s: either %[1]s-%[1]s for stdout, or the path of
+			a file. The values are written in dotenv format, one %[1]sKEY=value%[1]s line per token,
+			where the key is derived from the owner and the name of the token. Files are
+			created with permissions 0600, and existing files are appended to. The sink is
+			opened before any token is rotated, and each new value is written as soon as
+			its token is rotated. The personal access token glab is logged in with is not
+			rotated, because rotating it revokes it while glab still needs it. Rotate it with
+			%[1]sglab token rotate%[1]s, and run %[1]sglab auth login%[1]s again with the new value.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Audit my personal access tokens
+			$ glab token audit
+
+			# Audit my tokens, and the tokens of a group, its subgroups, and projects
+			$ glab token audit --group my-group --days 14
+
+			# Rotate the tokens expiring within 7 days, and store the new values in a file
+			$ glab token audit --group my-group --days 7 --rotate --sink tokens.env
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(cmd); err != nil {
+				return err
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Also audit the access tokens of this group, its subgroups, and their projects.")
+	cmd.Flags().StringVarP(&opts.user, "user", "U", "@me", "Audit the personal access tokens of this user. Administrators can audit other users.")
+	cmd.Flags().IntVarP(&opts.days, "days", "d", 30, "Report tokens expiring within this number of days.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	cmd.Flags().BoolVar(&opts.rotate, "rotate", false, "Rotate the expiring tokens. Requires --sink.")
+	cmd.Flags().VarP(&opts.duration, "duration", "D", "Lifetime of the rotated tokens. Accepts: days (30d), weeks (4w), or hours in multiples of 24 (24h, 168h, 720h). Maximum: 365d.")
+	cmd.Flags().StringVar(&opts.sink, "sink", "", "Where to write the values of rotated tokens: '-' for stdout, or a file path.")
+	cmd.MarkFlagsRequiredTogether("rotate", "sink")
+
+	return cmd
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	group, err := cmdutils.GroupOverride(cmd)
+	if err != nil {
+		return err
+	}
+	o.group = group
+
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.days < 0 {
+		return cmdutils.FlagError{Err: errors.New("--days must not be negative.")}
+	}
+	if o.outputFormat != "text" && o.outputFormat != "json" {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", o.outputFormat)}
+	}
+	if o.rotate && o.sink == "-" && o.outputFormat == "json" {
+		return cmdutils.FlagError{Err: errors.New("--sink - cannot be combined with --output json. Write the rotated tokens to a file instead.")}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	// Open the sink before anything is rotated: the previous values of rotated
+	// tokens are revoked, so the new values must not get lost.
+	var sink io.Writer = o.io.StdOut
+	if o.rotate && o.sink != "-" {
+		f, err := os.OpenFile(o.sink, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open token sink: %w", err)
+		}
+		defer f.Close()
+		sink = f
+	}
+
+	// NOTE: this command can not only be used for projects,
+	// so we have to manually check for the base repo, it it doesn't exist,
+	// we bootstrap the client with the default hostname.
+	var repoHost string
+	if baseRepo, err := o.baseRepo(); err == nil {
+		repoHost = baseRepo.RepoHost()
+	}
+	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return err
+	}
+	client := apiClient.Lab()
+
+	findings, err := o.audit(client)
+	if err != nil {
+		return err
+	}
+
+	var rotateErr error
+	if o.rotate {
+		rotateErr = o.rotateExpiring(client, findings, sink)
+	}
+
+	if o.outputFormat == "json" {
+		if findings == nil {
+			findings = []*Finding{}
+		}
+		if err := json.NewEncoder(o.io.StdOut).Encode(findings); err != nil {
+			return err
+		}
+	} else if len(findings) == 0 {
+		fmt.Fprintln(o.io.StdOut, "No token issues found.")
+	} else {
+		fmt.Fprint(o.io.StdOut, o.renderTable(findings))
+	}
+
+	return rotateErr
+}
+
+func (o *options) audit(client *gitlab.Client) ([]*Finding, error) {
+	var findings []*Finding
+
+	user, err := api.UserByName(client, o.user)
+	if err != nil {
+		return nil, cmdutils.FlagError{Err: err}
+	}
+	personalTokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+		return client.PersonalAccessTokens.ListPersonalAccessTokens(&gitlab.ListPersonalAccessTokensOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			UserID:      &user.ID,
+		}, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range personalTokens {
+		findings = o.appendFinding(findings, tokenTypePersonal, user.Username, t)
+	}
+
+	if o.group == "" {
+		return findings, nil
+	}
+
+	descendants, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Group, *gitlab.Response, error) {
+		return client.Groups.ListDescendantGroups(o.group, &gitlab.ListDescendantGroupsOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the subgroups of %s.", o.group))
+	}
+	groups := []string{o.group}
+	for _, g := range descendants {
+		groups = append(groups, g.FullPath)
+	}
+
+	for _, group := range groups {
+		tokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupAccessToken, *gitlab.Response, error) {
+			return client.GroupAccessTokens.ListGroupAccessTokens(group, &gitlab.ListGroupAccessTokensOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			}, p)
+		})
+		if err != nil {
+			o.warnSkipped("group", group, err)
+			continue
+		}
+		for _, t := range tokens {
+			findings = o.appendFinding(findings, tokenTypeGroup, group, &t.PersonalAccessToken)
+		}
+	}
+
+	projects, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+		return client.Groups.ListGroupProjects(o.group, &gitlab.ListGroupProjectsOptions{
+			ListOptions:      gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IncludeSubGroups: gitlab.Ptr(true),
+			Archived:         gitlab.Ptr(false),
+		}, p)
+	})
+	if err != nil {
+		return nil, cmdutils.WrapError(err, fmt.Sprintf("failed to list the projects of %s.", o.group))
+	}
+
+	for _, project := range projects {
+		tokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+			return client.ProjectAccessTokens.ListProjectAccessTokens(project.PathWithNamespace, &gitlab.ListProjectAccessTokensOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			}, p)
+		})
+		if err != nil {
+			o.warnSkipped("project", project.PathWithNamespace, err)
+			continue
+		}
+		for _, t := range tokens {
+			findings = o.appendFinding(findings, tokenTypeProject, project.PathWithNamespace, &t.PersonalAccessToken)
+		}
+	}
+
+	return findings, nil
+}
+
+func (o *options) warnSkipped(kind, path string, err error) {
+	color := o.io.Color()
+	fmt.Fprintf(o.io.StdErr, "%s skipping %s %s: %v\n", color.WarnIcon(), kind, path, err)
+}
+
+// appendFinding checks an access token and appends a finding for it to findings,
+// if the token is active and has at least one issue.
+func (o *options) appendFinding(findings []*Finding, tokenType, owner string, t *gitlab.PersonalAccessToken) []*Finding {
+	if !t.Active || t.Revoked {
+		return findings
+	}
+
+	finding := &Finding{
+		Type:       tokenType,
+		Owner:      owner,
+		ID:         t.ID,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		Issues:     []string{},
+	}
+
+	if t.ExpiresAt != nil {
+		today := truncateToDay(o.now())
+		daysLeft := int(time.Time(*t.ExpiresAt).Sub(today).Hours() / 24)
+		if daysLeft <= o.days {
+			finding.expiring = true
+			finding.Issues = append(finding.Issues, expiryIssue(daysLeft))
+		}
+	}
+
+	if t.LastUsedAt == nil {
+		finding.Issues = append(finding.Issues, "never used")
+	}
+
+	for _, scope := range t.Scopes {
+		narrower, ok := broadScopes[scope]
+		if !ok {
+			continue
+		}
+		if narrower == "" {
+			finding.Issues = append(finding.Issues, fmt.Sprintf("broad scope %s", scope))
+		} else {
+			finding.Issues = append(finding.Issues, fmt.Sprintf("broad scope %s (consider %s)", scope, narrower))
+		}
+	}
+
+	if len(finding.Issues) == 0 {
+		return findings
+	}
+	return append(findings, finding)
+}
+
+func expiryIssue(daysLeft int) string {
+	switch {
+	case daysLeft < 0:
+		return "expired"
+	case daysLeft == 0:
+		return "expires today"
+	case daysLeft == 1:
+		return "expires in 1 day"
+	default:
+		return fmt.Sprintf("expires in %d days", daysLeft)
+	}
+}
+
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// rotateExpiring rotates the expiring tokens of findings, and writes the new
+// value of each token to sink as soon as it is rotated. The personal access
+// token of the current session is skipped: rotating it revokes it, and the
+// next requests would fail.
+func (o *options) rotateExpiring(client *gitlab.Client, findings []*Finding, sink io.Writer) error {
+	expiresAt := gitlab.ISOTime(o.duration.CalculateExpirationDate())
+	color := o.io.Color()
+
+	// Other kinds of tokens, like OAuth tokens, can't read themselves.
+	var currentID int64
+	if current, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken(); err == nil {
+		currentID = current.ID
+	}
+
+	for _, finding := range findings {
+		if !finding.expiring {
+			continue
+		}
+		if finding.Type == tokenTypePersonal && finding.ID == currentID {
+			fmt.Fprintf(o.io.StdErr, "%s Skipped personal access token %s of %s: glab is using it. Rotate it with 'glab token rotate', and run 'glab auth login' again with the new value.\n",
+				color.WarnIcon(), finding.Name, finding.Owner)
+			continue
+		}
+
+		var value string
+		var newExpiresAt *gitlab.ISOTime
+		switch finding.Type {
+		case tokenTypePersonal:
+			token, err := rotate.PersonalAccessToken(client, finding.ID, expiresAt)
+			if err != nil {
+				return fmt.Errorf("failed to rotate personal access token %s of %s: %w", finding.Name, finding.Owner, err)
+			}
+			value, newExpiresAt = token.Token, token.ExpiresAt
+		case tokenTypeGroup:
+			token, err := rotate.GroupAccessToken(client, finding.Owner, finding.ID, expiresAt)
+			if err != nil {
+				return fmt.Errorf("failed to rotate group access token %s of %s: %w", finding.Name, finding.Owner, err)
+			}
+			value, newExpiresAt = token.Token, token.ExpiresAt
+		case tokenTypeProject:
+			token, err := rotate.ProjectAccessToken(client, finding.Owner, finding.ID, expiresAt)
+			if err != nil {
+				return fmt.Errorf("failed to rotate project access token %s of %s: %w", finding.Name, finding.Owner, err)
+			}
+			value, newExpiresAt = token.Token, token.ExpiresAt
+		}
+
+		finding.Rotated = true
+		finding.ExpiresAt = newExpiresAt
+		if _, err := fmt.Fprintf(sink, "%s=%s\n", sinkKey(finding.Owner, finding.Name), value); err != nil {
+			return fmt.Errorf("rotated %s access token %s of %s, but failed to write its new value to the token sink: %w", finding.Type, finding.Name, finding.Owner, err)
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Rotated %s access token %s of %s.\n", color.GreenCheck(), finding.Type, finding.Name, finding.Owner)
+	}
+
+	return nil
+}
+
+var nonKeyChars = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// sinkKey returns the dotenv key for the token name of an owner, for example
+// GROUP_PROJECT_DEPLOY_TOKEN for the token deploy-token of group/project.
+func sinkKey(owner, name string) string {
+	key := nonKeyChars.ReplaceAllString(strings.ToUpper(owner+"_"+name), "_")
+	return strings.Trim(key, "_")
+}
+
+func (o *options) renderTable(findings []*Finding) string {
+	color := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("Type", "Owner", "Name", "ID", "Expires", "Last used", "Issues")
+	for _, f := range findings {
+		expires := "-"
+		if f.ExpiresAt != nil {
+			expires = f.ExpiresAt.String()
+		}
+		lastUsed := "-"
+		if f.LastUsedAt != nil {
+			lastUsed = f.LastUsedAt.Format(time.DateOnly)
+		}
+		issues := strings.Join(f.Issues, ", ")
+		if f.Rotated {
+			issues += ", rotated"
+		}
+		table.AddRow(f.Type, f.Owner, f.Name, f.ID, expires, lastUsed, color.Yellow(issues))
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,213 @@
+//go:build !integration
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams(cmdtest.WithTestIOStreamsAsTTY(false))
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", glinstance.DefaultHostname)),
+	)
+	cmd := NewCmdAudit(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func inDays(days int) string {
+	return time.Now().UTC().AddDate(0, 0, days).Format(time.DateOnly)
+}
+
+func tokenJSON(id int, name, expiresAt, lastUsedAt string, active bool, scopes string) string {
+	lastUsed := "null"
+	if lastUsedAt != "" {
+		lastUsed = fmt.Sprintf("%q", lastUsedAt)
+	}
+	return fmt.Sprintf(`{"id": %d, "name": %q, "scopes": [%s], "created_at": "2024-07-07T07:59:35.767Z", "expires_at": %q, "last_used_at": %s, "active": %t, "revoked": false}`,
+		id, name, scopes, expiresAt, lastUsed, active)
+}
+
+func registerUser(fakeHTTP *httpmock.Mocker, tokens ...string) {
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/user",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "username": "johndoe"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/personal_access_tokens",
+		httpmock.NewJSONResponse(http.StatusOK, json.RawMessage("["+strings.Join(tokens, ",")+"]")))
+}
+
+func TestAuditPersonalTokens(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP,
+		tokenJSON(1, "expiring", inDays(5), "2024-07-08T07:59:35Z", true, `"read_api"`),
+		tokenJSON(2, "unused", inDays(90), "", true, `"read_repository"`),
+		tokenJSON(3, "broad", inDays(90), "2024-07-08T07:59:35Z", true, `"api", "sudo"`),
+		tokenJSON(4, "healthy", inDays(90), "2024-07-08T07:59:35Z", true, `"read_api"`),
+		tokenJSON(5, "inactive", inDays(1), "", false, `"api"`),
+	)
+
+	output, err := runCommand(t, fakeHTTP, "--days 7")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "personal\tjohndoe\texpiring\t1\t"+inDays(5)+"\t2024-07-08\texpires in 5 days\n")
+	assert.Contains(t, out, "personal\tjohndoe\tunused\t2\t"+inDays(90)+"\t-\tnever used\n")
+	assert.Contains(t, out, "personal\tjohndoe\tbroad\t3\t"+inDays(90)+"\t2024-07-08\tbroad scope api (consider read_api), broad scope sudo\n")
+	assert.NotContains(t, out, "healthy")
+	assert.NotContains(t, out, "inactive")
+	assert.Empty(t, output.Stderr())
+}
+
+func TestAuditNoIssues(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP, tokenJSON(4, "healthy", inDays(90), "2024-07-08T07:59:35Z", true, `"read_api"`))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+	assert.Equal(t, "No token issues found.\n", output.String())
+}
+
+func TestAuditGroupTree(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/grp/descendant_groups",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 2, "full_path": "grp/sub"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/grp/access_tokens",
+		httpmock.NewJSONResponse(http.StatusOK, json.RawMessage(fmt.Sprintf("[%s]",
+			tokenJSON(10, "group-bot", inDays(3), "2024-07-08T07:59:35Z", true, `"read_api"`)))))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/grp/sub/access_tokens",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/grp/projects",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 3, "path_with_namespace": "grp/sub/proj"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/grp/sub/proj/access_tokens",
+		httpmock.NewJSONResponse(http.StatusOK, json.RawMessage(fmt.Sprintf("[%s]",
+			tokenJSON(20, "deploy", inDays(60), "", true, `"api"`)))))
+
+	output, err := runCommand(t, fakeHTTP, "--group grp --days 7")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "group\tgrp\tgroup-bot\t10\t"+inDays(3)+"\t2024-07-08\texpires in 3 days\n")
+	assert.Contains(t, out, "project\tgrp/sub/proj\tdeploy\t20\t"+inDays(60)+"\t-\tnever used, broad scope api (consider read_api)\n")
+	assert.Contains(t, output.Stderr(), "skipping group grp/sub")
+}
+
+func TestAuditJSON(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP, tokenJSON(2, "unused", inDays(90), "", true, `"read_repository"`))
+
+	output, err := runCommand(t, fakeHTTP, "--output json")
+	require.NoError(t, err)
+
+	var findings []Finding
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &findings))
+	require.Len(t, findings, 1)
+	assert.Equal(t, "personal", findings[0].Type)
+	assert.Equal(t, "johndoe", findings[0].Owner)
+	assert.Equal(t, []string{"never used"}, findings[0].Issues)
+	assert.False(t, findings[0].Rotated)
+}
+
+func TestAuditRotateToFile(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP,
+		tokenJSON(1, "ci-token", inDays(2), "2024-07-08T07:59:35Z", true, `"read_api"`),
+		tokenJSON(2, "unused", inDays(90), "", true, `"read_repository"`),
+	)
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/personal_access_tokens/1/rotate",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"id": 6, "name": "ci-token", "active": true, "expires_at": %q, "token": "glpat-new"}`, inDays(30))))
+
+	sink := filepath.Join(t.TempDir(), "tokens.env")
+	output, err := runCommand(t, fakeHTTP, "--days 7 --rotate --sink "+sink)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(sink)
+	require.NoError(t, err)
+	assert.Equal(t, "JOHNDOE_CI_TOKEN=glpat-new\n", string(content))
+
+	info, err := os.Stat(sink)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	assert.Contains(t, output.String(), "personal\tjohndoe\tci-token\t1\t"+inDays(30)+"\t2024-07-08\texpires in 2 days, rotated\n")
+	assert.NotContains(t, output.String(), "glpat-new")
+	assert.Contains(t, output.Stderr(), "Rotated personal access token ci-token of johndoe.")
+}
+
+func TestAuditRotateSkipsCurrentToken(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	registerUser(fakeHTTP,
+		tokenJSON(1, "glab", inDays(2), "2024-07-08T07:59:35Z", true, `"read_api"`),
+		tokenJSON(2, "ci-token", inDays(3), "2024-07-08T07:59:35Z", true, `"read_api"`),
+	)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/personal_access_tokens/self",
+		httpmock.NewStringResponse(http.StatusOK, tokenJSON(1, "glab", inDays(2), "2024-07-08T07:59:35Z", true, `"read_api"`)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/personal_access_tokens/2/rotate",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"id": 7, "name": "ci-token", "active": true, "expires_at": %q, "token": "glpat-new"}`, inDays(30))))
+
+	sink := filepath.Join(t.TempDir(), "tokens.env")
+	output, err := runCommand(t, fakeHTTP, "--days 7 --rotate --sink "+sink)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(sink)
+	require.NoError(t, err)
+	assert.Equal(t, "JOHNDOE_CI_TOKEN=glpat-new\n", string(content))
+
+	assert.Contains(t, output.String(), "personal\tjohndoe\tglab\t1\t"+inDays(2)+"\t2024-07-08\texpires in 2 days\n")
+	assert.Contains(t, output.Stderr(), "Skipped personal access token glab of johndoe: glab is using it.")
+	assert.Contains(t, output.Stderr(), "Rotated personal access token ci-token of johndoe.")
+}
+
+func TestAuditRotateUnwritableSink(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	// No request is sent: nothing is rotated when the new values can't be stored.
+	sink := filepath.Join(t.TempDir(), "missing", "tokens.env")
+	_, err := runCommand(t, fakeHTTP, "--days 7 --rotate --sink "+sink)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to open token sink")
+	assert.NoFileExists(t, sink)
+}
+
+func TestAuditRotateRequiresSink(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "--rotate")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sink")
+}
+
+func TestSinkKey(t *testing.T) {
+	assert.Equal(t, "GROUP_PROJECT_DEPLOY_TOKEN", sinkKey("group/project", "deploy-token"))
+	assert.Equal(t, "JOHNDOE_MY_TOKEN", sinkKey("johndoe", "my token!"))
+}
@@ -0,0 +1,171 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/token/tokenduration"
+)
+
+// bindingsConfig is the file format of `glab token rotate --config`.
+type bindingsConfig struct {
+	// ExpiringWithinDays skips tokens that expire later than this number of days
+	// from now. Zero rotates all tokens.
+	ExpiringWithinDays int            `yaml:"expiring_within_days"`
+	Duration           string         `yaml:"duration"`
+	Tokens             []tokenBinding `yaml:"tokens"`
+}
+
+// tokenBinding is a token and the CI/CD variables that hold its value.
+type tokenBinding struct {
+	User      string   `yaml:"user"`
+	Group     string   `yaml:"group"`
+	Project   string   `yaml:"project"`
+	Name      string   `yaml:"name"`
+	ID        int64    `yaml:"id"`
+	Duration  string   `yaml:"duration"`
+	Variables []string `yaml:"variables"`
+}
+
+func (b *tokenBinding) owner() tokenOwner {
+	return tokenOwner{user: b.User, group: b.Group, project: b.Project}
+}
+
+// nameOrID returns the token name, or the token ID if no name is set.
+func (b *tokenBinding) nameOrID() any {
+	if b.Name != "" {
+		return b.Name
+	}
+	return b.ID
+}
+
+func readBindingsConfig(path string) (*bindingsConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config bindingsConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if config.ExpiringWithinDays < 0 {
+		return nil, errors.New("expiring_within_days must not be negative.")
+	}
+	if config.Duration != "" {
+		if _, err := tokenduration.ParseDuration(config.Duration); err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+	}
+	if len(config.Tokens) == 0 {
+		return nil, fmt.Errorf("no tokens listed in %s.", path)
+	}
+
+	for i, b := range config.Tokens {
+		owners := 0
+		for _, o := range []string{b.User, b.Group, b.Project} {
+			if o != "" {
+				owners++
+			}
+		}
+		if owners != 1 {
+			return nil, fmt.Errorf("token %d: set exactly one of user, group, or project.", i+1)
+		}
+		if (b.Name == "") == (b.ID == 0) {
+			return nil, fmt.Errorf("token %d: set either name or id.", i+1)
+		}
+		if b.Duration != "" {
+			if _, err := tokenduration.ParseDuration(b.Duration); err != nil {
+				return nil, fmt.Errorf("token %d: invalid duration: %w", i+1, err)
+			}
+		}
+		for _, v := range b.Variables {
+			if _, err := parseVariableTarget(v); err != nil {
+				return nil, fmt.Errorf("token %d: %w", i+1, err)
+			}
+		}
+	}
+
+	return &config, nil
+}
+
+// runConfig rotates all tokens of the configuration file, and updates their
+// variables. A failure for one token doesn't stop the rotation of the others.
+func (o *options) runConfig() error {
+	config, err := readBindingsConfig(o.configFile)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.client()
+	if err != nil {
+		return err
+	}
+
+	duration := o.duration
+	if config.Duration != "" {
+		duration, _ = tokenduration.ParseDuration(config.Duration)
+	}
+
+	color := o.io.Color()
+	failed := 0
+	for _, b := range config.Tokens {
+		tokenDuration := duration
+		if b.Duration != "" {
+			tokenDuration, _ = tokenduration.ParseDuration(b.Duration)
+		}
+
+		if err := o.rotateBinding(client, &b, tokenDuration, config.ExpiringWithinDays); err != nil {
+			failed++
+			fmt.Fprintf(o.io.StdErr, "%s Failed to rotate token %v of %s: %v\n", color.FailedIcon(), b.nameOrID(), b.owner(), err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to rotate %d of %d tokens.", failed, len(config.Tokens))
+	}
+	return nil
+}
+
+func (o *options) rotateBinding(client *gitlab.Client, b *tokenBinding, duration tokenduration.TokenDuration, expiringWithinDays int) error {
+	color := o.io.Color()
+	owner := b.owner()
+
+	var bindings []*variableBinding
+	for _, v := range b.Variables {
+		target, _ := parseVariableTarget(v)
+		binding, err := resolveVariable(client, target)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	token, err := findToken(client, owner, b.nameOrID())
+	if err != nil {
+		return err
+	}
+
+	if expiringWithinDays > 0 && token.ExpiresAt != nil {
+		threshold := time.Now().UTC().AddDate(0, 0, expiringWithinDays)
+		if time.Time(*token.ExpiresAt).After(threshold) {
+			fmt.Fprintf(o.io.StdErr, "%s Skipped token %s of %s: it expires on %s.\n", color.DotWarnIcon(), token.Name, owner, token.ExpiresAt)
+			return nil
+		}
+	}
+
+	expiresAt := gitlab.ISOTime(duration.CalculateExpirationDate())
+	_, value, err := rotateToken(client, owner, token.ID, expiresAt)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(o.io.StdErr, "%s Rotated token %s of %s. It expires on %s.\n", color.GreenCheck(), token.Name, owner, expiresAt)
+
+	return o.updateBindings(client, bindings, value)
+}
@@ -27,12 +27,14 @@ type options struct {
 	io        *iostreams.IOStreams
 	baseRepo  func() (glrepo.Interface, error)
 
-	user         string
-	group        string
-	name         any
-	duration     tokenduration.TokenDuration
-	expireAt     expirationdate.ExpirationDate
-	outputFormat string
+	user            string
+	group           string
+	name            any
+	duration        tokenduration.TokenDuration
+	expireAt        expirationdate.ExpirationDate
+	outputFormat    string
+	updateVariables []string
+	configFile      string
 }
 
 func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
@@ -47,8 +49,8 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 		Use:     "rotate <token-name|token-id>",
 		Short:   "Rotate user, group, or project access tokens",
 		Aliases: []string{"rotate", "rot"},
-		Args:    cobra.RangeArgs(1, 1),
-		Long: heredoc.Doc(`
+		Args:    cobra.RangeArgs(0, 1),
+		Long: heredoc.Docf(`
 			Rotate user, group, or project access token, then print the new token on stdout. If multiple tokens with
 			the same name exist, you can specify the ID of the token.
 
@@ -60,7 +62,37 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 			rotated token.
 
 			Administrators can rotate personal access tokens belonging to other users.
-		`),
+
+			To write the new token into CI/CD variables, use %[1]s--update-variable <path>:<KEY>%[1]s, where
+			%[1]s<path>%[1]s is the full path of a project or a group. The variables must exist. Their value is
+			replaced in all environment scopes, and all other settings, like masked and protected, are kept.
+
+			To rotate many tokens at once, for example from a scheduled pipeline, list the tokens and their
+			variables in a YAML file and pass it with %[1]s--config%[1]s. In this mode, the token values are not
+			printed:
+
+			%[1]s%[1]s%[1]syaml
+			# Only rotate tokens that expire within this number of days.
+			# Omit it, or set it to 0, to rotate the tokens on every run.
+			expiring_within_days: 7
+			# Lifetime of the rotated tokens. Defaults to --duration.
+			duration: 30d
+			tokens:
+			  - project: my-group/my-project
+			    name: deploy-token
+			    variables:
+			      - my-group/my-project:DEPLOY_TOKEN
+			  - group: my-group
+			    id: 1234
+			    duration: 90d
+			    variables:
+			      - my-group:GROUP_TOKEN
+			  - user: "@me"
+			    name: release-bot
+			    variables:
+			      - my-group/tools:RELEASE_TOKEN
+			%[1]s%[1]s%[1]s
+		`, "`"),
 		Example: heredoc.Doc(`
 		# Rotate project access token of current project (default 30 days)
 		$ glab token rotate my-project-token
@@ -76,6 +108,12 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 
 		# Rotate a personal access token of another user (administrator only)
 		$ glab token rotate --user johndoe johns-personal-token --duration 90d
+
+		# Rotate a project access token, and write it into CI/CD variables of a project and a group
+		$ glab token rotate my-project-token --update-variable user/repo:API_TOKEN --update-variable group:API_TOKEN
+
+		# Rotate all tokens listed in a configuration file
+		$ glab token rotate --config token-bindings.yml
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -89,6 +127,9 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if opts.configFile != "" {
+				return opts.runConfig()
+			}
 			return opts.run()
 		},
 	}
@@ -99,15 +140,22 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 	cmd.Flags().VarP(&opts.duration, "duration", "D", "Sets the token lifetime in days. Accepts: days (30d), weeks (4w), or hours in multiples of 24 (24h, 168h, 720h). Maximum: 365d. The token expires at midnight UTC on the calculated date.")
 	cmd.Flags().VarP(&opts.expireAt, "expires-at", "E", "Sets the token's expiration date and time, in YYYY-MM-DD format. If not specified, --duration is used.")
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json. 'text' provides the new token value; 'json' outputs the token with metadata.")
+	cmd.Flags().StringArrayVar(&opts.updateVariables, "update-variable", nil, "Write the new token into an existing CI/CD variable, as <project-or-group-path>:<KEY>. Can be repeated.")
+	cmd.Flags().StringVar(&opts.configFile, "config", "", "Rotate the tokens listed in this YAML file, and update their CI/CD variables.")
 	cmd.MarkFlagsMutuallyExclusive("duration", "expires-at")
+	cmd.MarkFlagsMutuallyExclusive("config", "update-variable")
+	cmd.MarkFlagsMutuallyExclusive("config", "expires-at")
+	cmd.MarkFlagsMutuallyExclusive("config", "output")
 	return cmd
 }
 
 func (o *options) complete(cmd *cobra.Command, args []string) error {
-	if name, err := strconv.Atoi(args[0]); err != nil {
-		o.name = args[0]
-	} else {
-		o.name = name
+	if len(args) == 1 {
+		if id, err := strconv.ParseInt(args[0], 10, 64); err != nil {
+			o.name = args[0]
+		} else {
+			o.name = id
+		}
 	}
 
 	if group, err := cmdutils.GroupOverride(cmd); err != nil {
@@ -124,14 +172,31 @@ func (o *options) complete(cmd *cobra.Command, args []string) error {
 }
 
 func (o *options) validate() error {
+	if o.configFile != "" {
+		if o.name != nil {
+			return cmdutils.FlagError{Err: errors.New("a token name or ID cannot be used with '--config'")}
+		}
+		return nil
+	}
+
+	if o.name == nil {
+		return cmdutils.FlagError{Err: errors.New("a token name or ID is required")}
+	}
+
 	if o.group != "" && o.user != "" {
 		return cmdutils.FlagError{Err: errors.New("'--group' and '--user' are mutually exclusive")}
 	}
 
+	for _, v := range o.updateVariables {
+		if _, err := parseVariableTarget(v); err != nil {
+			return cmdutils.FlagError{Err: err}
+		}
+	}
+
 	return nil
 }
 
-func (o *options) run() error {
+func (o *options) client() (*gitlab.Client, error) {
 	// NOTE: this command can not only be used for projects,
 	// so we have to manually check for the base repo, it it doesn't exist,
 	// we bootstrap the client with the default hostname.
@@ -140,128 +205,209 @@ func (o *options) run() error {
 		repoHost = baseRepo.RepoHost()
 	}
 	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return nil, err
+	}
+	return apiClient.Lab(), nil
+}
+
+func (o *options) run() error {
+	client, err := o.client()
 	if err != nil {
 		return err
 	}
-	client := apiClient.Lab()
 
-	expirationDate := gitlab.ISOTime(o.expireAt)
+	owner := tokenOwner{user: o.user, group: o.group}
+	if owner.user == "" && owner.group == "" {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		owner.project = repo.FullName()
+	}
 
-	var outputToken any
-	var outputTokenValue string
+	// Resolve the variables before rotating, so that a typo doesn't leave us
+	// with a rotated token and nowhere to put it.
+	var bindings []*variableBinding
+	for _, v := range o.updateVariables {
+		target, _ := parseVariableTarget(v)
+		binding, err := resolveVariable(client, target)
+		if err != nil {
+			return err
+		}
+		bindings = append(bindings, binding)
+	}
+
+	token, err := findToken(client, owner, o.name)
+	if err != nil {
+		return err
+	}
+
+	outputToken, outputTokenValue, err := rotateToken(client, owner, token.ID, gitlab.ISOTime(o.expireAt))
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		encoder := json.NewEncoder(o.io.StdOut)
+		if err := encoder.Encode(outputToken); err != nil {
+			return err
+		}
+	} else {
+		if _, err := fmt.Fprintf(o.io.StdOut, "%s\n", outputTokenValue); err != nil {
+			return err
+		}
+	}
+
+	return o.updateBindings(client, bindings, outputTokenValue)
+}
+
+// updateBindings writes value into the variables of bindings. It updates as many
+// variables as possible, and returns an error if any of the updates failed.
+func (o *options) updateBindings(client *gitlab.Client, bindings []*variableBinding, value string) error {
+	color := o.io.Color()
+	failed := 0
+	for _, binding := range bindings {
+		if err := binding.update(client, value); err != nil {
+			failed++
+			fmt.Fprintf(o.io.StdErr, "%s Failed to update variable %s: %v\n", color.FailedIcon(), binding.target, err)
+			continue
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Updated variable %s.\n", color.GreenCheck(), binding.target)
+	}
 
-	if o.user != "" {
-		user, err := api.UserByName(client, o.user)
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d of %d variables.", failed, len(bindings))
+	}
+	return nil
+}
+
+// tokenOwner is the user, group, or project an access token belongs to.
+// Exactly one of the fields is set.
+type tokenOwner struct {
+	user    string
+	group   string
+	project string
+}
+
+func (t tokenOwner) String() string {
+	switch {
+	case t.user != "":
+		return "user " + t.user
+	case t.group != "":
+		return "group " + t.group
+	default:
+		return "project " + t.project
+	}
+}
+
+// findToken returns the active token of owner with the given name or ID.
+func findToken(client *gitlab.Client, owner tokenOwner, name any) (*gitlab.PersonalAccessToken, error) {
+	var tokens []*gitlab.PersonalAccessToken
+
+	switch {
+	case owner.user != "":
+		user, err := api.UserByName(client, owner.user)
 		if err != nil {
-			return cmdutils.FlagError{Err: err}
+			return nil, cmdutils.FlagError{Err: err}
 		}
 
 		options := &gitlab.ListPersonalAccessTokensOptions{
 			ListOptions: gitlab.ListOptions{PerPage: 100},
 			UserID:      &user.ID,
 		}
-		tokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+		personalTokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
 			return client.PersonalAccessTokens.ListPersonalAccessTokens(options, p)
 		})
 		if err != nil {
-			return err
+			return nil, err
 		}
-		var token *gitlab.PersonalAccessToken
-		tokens = filter.Filter(tokens, func(t *gitlab.PersonalAccessToken) bool {
-			return t.Active && (t.Name == o.name || t.ID == o.name)
+		tokens = personalTokens
+	case owner.group != "":
+		options := &gitlab.ListGroupAccessTokensOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+		groupTokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupAccessToken, *gitlab.Response, error) {
+			return client.GroupAccessTokens.ListGroupAccessTokens(owner.group, options, p)
 		})
-		switch len(tokens) {
-		case 1:
-			token = tokens[0]
-		case 0:
-			return cmdutils.FlagError{Err: fmt.Errorf("no token found with the name '%v'", o.name)}
-		default:
-			return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v'. Use the ID instead.", o.name)}
+		if err != nil {
+			return nil, err
 		}
-		rotateOptions := &gitlab.RotatePersonalAccessTokenOptions{
-			ExpiresAt: &expirationDate,
+		for _, t := range groupTokens {
+			tokens = append(tokens, &t.PersonalAccessToken)
 		}
-		if token, _, err = client.PersonalAccessTokens.RotatePersonalAccessToken(token.ID, rotateOptions); err != nil {
-			return err
+	default:
+		options := &gitlab.ListProjectAccessTokensOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+		projectTokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+			return client.ProjectAccessTokens.ListProjectAccessTokens(owner.project, options, p)
+		})
+		if err != nil {
+			return nil, err
 		}
-		outputToken = token
-		outputTokenValue = token.Token
-	} else {
-		if o.group != "" {
-			options := &gitlab.ListGroupAccessTokensOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
-			tokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupAccessToken, *gitlab.Response, error) {
-				return client.GroupAccessTokens.ListGroupAccessTokens(o.group, options, p)
-			})
-			if err != nil {
-				return err
-			}
-			var token *gitlab.GroupAccessToken
-			tokens = filter.Filter(tokens, func(t *gitlab.GroupAccessToken) bool {
-				return t.Active && (t.Name == o.name || t.ID == o.name)
-			})
-			switch len(tokens) {
-			case 1:
-				token = tokens[0]
-			case 0:
-				return cmdutils.FlagError{Err: fmt.Errorf("no token found with the name '%v'", o.name)}
-			default:
-				return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v', use the ID instead", o.name)}
-			}
-
-			rotateOptions := &gitlab.RotateGroupAccessTokenOptions{
-				ExpiresAt: &expirationDate,
-			}
-			if token, _, err = client.GroupAccessTokens.RotateGroupAccessToken(o.group, token.ID, rotateOptions); err != nil {
-				return err
-			}
-			outputToken = token
-			outputTokenValue = token.Token
-		} else {
-			repo, err := o.baseRepo()
-			if err != nil {
-				return err
-			}
-			options := &gitlab.ListProjectAccessTokensOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
-			tokens, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectAccessToken, *gitlab.Response, error) {
-				return client.ProjectAccessTokens.ListProjectAccessTokens(repo.FullName(), options, p)
-			})
-			if err != nil {
-				return err
-			}
-			tokens = filter.Filter(tokens, func(t *gitlab.ProjectAccessToken) bool {
-				return t.Active && (t.Name == o.name || t.ID == o.name)
-			})
-			var token *gitlab.ProjectAccessToken
-			switch len(tokens) {
-			case 1:
-				token = tokens[0]
-			case 0:
-				return cmdutils.FlagError{Err: fmt.Errorf("no token found with the name '%v'", o.name)}
-			default:
-				return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v', use the ID instead", o.name)}
-			}
-
-			rotateOptions := &gitlab.RotateProjectAccessTokenOptions{
-				ExpiresAt: &expirationDate,
-			}
-			if token, _, err = client.ProjectAccessTokens.RotateProjectAccessToken(repo.FullName(), token.ID, rotateOptions); err != nil {
-				return err
-			}
-			outputToken = token
-			outputTokenValue = token.Token
+		for _, t := range projectTokens {
+			tokens = append(tokens, &t.PersonalAccessToken)
 		}
 	}
 
-	if o.outputFormat == "json" {
-		encoder := json.NewEncoder(o.io.StdOut)
-		if err := encoder.Encode(outputToken); err != nil {
-			return err
+	tokens = filter.Filter(tokens, func(t *gitlab.PersonalAccessToken) bool {
+		return t.Active && (t.Name == name || t.ID == name)
+	})
+	switch len(tokens) {
+	case 1:
+		return tokens[0], nil
+	case 0:
+		return nil, cmdutils.FlagError{Err: fmt.Errorf("no token found with the name '%v'", name)}
+	default:
+		return nil, cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v', use the ID instead", name)}
+	}
+}
+
+// rotateToken rotates the token of owner with the given ID. It returns the
+// API representation of the new token, and the new token value.
+func rotateToken(client *gitlab.Client, owner tokenOwner, id int64, expiresAt gitlab.ISOTime) (any, string, error) {
+	switch {
+	case owner.user != "":
+		token, err := PersonalAccessToken(client, id, expiresAt)
+		if err != nil {
+			return nil, "", err
 		}
-	} else {
-		if _, err := fmt.Fprintf(o.io.StdOut, "%s\n", outputTokenValue); err != nil {
-			return err
+		return token, token.Token, nil
+	case owner.group != "":
+		token, err := GroupAccessToken(client, owner.group, id, expiresAt)
+		if err != nil {
+			return nil, "", err
+		}
+		return token, token.Token, nil
+	default:
+		token, err := ProjectAccessToken(client, owner.project, id, expiresAt)
+		if err != nil {
+			return nil, "", err
 		}
+		return token, token.Token, nil
 	}
+}
 
-	return nil
+// PersonalAccessToken rotates the personal access token with the given ID and
+// returns the new token, including its value.
+func PersonalAccessToken(client *gitlab.Client, id int64, expiresAt gitlab.ISOTime) (*gitlab.PersonalAccessToken, error) {
+	token, _, err := client.PersonalAccessTokens.RotatePersonalAccessToken(id, &gitlab.RotatePersonalAccessTokenOptions{
+		ExpiresAt: &expiresAt,
+	})
+	return token, err
+}
+
+// GroupAccessToken rotates the access token with the given ID of a group and
+// returns the new token, including its value.
+func GroupAccessToken(client *gitlab.Client, group string, id int64, expiresAt gitlab.ISOTime) (*gitlab.GroupAccessToken, error) {
+	token, _, err := client.GroupAccessTokens.RotateGroupAccessToken(group, id, &gitlab.RotateGroupAccessTokenOptions{
+		ExpiresAt: &expiresAt,
+	})
+	return token, err
+}
+
+// ProjectAccessToken rotates the access token with the given ID of a project and
+// returns the new token, including its value.
+func ProjectAccessToken(client *gitlab.Client, project string, id int64, expiresAt gitlab.ISOTime) (*gitlab.ProjectAccessToken, error) {
+	token, _, err := client.ProjectAccessTokens.RotateProjectAccessToken(project, id, &gitlab.RotateProjectAccessTokenOptions{
+		ExpiresAt: &expiresAt,
+	})
+	return token, err
 }
@@ -6,10 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
@@ -276,3 +280,200 @@ func TestRotateProjectAccessTokenAsText(t *testing.T) {
 
 	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", output.String())
 }
+
+func TestRotateByID(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("[%s]", projectAccessTokenResponse)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/access_tokens/10191548/rotate",
+		httpmock.NewStringResponse(http.StatusOK, projectAccessTokenResponse))
+
+	output, err := runCommand(t, fakeHTTP, "10191548")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", output.String())
+}
+
+// captureRequests returns a responder that records the request bodies.
+func captureRequests(bodies *[]map[string]any, status int, response string) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		var body map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		*bodies = append(*bodies, body)
+		return httpmock.NewStringResponse(status, response)(req)
+	}
+}
+
+func TestRotateUpdateVariable(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"key": "API_TOKEN", "value": "old", "variable_type": "env_var", "protected": true, "masked": true, "environment_scope": "production"},
+			{"key": "API_TOKEN", "value": "old", "variable_type": "env_var", "protected": false, "masked": true, "environment_scope": "staging"},
+			{"key": "OTHER", "value": "x", "variable_type": "env_var", "environment_scope": "*"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/my-group/variables",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Project Not Found"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-group/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[{"key": "GROUP_TOKEN", "value": "old", "variable_type": "env_var", "masked": true, "environment_scope": "*"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("[%s]", projectAccessTokenResponse)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/access_tokens/10191548/rotate",
+		httpmock.NewStringResponse(http.StatusOK, projectAccessTokenResponse))
+
+	var projectUpdates, groupUpdates []map[string]any
+	fakeHTTP.RegisterReusableResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/variables/API_TOKEN",
+		captureRequests(&projectUpdates, http.StatusOK, `{"key": "API_TOKEN"}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/groups/my-group/variables/GROUP_TOKEN",
+		captureRequests(&groupUpdates, http.StatusOK, `{"key": "GROUP_TOKEN"}`))
+
+	output, err := runCommand(t, fakeHTTP, "my-project-token --update-variable OWNER/REPO:API_TOKEN --update-variable my-group:GROUP_TOKEN")
+	require.NoError(t, err)
+
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd\n", output.String())
+	assert.Contains(t, output.Stderr(), "Updated variable OWNER/REPO:API_TOKEN.")
+	assert.Contains(t, output.Stderr(), "Updated variable my-group:GROUP_TOKEN.")
+
+	require.Len(t, projectUpdates, 2)
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd", projectUpdates[0]["value"])
+	assert.Equal(t, true, projectUpdates[0]["protected"])
+	assert.Equal(t, true, projectUpdates[0]["masked"])
+	assert.Equal(t, "production", projectUpdates[0]["environment_scope"])
+	assert.Equal(t, false, projectUpdates[1]["protected"])
+	assert.Equal(t, "staging", projectUpdates[1]["environment_scope"])
+
+	require.Len(t, groupUpdates, 1)
+	assert.Equal(t, "glpat-dfsdfjksjdfslkdfjsd", groupUpdates[0]["value"])
+	assert.Equal(t, true, groupUpdates[0]["masked"])
+}
+
+func TestRotateUpdateVariableNotFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	_, err := runCommand(t, fakeHTTP, "my-project-token --update-variable OWNER/REPO:API_TOKEN")
+	require.Error(t, err)
+	assert.Equal(t, "variable API_TOKEN not found in OWNER/REPO. Create it before rotating the token.", err.Error())
+}
+
+func TestRotateUpdateVariableInvalid(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "my-project-token --update-variable API_TOKEN")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Use the format <project-or-group-path>:<KEY>.")
+}
+
+func TestRotateConfig(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	expiresSoon := time.Now().UTC().AddDate(0, 0, 3).Format(time.DateOnly)
+	expiresLater := time.Now().UTC().AddDate(0, 0, 60).Format(time.DateOnly)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/variables",
+		httpmock.NewStringResponse(http.StatusOK, `[{"key": "DEPLOY_TOKEN", "value": "old", "variable_type": "env_var", "masked": true, "environment_scope": "*"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`[{"id": 1, "name": "deploy", "active": true, "expires_at": %q}]`, expiresSoon)))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/access_tokens/1/rotate",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 2, "name": "deploy", "active": true, "token": "glpat-new"}`))
+	var updates []map[string]any
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/variables/DEPLOY_TOKEN",
+		captureRequests(&updates, http.StatusOK, `{"key": "DEPLOY_TOKEN"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-group/access_tokens",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`[{"id": 5, "name": "bot", "active": true, "expires_at": %q}]`, expiresLater)))
+
+	config := filepath.Join(t.TempDir(), "bindings.yml")
+	require.NoError(t, os.WriteFile(config, []byte(heredoc.Doc(`
+		expiring_within_days: 7
+		duration: 14d
+		tokens:
+		  - project: OWNER/REPO
+		    name: deploy
+		    variables:
+		      - OWNER/REPO:DEPLOY_TOKEN
+		  - group: my-group
+		    id: 5
+	`)), 0o600))
+
+	output, err := runCommand(t, fakeHTTP, "--config "+config)
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Contains(t, output.Stderr(), "Rotated token deploy of project OWNER/REPO.")
+	assert.Contains(t, output.Stderr(), "Updated variable OWNER/REPO:DEPLOY_TOKEN.")
+	assert.Contains(t, output.Stderr(), "Skipped token bot of group my-group: it expires on "+expiresLater+".")
+	require.Len(t, updates, 1)
+	assert.Equal(t, "glpat-new", updates[0]["value"])
+}
+
+func TestReadBindingsConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "no tokens",
+			content: "duration: 30d\n",
+			wantErr: "no tokens listed",
+		},
+		{
+			name:    "two owners",
+			content: "tokens:\n  - project: a/b\n    group: a\n    name: x\n",
+			wantErr: "token 1: set exactly one of user, group, or project.",
+		},
+		{
+			name:    "name and id",
+			content: "tokens:\n  - project: a/b\n    name: x\n    id: 4\n",
+			wantErr: "token 1: set either name or id.",
+		},
+		{
+			name:    "invalid variable",
+			content: "tokens:\n  - project: a/b\n    name: x\n    variables: [KEY]\n",
+			wantErr: "token 1: invalid variable",
+		},
+		{
+			name:    "invalid duration",
+			content: "duration: 2y\ntokens:\n  - project: a/b\n    name: x\n",
+			wantErr: "invalid duration",
+		},
+		{
+			name:    "valid",
+			content: "tokens:\n  - user: '@me'\n    name: x\n    duration: 7d\n    variables: [a/b:KEY]\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "bindings.yml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			_, err := readBindingsConfig(path)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestRotateRequiresName(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{}
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "")
+	require.Error(t, err)
+	assert.Equal(t, "a token name or ID is required", err.Error())
+}
@@ -0,0 +1,81 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/variableutils"
+)
+
+// variableTarget is a CI/CD variable key in a project or group, written as <path>:<KEY>.
+type variableTarget struct {
+	path string
+	key  string
+}
+
+func (t variableTarget) String() string {
+	return t.path + ":" + t.key
+}
+
+func parseVariableTarget(s string) (variableTarget, error) {
+	i := strings.LastIndex(s, ":")
+	if i <= 0 || i == len(s)-1 {
+		return variableTarget{}, fmt.Errorf("invalid variable %q. Use the format <project-or-group-path>:<KEY>.", s)
+	}
+
+	target := variableTarget{path: s[:i], key: s[i+1:]}
+	if !variableutils.IsValidKey(target.key) {
+		return variableTarget{}, fmt.Errorf("invalid key %q. %s", target.key, variableutils.ValidKeyMsg)
+	}
+	return target, nil
+}
+
+// variableBinding is a resolved variableTarget: the variables, one per environment
+// scope, that hold the key in either a project or a group.
+type variableBinding struct {
+	target    variableTarget
+	project   string
+	group     string
+	variables []*variableutils.Variable
+}
+
+// resolveVariable looks up the variables of target. The path is tried as a
+// project first, and as a group if no such project exists.
+func resolveVariable(client *gitlab.Client, target variableTarget) (*variableBinding, error) {
+	binding := &variableBinding{target: target, project: target.path}
+
+	variables, err := variableutils.ListVariables(client, target.path, "")
+	if errors.Is(err, gitlab.ErrNotFound) {
+		binding.project, binding.group = "", target.path
+		variables, err = variableutils.ListVariables(client, "", target.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the variables of %s: %w", target.path, err)
+	}
+
+	for _, v := range variables {
+		if v.Key == target.key {
+			binding.variables = append(binding.variables, v)
+		}
+	}
+	if len(binding.variables) == 0 {
+		return nil, fmt.Errorf("variable %s not found in %s. Create it before rotating the token.", target.key, target.path)
+	}
+
+	return binding, nil
+}
+
+// update sets the value of all variables of the binding, and keeps their other settings.
+func (b *variableBinding) update(client *gitlab.Client, value string) error {
+	for _, v := range b.variables {
+		updated := *v
+		updated.Value = value
+		if err := variableutils.UpdateVariable(client, b.project, b.group, &updated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,10 +33,13 @@ const (
 var commandAliases = []string{"update"}
 
 func NewCheckUpdateCmd(f cmdutils.Factory) *cobra.Command {
+	var installFlag bool
+	opts := installOptions{}
+
 	cmd := &cobra.Command{
 		Use:   commandUse,
 		Short: "Check for latest glab releases.",
-		Long: heredoc.Doc(`Checks for the latest version of glab available on GitLab.com.
+		Long: heredoc.Docf(`Checks for the latest version of glab available on GitLab.com.
 
 		When run explicitly, this command always checks for updates regardless of when the last check occurred.
 
@@ -43,16 +47,48 @@ func NewCheckUpdateCmd(f cmdutils.Factory) *cobra.Command {
 
 		To disable the automatic update check entirely, run 'glab config set check_update false'.
 		To re-enable the automatic update check, run 'glab config set check_update true'.
+
+		With %[1]s--install%[1]s, installs the latest release instead. The release asset for your platform is
+		verified with the checksums of the release, then replaces the running binary. Only standalone
+		binaries are updated this way: if glab was installed with Homebrew, snap, or another package
+		manager, the command prints how to update it with that package manager instead.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Check for a new version
+			$ glab update
+
+			# Install the latest version
+			$ glab update --install
+
+			# Install a specific version
+			$ glab update --install --version v1.60.0
+
+			# Install the latest version, including pre-releases
+			$ glab update --install --channel prerelease
 		`),
 		Aliases: commandAliases,
 		Annotations: map[string]string{
-			mcpannotations.Safe: "true",
+			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return CheckUpdateExplicit(f)
+			if !installFlag {
+				if cmd.Flags().Changed("version") || cmd.Flags().Changed("channel") {
+					return &cmdutils.FlagError{Err: errors.New("the '--version' and '--channel' flags require '--install'.")}
+				}
+				return CheckUpdateExplicit(f)
+			}
+			if !slices.Contains(channels, opts.channel) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid channel %q. Use one of: %s.", opts.channel, strings.Join(channels, ", "))}
+			}
+			return install(f, opts)
 		},
 	}
 
+	cmd.Flags().BoolVar(&installFlag, "install", false, "Install the latest version of glab.")
+	cmd.Flags().StringVar(&opts.version, "version", "", "Install this version of glab, instead of the latest one.")
+	cmd.Flags().StringVar(&opts.channel, "channel", channelStable, "Release channel to install from: stable, or prerelease to include pre-releases.")
+	cmd.MarkFlagsMutuallyExclusive("version", "channel")
+
 	return cmd
 }
 
@@ -92,7 +128,7 @@ func checkUpdate(f cmdutils.Factory, silentSuccess bool, forceCheck bool) error
 	gitlabClient := apiClient.Lab()
 
 	releases, _, err := gitlabClient.Releases.ListReleases(
-		"gitlab-org/cli", &gitlab.ListReleasesOptions{ListOptions: gitlab.ListOptions{Page: 1, PerPage: 1}})
+		projectPath, &gitlab.ListReleasesOptions{ListOptions: gitlab.ListOptions{Page: 1, PerPage: 1}})
 	if err != nil {
 		return fmt.Errorf("failed checking for glab updates: %s", err.Error())
 	}
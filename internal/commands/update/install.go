@@ -0,0 +1,339 @@
+package update
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
+)
+
+const (
+	projectPath = "gitlab-org/cli"
+
+	channelStable     = "stable"
+	channelPrerelease = "prerelease"
+)
+
+var channels = []string{channelStable, channelPrerelease}
+
+// executable returns the path of the running glab binary. It's a variable
+// so tests can override it.
+var executable = os.Executable
+
+// installMethod is how glab was installed. Only standalone binaries are
+// updated by glab itself: the others are updated with the tool that
+// installed them, which would otherwise overwrite the update, or fail to
+// verify its files.
+type installMethod struct {
+	name string
+	// hint tells how to update glab, for the other methods.
+	hint string
+}
+
+var standalone = installMethod{name: "standalone binary"}
+
+// detectInstallMethod detects how the binary at path was installed, from
+// the directory it's in.
+func detectInstallMethod(path string) installMethod {
+	p := filepath.ToSlash(path)
+	lower := strings.ToLower(p)
+
+	switch {
+	case strings.Contains(p, "/Cellar/") || strings.Contains(p, "/homebrew/") || strings.Contains(p, "/linuxbrew/"):
+		return installMethod{name: "Homebrew", hint: "Run 'brew upgrade glab' to update it."}
+	case strings.HasPrefix(p, "/snap/") || os.Getenv("SNAP_NAME") == "glab":
+		return installMethod{name: "snap", hint: "Run 'sudo snap refresh glab' to update it."}
+	case strings.HasPrefix(p, "/nix/store/"):
+		return installMethod{name: "Nix", hint: "Run 'nix profile upgrade glab' to update it."}
+	case strings.Contains(lower, "/scoop/"):
+		return installMethod{name: "Scoop", hint: "Run 'scoop update glab' to update it."}
+	case strings.Contains(lower, "/chocolatey/"):
+		return installMethod{name: "Chocolatey", hint: "Run 'choco upgrade glab' to update it."}
+	case strings.Contains(lower, "/winget/"):
+		return installMethod{name: "WinGet", hint: "Run 'winget upgrade glab' to update it."}
+	case strings.Contains(p, "/.asdf/"):
+		return installMethod{name: "asdf", hint: "Run 'asdf install glab latest' to update it."}
+	case strings.Contains(p, "/mise/"):
+		return installMethod{name: "mise", hint: "Run 'mise upgrade glab' to update it."}
+	case p == "/usr/bin/glab" || p == "/bin/glab":
+		return installMethod{name: "a package manager", hint: "Update it with your package manager, like apt, dnf, or apk."}
+	default:
+		return standalone
+	}
+}
+
+type installOptions struct {
+	version string
+	channel string
+}
+
+// install updates the running glab binary to the latest release of the
+// channel, or to the pinned version. The release asset is verified with the
+// checksums of the release before it replaces the binary.
+func install(f cmdutils.Factory, opts installOptions) error {
+	c := f.IO().Color()
+
+	exe, err := executable()
+	if err != nil {
+		return fmt.Errorf("could not find the glab binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	method := detectInstallMethod(exe)
+	if method != standalone {
+		return fmt.Errorf("glab was installed with %s. %s", method.name, method.hint)
+	}
+
+	apiClient, err := clientCreator(f.BuildInfo().UserAgent())
+	if err != nil {
+		return err
+	}
+	client := apiClient.Lab()
+
+	release, err := findRelease(client, opts)
+	if err != nil {
+		return err
+	}
+
+	current := f.BuildInfo().Version
+	if opts.version == "" && !isOlderVersion(release.TagName, current) {
+		fmt.Fprintf(f.IO().StdErr, "%s You are already using the latest version of glab: %s.\n", c.GreenCheck(), current)
+		return nil
+	}
+	if sameVersion(release.TagName, current) {
+		fmt.Fprintf(f.IO().StdErr, "%s You are already using glab %s.\n", c.GreenCheck(), current)
+		return nil
+	}
+
+	assetName := releaseAssetName(release.TagName, runtime.GOOS, runtime.GOARCH)
+	link := releaseutils.FindLink(release, assetName)
+	if link == nil {
+		return fmt.Errorf("release %s has no asset for %s/%s.", release.TagName, runtime.GOOS, runtime.GOARCH)
+	}
+
+	ctx := context.Background()
+	content, err := releaseutils.FetchChecksums(ctx, client, release)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		return fmt.Errorf("release %s has no %s, so its assets can't be verified.", release.TagName, checksums.FileName)
+	}
+	sums, err := checksums.Parse(content)
+	if err != nil {
+		return err
+	}
+	_, want, ok := sums.Lookup(link)
+	if !ok {
+		return fmt.Errorf("%s has no checksum for %s.", checksums.FileName, assetName)
+	}
+
+	fmt.Fprintf(f.IO().StdErr, "%s Downloading glab %s...\n", c.ProgressIcon(), release.TagName)
+
+	// Write the new binary next to the current one, so it can replace it
+	// with a rename, which is atomic.
+	dir := filepath.Dir(exe)
+	archive, err := os.CreateTemp(dir, ".glab-download-*")
+	if err != nil {
+		return fmt.Errorf("could not write to %s: %w. Run the command again as a user who can write to it.", dir, err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	assetURL := link.DirectAssetURL
+	if assetURL == "" {
+		assetURL = link.URL
+	}
+	if err := releaseutils.DownloadAsset(ctx, client, assetURL, archive); err != nil {
+		return fmt.Errorf("could not download %s: %w", assetName, err)
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	got, err := checksums.Sum(archive)
+	if err != nil {
+		return err
+	}
+	if got != want {
+		return fmt.Errorf("the checksum of %s does not match %s: expected %s, got %s.", assetName, checksums.FileName, want, got)
+	}
+
+	binary, err := extractBinary(archive, assetName, dir)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(binary)
+
+	if err := replaceExecutable(exe, binary); err != nil {
+		return fmt.Errorf("could not replace %s: %w", exe, err)
+	}
+
+	fmt.Fprintf(f.IO().StdErr, "%s Updated glab from %s to %s.\n", c.GreenCheck(), current, release.TagName)
+	return nil
+}
+
+// findRelease returns the release of the pinned version, or the latest
+// release of the channel.
+func findRelease(client *gitlab.Client, opts installOptions) (*gitlab.Release, error) {
+	if opts.version != "" {
+		tag := "v" + strings.TrimPrefix(opts.version, "v")
+		release, _, err := client.Releases.GetRelease(projectPath, tag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get glab %s: %w", tag, err)
+		}
+		return release, nil
+	}
+
+	releases, _, err := client.Releases.ListReleases(projectPath, &gitlab.ListReleasesOptions{ListOptions: gitlab.ListOptions{Page: 1, PerPage: 20}})
+	if err != nil {
+		return nil, fmt.Errorf("failed checking for glab updates: %w", err)
+	}
+	for _, release := range releases {
+		if opts.channel == channelPrerelease || !isPrerelease(release.TagName) {
+			return release, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s release found for glab.", opts.channel)
+}
+
+func isPrerelease(tag string) bool {
+	v, err := version.NewVersion(tag)
+	return err == nil && v.Prerelease() != ""
+}
+
+func sameVersion(a, b string) bool {
+	va, errA := version.NewVersion(a)
+	vb, errB := version.NewVersion(b)
+	return errA == nil && errB == nil && va.Equal(vb)
+}
+
+// releaseAssetName returns the name of the archive of a release for a
+// platform, as published by GoReleaser.
+func releaseAssetName(tag, goos, goarch string) string {
+	if goarch == "arm" {
+		goarch = "armv6"
+	}
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("glab_%s_%s_%s%s", strings.TrimPrefix(tag, "v"), goos, goarch, ext)
+}
+
+func binaryName() string {
+	if runtime.GOOS == "windows" {
+		return "glab.exe"
+	}
+	return "glab"
+}
+
+// extractBinary extracts the glab binary of a release archive to a
+// temporary file of dir, and returns its path.
+func extractBinary(archive *os.File, assetName, dir string) (string, error) {
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	var binary io.Reader
+	if strings.HasSuffix(assetName, ".zip") {
+		stat, err := archive.Stat()
+		if err != nil {
+			return "", err
+		}
+		zr, err := zip.NewReader(archive, stat.Size())
+		if err != nil {
+			return "", fmt.Errorf("could not read %s: %w", assetName, err)
+		}
+		for _, file := range zr.File {
+			if filepath.Base(file.Name) == binaryName() && !file.FileInfo().IsDir() {
+				rc, err := file.Open()
+				if err != nil {
+					return "", err
+				}
+				defer rc.Close()
+				binary = rc
+				break
+			}
+		}
+	} else {
+		gz, err := gzip.NewReader(archive)
+		if err != nil {
+			return "", fmt.Errorf("could not read %s: %w", assetName, err)
+		}
+		defer gz.Close()
+		tr := tar.NewReader(gz)
+		for {
+			header, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("could not read %s: %w", assetName, err)
+			}
+			if header.Typeflag == tar.TypeReg && filepath.Base(header.Name) == binaryName() {
+				binary = tr
+				break
+			}
+		}
+	}
+	if binary == nil {
+		return "", fmt.Errorf("%s has no %s binary.", assetName, binaryName())
+	}
+
+	out, err := os.CreateTemp(dir, ".glab-update-*")
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(out, binary); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return "", err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(out.Name())
+		return "", err
+	}
+	if err := os.Chmod(out.Name(), 0o755); err != nil {
+		os.Remove(out.Name())
+		return "", err
+	}
+	return out.Name(), nil
+}
+
+// replaceExecutable replaces the binary at exe with the one at binary. On
+// Windows, running binaries can't be replaced, but they can be renamed, so
+// the current binary is moved out of the way first.
+func replaceExecutable(exe, binary string) error {
+	if runtime.GOOS != "windows" {
+		return os.Rename(binary, exe)
+	}
+
+	old := exe + ".old"
+	_ = os.Remove(old)
+	if err := os.Rename(exe, old); err != nil {
+		return err
+	}
+	if err := os.Rename(binary, exe); err != nil {
+		_ = os.Rename(old, exe)
+		return err
+	}
+	return nil
+}
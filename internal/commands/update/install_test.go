@@ -0,0 +1,202 @@
+//go:build !integration
+
+package update
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/release/releaseutils/checksums"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func Test_detectInstallMethod(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/opt/homebrew/Cellar/glab/1.60.0/bin/glab", "Homebrew"},
+		{"/home/linuxbrew/.linuxbrew/bin/glab", "Homebrew"},
+		{"/snap/glab/123/bin/glab", "snap"},
+		{"/nix/store/abc-glab-1.60.0/bin/glab", "Nix"},
+		{`C:\Users\alice\scoop\apps\glab\current\glab.exe`, "Scoop"},
+		{"/home/alice/.asdf/installs/glab/1.60.0/bin/glab", "asdf"},
+		{"/usr/bin/glab", "a package manager"},
+		{"/usr/local/bin/glab", "standalone binary"},
+		{"/home/alice/bin/glab", "standalone binary"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			path := tc.path
+			if runtime.GOOS != "windows" {
+				path = strings.ReplaceAll(path, `\`, "/")
+			}
+			assert.Equal(t, tc.want, detectInstallMethod(path).name)
+		})
+	}
+}
+
+func Test_releaseAssetName(t *testing.T) {
+	assert.Equal(t, "glab_1.60.0_linux_amd64.tar.gz", releaseAssetName("v1.60.0", "linux", "amd64"))
+	assert.Equal(t, "glab_1.60.0_linux_armv6.tar.gz", releaseAssetName("v1.60.0", "linux", "arm"))
+	assert.Equal(t, "glab_1.60.0_windows_amd64.zip", releaseAssetName("v1.60.0", "windows", "amd64"))
+}
+
+func releaseArchive(t *testing.T, binary string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string]string{"README.md": "readme", "bin/glab": binary} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func releaseJSON(tag string, assetName string) string {
+	version := strings.TrimPrefix(tag, "v")
+	return fmt.Sprintf(`{
+		"tag_name": %[1]q,
+		"name": %[1]q,
+		"assets": {
+			"links": [
+				{"name": %[3]q, "url": "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/%[2]s/%[3]s"},
+				{"name": "checksums.txt", "url": "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/%[2]s/checksums.txt"}
+			]
+		}
+	}`, tag, version, assetName)
+}
+
+func runInstall(t *testing.T, rt http.RoundTripper, exe, currentVersion, cli string) (*test.CmdOut, error) {
+	t.Helper()
+	t.Setenv("NO_COLOR", "true")
+
+	oldExecutable, oldCreator := executable, clientCreator
+	t.Cleanup(func() {
+		executable, clientCreator = oldExecutable, oldCreator
+	})
+	executable = func() (string, error) { return exe, nil }
+	clientCreator = func(userAgent string, options ...api.ClientOption) (*api.Client, error) {
+		opts := append([]api.ClientOption{api.WithHTTPClient(&http.Client{Transport: rt})}, options...)
+		return createUnauthenticatedClient(userAgent, opts...)
+	}
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios, cmdtest.WithBuildInfo(api.BuildInfo{Version: currentVersion}))
+
+	defer config.StubWriteConfig(io.Discard, io.Discard)()
+	return cmdtest.ExecuteCommand(NewCheckUpdateCmd(factory), cli, stdout, stderr)
+}
+
+func setupExecutable(t *testing.T) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("release archives for Windows are zip files")
+	}
+	exe := filepath.Join(t.TempDir(), "glab")
+	require.NoError(t, os.WriteFile(exe, []byte("old"), 0o755))
+	return exe
+}
+
+func TestInstall(t *testing.T) {
+	exe := setupExecutable(t)
+
+	assetName := releaseAssetName("v1.60.0", runtime.GOOS, runtime.GOARCH)
+	archive := releaseArchive(t, "new")
+	sum, err := checksums.Sum(bytes.NewReader(archive))
+	require.NoError(t, err)
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/gitlab-org/cli/releases?page=1&per_page=20",
+		httpmock.NewStringResponse(http.StatusOK, "["+releaseJSON("v1.61.0-rc1", releaseAssetName("v1.61.0-rc1", runtime.GOOS, runtime.GOARCH))+","+releaseJSON("v1.60.0", assetName)+"]"))
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/1.60.0/checksums.txt",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("%s  %s\n", sum, assetName)))
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/1.60.0/"+assetName,
+		httpmock.NewStringResponse(http.StatusOK, string(archive)))
+
+	output, err := runInstall(t, fakeHTTP, exe, "v1.59.0", "--install")
+	require.NoError(t, err)
+	assert.Equal(t, "• Downloading glab v1.60.0...\n✓ Updated glab from v1.59.0 to v1.60.0.\n", output.Stderr())
+
+	content, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+
+	entries, err := os.ReadDir(filepath.Dir(exe))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files must be removed")
+}
+
+func TestInstall_checksumMismatch(t *testing.T) {
+	exe := setupExecutable(t)
+
+	assetName := releaseAssetName("v1.60.0", runtime.GOOS, runtime.GOARCH)
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/gitlab-org/cli/releases/v1.60.0",
+		httpmock.NewStringResponse(http.StatusOK, releaseJSON("v1.60.0", assetName)))
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/1.60.0/checksums.txt",
+		httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf("%s  %s\n", strings.Repeat("0", 64), assetName)))
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/34675721/packages/generic/glab/1.60.0/"+assetName,
+		httpmock.NewStringResponse(http.StatusOK, string(releaseArchive(t, "tampered"))))
+
+	_, err := runInstall(t, fakeHTTP, exe, "v1.61.0", "--install --version 1.60.0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the checksum of "+assetName+" does not match checksums.txt")
+
+	content, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(content))
+}
+
+func TestInstall_latest(t *testing.T) {
+	exe := setupExecutable(t)
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/gitlab-org/cli/releases?page=1&per_page=20",
+		httpmock.NewStringResponse(http.StatusOK, "["+releaseJSON("v1.61.0-rc1", "")+","+releaseJSON("v1.60.0", "")+"]"))
+
+	output, err := runInstall(t, fakeHTTP, exe, "v1.60.0", "--install")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ You are already using the latest version of glab: v1.60.0.\n", output.Stderr())
+}
+
+func TestInstall_packageManager(t *testing.T) {
+	_, err := runInstall(t, &httpmock.Mocker{}, "/opt/homebrew/Cellar/glab/1.60.0/bin/glab", "v1.59.0", "--install")
+	require.Error(t, err)
+	assert.Equal(t, "glab was installed with Homebrew. Run 'brew upgrade glab' to update it.", err.Error())
+}
+
+func TestInstall_flags(t *testing.T) {
+	_, err := runInstall(t, &httpmock.Mocker{}, "", "v1.59.0", "--version 1.60.0")
+	require.Error(t, err)
+	assert.Equal(t, "the '--version' and '--channel' flags require '--install'.", err.Error())
+
+	_, err = runInstall(t, &httpmock.Mocker{}, "", "v1.59.0", "--install --channel nightly")
+	require.Error(t, err)
+	assert.Equal(t, `invalid channel "nightly". Use one of: stable, prerelease.`, err.Error())
+}
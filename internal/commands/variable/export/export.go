@@ -168,6 +168,34 @@ func (o *options) run() error {
 	}
 }
 
+// ProjectVariablesForScope returns all variables of the project that apply
+// to the given environment scope.
+func ProjectVariablesForScope(client *gitlab.Client, project any, scope string) ([]*gitlab.ProjectVariable, error) {
+	if !isValidEnvironmentScope(scope) {
+		return nil, fmt.Errorf("invalid environment scope: %s", scope)
+	}
+
+	opts := &gitlab.ListProjectVariablesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+	variables, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		return client.ProjectVariables.ListVariables(project, opts, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*gitlab.ProjectVariable, 0, len(variables))
+	for _, variable := range variables {
+		if matchesScope(variable.EnvironmentScope, scope) {
+			filtered = append(filtered, variable)
+		}
+	}
+	return filtered, nil
+}
+
 func matchesScope(varScope, optScope string) bool {
 	if varScope == "*" || optScope == "*" {
 		return true
@@ -643,3 +643,25 @@ func Test_exportRun_group(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProjectVariablesForScope(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+		{Key: "ALL", EnvironmentScope: "*"},
+		{Key: "PROD", EnvironmentScope: "production"},
+		{Key: "REVIEW", EnvironmentScope: "review/*"},
+		{Key: "STAGING", EnvironmentScope: "staging"},
+	}, &gitlab.Response{}, nil)
+
+	variables, err := ProjectVariablesForScope(tc.Client, "owner/repo", "review/app")
+	assert.NoError(t, err)
+
+	var keys []string
+	for _, v := range variables {
+		keys = append(keys, v.Key)
+	}
+	assert.Equal(t, []string{"ALL", "REVIEW"}, keys)
+
+	_, err = ProjectVariablesForScope(tc.Client, "owner/repo", "in.valid")
+	assert.EqualError(t, err, "invalid environment scope: in.valid")
+}
@@ -0,0 +1,413 @@
+package variableimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+const (
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+	conflictFail      = "fail"
+)
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	file       string
+	format     string
+	group      string
+	scope      string
+	protected  bool
+	masked     bool
+	raw        bool
+	onConflict string
+	dryRun     bool
+}
+
+func NewCmdImport(f cmdutils.Factory, runE func(opts *options) error) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import variables into a project or group.",
+		Long: heredoc.Docf(`
+			Import variables into a project or group from a file or from standard input.
+
+			Supported formats are the output formats of %[1]sglab variable export%[1]s:
+
+			- %[1]sjson%[1]s: A list of variables, with their type, scope, and flags.
+			- %[1]senv%[1]s: One %[1]sKEY=VALUE%[1]s line per variable, as in a dotenv file.
+			- %[1]sexport%[1]s: One %[1]sexport KEY=VALUE%[1]s line per variable.
+
+			For the %[1]senv%[1]s and %[1]sexport%[1]s formats, the scope and flags of the variables are
+			set with %[1]s--scope%[1]s, %[1]s--protected%[1]s, %[1]s--masked%[1]s, and %[1]s--raw%[1]s.
+			Values can be wrapped in single or double quotes, and quoted values can span several
+			lines. Escape sequences are not interpreted.
+
+			All variables are validated before any is imported. Masked and hidden variables
+			without a value are skipped: the value of hidden variables is not exported.
+
+			A variable conflicts with an existing variable when both have the same key and
+			environment scope. Use %[1]s--on-conflict%[1]s to skip or overwrite existing variables.
+			With the default, %[1]sfail%[1]s, nothing is imported if any variable conflicts.
+		`, "`"),
+		Args: cobra.ExactArgs(0),
+		Example: heredoc.Doc(`
+			# Move variables from one project to another
+			$ glab variable export -R group/source > vars.json
+			$ glab variable import -R group/target -f vars.json
+
+			# Import a dotenv file as protected variables for the production environment
+			$ glab variable import -f .env --scope production --protected
+
+			# Import into a group, overwriting existing variables
+			$ glab variable export --output export | glab variable import --group mygroup --on-conflict overwrite
+
+			# Show what would be imported
+			$ glab variable import -f vars.json --dry-run
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(cmd); err != nil {
+				return err
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+	cmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repository argument is set.")
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.file, "file", "f", "-", "Path to the file to import. Use '-' to read from standard input.")
+	fl.StringVarP(&opts.format, "format", "F", "", "Format of the input: json, env, export. (default: detected from the content)")
+	fl.StringVarP(&opts.scope, "scope", "s", "*", "The environment_scope of variables imported from the env and export formats.")
+	fl.BoolVarP(&opts.protected, "protected", "p", false, "Whether variables imported from the env and export formats are protected.")
+	fl.BoolVarP(&opts.masked, "masked", "m", false, "Whether variables imported from the env and export formats are masked.")
+	fl.BoolVarP(&opts.raw, "raw", "r", false, "Whether variables imported from the env and export formats are treated as raw strings.")
+	fl.StringVar(&opts.onConflict, "on-conflict", conflictFail, "What to do when a variable already exists: skip, overwrite, fail.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Print the changes without applying them.")
+
+	return cmd
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	group, err := cmdutils.GroupOverride(cmd)
+	if err != nil {
+		return err
+	}
+	o.group = group
+
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.file == "-" && o.io.IsInTTY {
+		return &cmdutils.FlagError{Err: errors.New("no file specified with --file and nothing on STDIN.")}
+	}
+
+	switch o.format {
+	case "", "json", "env", "export":
+	default:
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid format: %s. --format must be one of `json`, `env`, or `export`.", o.format)}
+	}
+
+	switch o.onConflict {
+	case conflictSkip, conflictOverwrite, conflictFail:
+	default:
+		return &cmdutils.FlagError{Err: fmt.Errorf("invalid conflict policy: %s. --on-conflict must be one of `skip`, `overwrite`, or `fail`.", o.onConflict)}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	c := o.io.Color()
+
+	content, err := o.readInput()
+	if err != nil {
+		return err
+	}
+
+	variables, withoutValue, err := o.parse(content)
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if o.dryRun {
+		prefix = "[dry run] "
+	}
+
+	for _, v := range withoutValue {
+		fmt.Fprintf(o.io.StdOut, "%s%s Skipped variable %s: masked and hidden variables need a value.\n", prefix, c.WarnIcon(), v.ID())
+	}
+	if len(variables) == 0 {
+		return errors.New("no variables to import.")
+	}
+
+	// NOTE: this command can not only be used for projects,
+	// so we have to manually check for the base repo, it it doesn't exist,
+	// we bootstrap the client with the default hostname.
+	var repoHost string
+	if baseRepo, err := o.baseRepo(); err == nil {
+		repoHost = baseRepo.RepoHost()
+	}
+	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return err
+	}
+	client := apiClient.Lab()
+
+	var project, target string
+	if o.group != "" {
+		target = "group " + o.group
+	} else {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		project = repo.FullName()
+		target = "project " + project
+	}
+
+	existing, err := variableutils.ListVariables(client, project, o.group)
+	if err != nil {
+		return err
+	}
+	exists := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		exists[v.ID()] = true
+	}
+
+	if o.onConflict == conflictFail {
+		var conflicts []string
+		for _, v := range variables {
+			if exists[v.ID()] {
+				conflicts = append(conflicts, v.ID())
+			}
+		}
+		if len(conflicts) > 0 {
+			return fmt.Errorf("variables already exist in %s: %s. Use --on-conflict to skip or overwrite them.", target, strings.Join(conflicts, ", "))
+		}
+	}
+
+	created, updated, skipped := 0, 0, len(withoutValue)
+	for _, v := range variables {
+		switch {
+		case !exists[v.ID()]:
+			if !o.dryRun {
+				if err := variableutils.CreateVariable(client, project, o.group, v); err != nil {
+					return fmt.Errorf("creating variable %s: %w", v.ID(), err)
+				}
+			}
+			created++
+			fmt.Fprintf(o.io.StdOut, "%s%s Created variable %s.\n", prefix, c.GreenCheck(), v.ID())
+		case o.onConflict == conflictOverwrite:
+			if !o.dryRun {
+				if err := variableutils.UpdateVariable(client, project, o.group, v); err != nil {
+					return fmt.Errorf("updating variable %s: %w", v.ID(), err)
+				}
+			}
+			updated++
+			fmt.Fprintf(o.io.StdOut, "%s%s Updated variable %s.\n", prefix, c.GreenCheck(), v.ID())
+		default:
+			skipped++
+			fmt.Fprintf(o.io.StdOut, "%s%s Skipped existing variable %s.\n", prefix, c.WarnIcon(), v.ID())
+		}
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%sImported variables into %s: %d created, %d updated, %d skipped.\n", prefix, target, created, updated, skipped)
+	return nil
+}
+
+func (o *options) readInput() ([]byte, error) {
+	if o.file == "-" {
+		defer o.io.In.Close()
+		content, err := io.ReadAll(o.io.In)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read variables from STDIN: %w", err)
+		}
+		return content, nil
+	}
+
+	content, err := os.ReadFile(o.file)
+	if err != nil {
+		return nil, fmt.Errorf("reading variables file: %w", err)
+	}
+	return content, nil
+}
+
+// parse returns the variables to import, and the variables without a value
+// that are skipped: the value of hidden variables isn't exported, and masked
+// variables can't be empty.
+func (o *options) parse(content []byte) ([]*variableutils.Variable, []*variableutils.Variable, error) {
+	format := o.format
+	if format == "" {
+		format = detectFormat(content)
+	}
+
+	var variables []*variableutils.Variable
+	if format == "json" {
+		if err := json.Unmarshal(content, &variables); err != nil {
+			return nil, nil, fmt.Errorf("parsing JSON variables: %w", err)
+		}
+	} else {
+		pairs, err := parseEnv(content, format == "export")
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, pair := range pairs {
+			variables = append(variables, &variableutils.Variable{
+				Key:              pair[0],
+				Value:            pair[1],
+				Protected:        o.protected,
+				Masked:           o.masked,
+				Raw:              o.raw,
+				EnvironmentScope: o.scope,
+			})
+		}
+	}
+
+	seen := map[string]bool{}
+	var valid, skipped []*variableutils.Variable
+	for _, v := range variables {
+		v.SetDefaults()
+		if seen[v.ID()] {
+			return nil, nil, fmt.Errorf("variable %s is defined more than once.", v.ID())
+		}
+		seen[v.ID()] = true
+
+		if (v.Masked || v.Hidden) && v.Value == "" {
+			skipped = append(skipped, v)
+			continue
+		}
+		if err := v.Validate(); err != nil {
+			return nil, nil, err
+		}
+		valid = append(valid, v)
+	}
+
+	return valid, skipped, nil
+}
+
+func detectFormat(content []byte) string {
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("[")) {
+		return "json"
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "export ") {
+			return "export"
+		}
+		break
+	}
+	return "env"
+}
+
+// parseEnv parses KEY=VALUE lines, optionally prefixed with `export`.
+// Empty lines and lines starting with # are ignored. A quoted value can
+// span several lines, like the multi-line values `variable export` writes.
+func parseEnv(content []byte, export bool) ([][2]string, error) {
+	var pairs [][2]string
+
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimSpace(lines[i])
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if export {
+			rest, ok := strings.CutPrefix(line, "export ")
+			if !ok {
+				return nil, fmt.Errorf("line %d: expected `export KEY=VALUE`.", lineNumber)
+			}
+			line = strings.TrimSpace(rest)
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE.", lineNumber)
+		}
+
+		// The value is quoted and its closing quote is on a later line.
+		value = strings.TrimSpace(value)
+		if value != "" && (value[0] == '"' || value[0] == '\'') && !closesQuote(value[1:], value[0]) {
+			quote := value[0]
+			value = value[1:]
+			closed := false
+			for i+1 < len(lines) {
+				i++
+				next := strings.TrimRight(lines[i], " \t")
+				if closesQuote(next, quote) {
+					value += "\n" + next[:len(next)-1]
+					closed = true
+					break
+				}
+				value += "\n" + lines[i]
+			}
+			if !closed {
+				return nil, fmt.Errorf("line %d: missing closing %c for the value of %s.", lineNumber, quote, strings.TrimSpace(key))
+			}
+			pairs = append(pairs, [2]string{strings.TrimSpace(key), value})
+			continue
+		}
+
+		pairs = append(pairs, [2]string{strings.TrimSpace(key), unquote(value)})
+	}
+
+	return pairs, nil
+}
+
+// closesQuote reports whether s ends with quote.
+func closesQuote(s string, quote byte) bool {
+	return s != "" && s[len(s)-1] == quote
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' || first == '\'') && first == last {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
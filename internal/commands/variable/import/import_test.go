@@ -0,0 +1,289 @@
+//go:build !integration
+
+package variableimport
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        options
+		content     string
+		want        []*variableutils.Variable
+		wantSkipped []*variableutils.Variable
+		wantErr     string
+	}{
+		{
+			name: "json export output",
+			content: heredoc.Doc(`
+				[
+				  {"key": "VAR1", "value": "masked-value1", "variable_type": "file", "protected": true, "masked": true, "environment_scope": "production", "description": "desc"},
+				  {"key": "HIDDEN", "value": "", "masked": true, "hidden": true}
+				]
+			`),
+			want: []*variableutils.Variable{
+				{Key: "VAR1", Value: "masked-value1", VariableType: "file", Protected: true, Masked: true, EnvironmentScope: "production", Description: "desc"},
+			},
+			wantSkipped: []*variableutils.Variable{
+				{Key: "HIDDEN", VariableType: "env_var", Masked: true, Hidden: true, EnvironmentScope: "*"},
+			},
+		},
+		{
+			name: "dotenv",
+			opts: options{scope: "staging", protected: true},
+			content: heredoc.Doc(`
+				# comment
+				VAR1="value 1"
+
+				VAR2='value=2'
+				VAR3=value3
+			`),
+			want: []*variableutils.Variable{
+				{Key: "VAR1", Value: "value 1", VariableType: "env_var", Protected: true, EnvironmentScope: "staging"},
+				{Key: "VAR2", Value: "value=2", VariableType: "env_var", Protected: true, EnvironmentScope: "staging"},
+				{Key: "VAR3", Value: "value3", VariableType: "env_var", Protected: true, EnvironmentScope: "staging"},
+			},
+		},
+		{
+			name:    "shell export",
+			opts:    options{scope: "*", masked: true},
+			content: "export VAR1=\"masked-value1\"\nexport VAR2=masked-value2\nexport VAR3=\n",
+			want: []*variableutils.Variable{
+				{Key: "VAR1", Value: "masked-value1", VariableType: "env_var", Masked: true, EnvironmentScope: "*"},
+				{Key: "VAR2", Value: "masked-value2", VariableType: "env_var", Masked: true, EnvironmentScope: "*"},
+			},
+			wantSkipped: []*variableutils.Variable{
+				{Key: "VAR3", VariableType: "env_var", Masked: true, EnvironmentScope: "*"},
+			},
+		},
+		{
+			name: "multi-line values",
+			opts: options{scope: "*"},
+			content: heredoc.Doc(`
+				CERT="-----BEGIN CERTIFICATE-----
+				MIIB
+				-----END CERTIFICATE-----"
+				SCRIPT='echo one
+				  echo two'
+				VAR3="value3"
+			`),
+			want: []*variableutils.Variable{
+				{Key: "CERT", Value: "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----", VariableType: "env_var", EnvironmentScope: "*"},
+				{Key: "SCRIPT", Value: "echo one\n  echo two", VariableType: "env_var", EnvironmentScope: "*"},
+				{Key: "VAR3", Value: "value3", VariableType: "env_var", EnvironmentScope: "*"},
+			},
+		},
+		{
+			name: "shell export with comments and multi-line value",
+			opts: options{scope: "*"},
+			content: heredoc.Doc(`
+				# Exported from gitlab-org/cli
+
+				export VAR1="line 1
+				line 2"
+				export VAR2="value2"
+			`),
+			want: []*variableutils.Variable{
+				{Key: "VAR1", Value: "line 1\nline 2", VariableType: "env_var", EnvironmentScope: "*"},
+				{Key: "VAR2", Value: "value2", VariableType: "env_var", EnvironmentScope: "*"},
+			},
+		},
+		{
+			name:    "missing closing quote",
+			opts:    options{scope: "*"},
+			content: "VAR1=value1\nVAR2=\"value2\nVAR3=value3\n",
+			wantErr: "line 2: missing closing \" for the value of VAR2.",
+		},
+		{
+			name:    "export format without export keyword",
+			opts:    options{format: "export", scope: "*"},
+			content: "export VAR1=value1\nVAR2=value2\n",
+			wantErr: "line 2: expected `export KEY=VALUE`.",
+		},
+		{
+			name:    "invalid line",
+			opts:    options{scope: "*"},
+			content: "VAR1\n",
+			wantErr: "line 1: expected KEY=VALUE.",
+		},
+		{
+			name:    "invalid key",
+			opts:    options{scope: "*"},
+			content: "VAR-1=value\n",
+			wantErr: `invalid key "VAR-1". ` + variableutils.ValidKeyMsg,
+		},
+		{
+			name:    "invalid variable type",
+			content: `[{"key": "VAR1", "value": "a", "variable_type": "secret"}]`,
+			wantErr: "invalid type \"secret\" for variable VAR1. Must be one of `env_var` or `file`.",
+		},
+		{
+			name:    "invalid environment scope",
+			opts:    options{scope: "prod|staging"},
+			content: "VAR1=a\n",
+			wantErr: `invalid environment scope "prod|staging" for variable VAR1. Only letters, digits, spaces, and the characters _/${}.*- are allowed.`,
+		},
+		{
+			name:    "value that can't be masked",
+			opts:    options{scope: "*", masked: true},
+			content: "VAR1=short\n",
+			wantErr: "the value of variable VAR1 (scope: *) can't be masked. A masked value must be a single line of at least 8 characters, without spaces.",
+		},
+		{
+			name:    "hidden variable that is not masked",
+			content: `[{"key": "VAR1", "value": "hidden-value", "hidden": true}]`,
+			wantErr: "variable VAR1 (scope: *) is hidden but not masked. Hidden variables must be masked.",
+		},
+		{
+			name:    "duplicate key",
+			opts:    options{scope: "*"},
+			content: "VAR1=a\nVAR1=b\n",
+			wantErr: "variable VAR1 (scope: *) is defined more than once.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, skipped, err := tt.opts.parse([]byte(tt.content))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantSkipped, skipped)
+		})
+	}
+}
+
+func Test_importRun(t *testing.T) {
+	existing := []*gitlab.ProjectVariable{
+		{Key: "EXISTING", Value: "old", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+	}
+	input := "EXISTING=new\nNEW=value\n"
+
+	tests := []struct {
+		name       string
+		cli        string
+		input      string
+		setup      func(tc *gitlabtesting.TestClient)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "fails on conflict by default",
+			cli:  "",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return(existing, &gitlab.Response{}, nil)
+			},
+			wantErr: "variables already exist in project owner/repo: EXISTING (scope: *). Use --on-conflict to skip or overwrite them.",
+		},
+		{
+			name: "skips existing variables",
+			cli:  "--on-conflict skip",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return(existing, &gitlab.Response{}, nil)
+				tc.MockProjectVariables.EXPECT().CreateVariable("owner/repo", gomock.Any()).Return(&gitlab.ProjectVariable{}, nil, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				! Skipped existing variable EXISTING (scope: *).
+				✓ Created variable NEW (scope: *).
+				Imported variables into project owner/repo: 1 created, 0 updated, 1 skipped.
+			`),
+		},
+		{
+			name: "overwrites existing variables",
+			cli:  "--on-conflict overwrite",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return(existing, &gitlab.Response{}, nil)
+				tc.MockProjectVariables.EXPECT().UpdateVariable("owner/repo", "EXISTING", gomock.Any()).Return(&gitlab.ProjectVariable{}, nil, nil)
+				tc.MockProjectVariables.EXPECT().CreateVariable("owner/repo", gomock.Any()).Return(&gitlab.ProjectVariable{}, nil, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				✓ Updated variable EXISTING (scope: *).
+				✓ Created variable NEW (scope: *).
+				Imported variables into project owner/repo: 1 created, 1 updated, 0 skipped.
+			`),
+		},
+		{
+			name:  "reports variables without a value",
+			input: `[{"key": "HIDDEN", "value": "", "masked": true, "hidden": true}, {"key": "NEW", "value": "value"}]`,
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return(existing, &gitlab.Response{}, nil)
+				tc.MockProjectVariables.EXPECT().CreateVariable("owner/repo", gomock.Any()).Return(&gitlab.ProjectVariable{}, nil, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				! Skipped variable HIDDEN (scope: *): masked and hidden variables need a value.
+				✓ Created variable NEW (scope: *).
+				Imported variables into project owner/repo: 1 created, 0 updated, 1 skipped.
+			`),
+		},
+		{
+			name:    "validates all variables before any request",
+			cli:     "--masked",
+			input:   "NEW=long-enough-value\nSHORT=value\n",
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "the value of variable SHORT (scope: *) can't be masked. A masked value must be a single line of at least 8 characters, without spaces.",
+		},
+		{
+			name: "dry run into a group",
+			cli:  "--group mygroup --on-conflict overwrite --dry-run",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockGroupVariables.EXPECT().ListVariables("mygroup", gomock.Any(), gomock.Any()).Return(nil, &gitlab.Response{}, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				[dry run] ✓ Created variable EXISTING (scope: *).
+				[dry run] ✓ Created variable NEW (scope: *).
+				[dry run] Imported variables into group mygroup: 2 created, 0 updated, 0 skipped.
+			`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tt.setup(tc)
+
+			stdin := input
+			if tt.input != "" {
+				stdin = tt.input
+			}
+
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				func(f cmdutils.Factory) *cobra.Command {
+					return NewCmdImport(f, nil)
+				},
+				false,
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "testtoken", "gitlab.example.com", api.WithGitLabClient(tc.Client))),
+				cmdtest.WithBaseRepo("owner", "repo", glinstance.DefaultHostname),
+				cmdtest.WithStdin(stdin),
+			)
+
+			out, err := exec(tt.cli)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, out.OutBuf.String())
+		})
+	}
+}
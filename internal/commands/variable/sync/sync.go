@@ -0,0 +1,386 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	file     string
+	group    string
+	yes      bool
+	dryRun   bool
+	noDelete bool
+}
+
+// fileVariable is an entry of the variables file. The value can be set
+// inline, or read from a file relative to the variables file.
+type fileVariable struct {
+	variableutils.Variable `yaml:",inline"`
+	File                   string `yaml:"file,omitempty"`
+}
+
+type change struct {
+	variable *variableutils.Variable
+	fields   []string
+}
+
+type plan struct {
+	create []change
+	update []change
+	delete []change
+}
+
+func (p *plan) empty() bool {
+	return len(p.create) == 0 && len(p.update) == 0 && len(p.delete) == 0
+}
+
+func NewCmdSync(f cmdutils.Factory, runE func(opts *options) error) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync variables of a project or group with a file.",
+		Long: heredoc.Docf(`
+			Sync the variables of a project or group with the variables defined in a file.
+
+			The file is a YAML or JSON list of variables. The output of
+			%[1]sglab variable export --output json%[1]s is a valid input. Each variable
+			supports the fields %[1]skey%[1]s, %[1]svalue%[1]s, %[1]svariable_type%[1]s,
+			%[1]senvironment_scope%[1]s, %[1]sprotected%[1]s, %[1]smasked%[1]s, %[1]shidden%[1]s,
+			%[1]sraw%[1]s, and %[1]sdescription%[1]s. Instead of %[1]svalue%[1]s, set %[1]sfile%[1]s
+			to read the value from a file, relative to the variables file.
+
+			Variables are identified by their key and environment scope. The command
+			prints the variables to create, update, and delete, and applies the changes
+			after confirmation. Variables that are not in the file are deleted, unless
+			%[1]s--no-delete%[1]s is set. The visibility of a variable can't be updated:
+			variables that become hidden or visible are deleted and created again, so
+			they need a value in the file. The value of hidden variables can't be read:
+			hidden variables without a value in the file keep their current value.
+		`, "`"),
+		Args: cobra.ExactArgs(0),
+		Example: heredoc.Doc(`
+			$ glab variable sync -f vars.yaml
+			$ glab variable sync -f vars.yaml --dry-run
+			$ glab variable sync -f vars.yaml --group gitlab-org --yes
+
+			# Copy the variables of a project to another project
+			$ glab variable export -R group/source > vars.json
+			$ glab variable sync -R group/target -f vars.json
+
+			# Example vars.yaml
+			- key: DEPLOY_URL
+			  value: https://staging.example.com
+			  environment_scope: staging
+			- key: KUBECONFIG
+			  file: secrets/kubeconfig
+			  variable_type: file
+			  protected: true
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(cmd); err != nil {
+				return err
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			if runE != nil {
+				return runE(opts)
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+	cmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repository argument is set.")
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.file, "file", "f", "", "Path to the file with the variables.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Apply the changes without asking for confirmation.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Print the changes without applying them.")
+	fl.BoolVar(&opts.noDelete, "no-delete", false, "Do not delete variables that are not in the file.")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	group, err := cmdutils.GroupOverride(cmd)
+	if err != nil {
+		return err
+	}
+	o.group = group
+
+	return nil
+}
+
+func (o *options) validate() error {
+	if !o.yes && !o.dryRun && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	c := o.io.Color()
+
+	desired, err := readVariablesFile(o.file)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: this command can not only be used for projects,
+	// so we have to manually check for the base repo, it it doesn't exist,
+	// we bootstrap the client with the default hostname.
+	var repoHost string
+	if baseRepo, err := o.baseRepo(); err == nil {
+		repoHost = baseRepo.RepoHost()
+	}
+	apiClient, err := o.apiClient(repoHost)
+	if err != nil {
+		return err
+	}
+	client := apiClient.Lab()
+
+	var project, target string
+	if o.group != "" {
+		target = "group " + o.group
+	} else {
+		repo, err := o.baseRepo()
+		if err != nil {
+			return err
+		}
+		project = repo.FullName()
+		target = "project " + project
+	}
+
+	current, err := variableutils.ListVariables(client, project, o.group)
+	if err != nil {
+		return err
+	}
+
+	p := computePlan(current, desired, !o.noDelete)
+	for _, ch := range p.update {
+		if slices.Contains(ch.fields, "hidden") && ch.variable.Value == "" {
+			return fmt.Errorf("variable %s has no value in the file. Variables that become hidden or visible are deleted and created again, so they need a value.", ch.variable.ID())
+		}
+	}
+	if p.empty() {
+		fmt.Fprintf(o.io.StdOut, "%s Variables of %s are up to date.\n", c.GreenCheck(), target)
+		return nil
+	}
+
+	fmt.Fprintf(o.io.StdOut, "Changes to the variables of %s:\n\n", target)
+	printPlan(o.io, p)
+
+	if o.dryRun {
+		return nil
+	}
+
+	if !o.yes {
+		err = o.io.Confirm(ctx, &o.yes, "Apply these changes?")
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.yes {
+		return cmdutils.CancelError()
+	}
+
+	for _, ch := range p.create {
+		if err := variableutils.CreateVariable(client, project, o.group, ch.variable); err != nil {
+			return fmt.Errorf("creating variable %s: %w", ch.variable.ID(), err)
+		}
+	}
+	for _, ch := range p.update {
+		if err := o.updateVariable(client, project, ch); err != nil {
+			return fmt.Errorf("updating variable %s: %w", ch.variable.ID(), err)
+		}
+	}
+	for _, ch := range p.delete {
+		if err := variableutils.DeleteVariable(client, project, o.group, ch.variable); err != nil {
+			return fmt.Errorf("deleting variable %s: %w", ch.variable.ID(), err)
+		}
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Synced variables of %s: %d created, %d updated, %d deleted.\n",
+		c.GreenCheck(), target, len(p.create), len(p.update), len(p.delete))
+	return nil
+}
+
+// updateVariable applies a change to an existing variable. The visibility of a
+// variable can't be updated, so a variable that becomes hidden or visible is
+// deleted and created again.
+func (o *options) updateVariable(client *gitlab.Client, project string, ch change) error {
+	if !slices.Contains(ch.fields, "hidden") {
+		return variableutils.UpdateVariable(client, project, o.group, ch.variable)
+	}
+	if err := variableutils.DeleteVariable(client, project, o.group, ch.variable); err != nil {
+		return err
+	}
+	return variableutils.CreateVariable(client, project, o.group, ch.variable)
+}
+
+func readVariablesFile(path string) ([]*variableutils.Variable, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading variables file: %w", err)
+	}
+
+	var entries []*fileVariable
+	if err := yaml.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("parsing variables file %s: %w", path, err)
+	}
+
+	seen := map[string]bool{}
+	variables := make([]*variableutils.Variable, 0, len(entries))
+	for _, entry := range entries {
+		v := entry.Variable
+		if entry.File != "" {
+			if v.Value != "" {
+				return nil, fmt.Errorf("variable %s: set either value or file, not both.", v.Key)
+			}
+			valuePath := entry.File
+			if !filepath.IsAbs(valuePath) {
+				valuePath = filepath.Join(filepath.Dir(path), valuePath)
+			}
+			value, err := os.ReadFile(valuePath)
+			if err != nil {
+				return nil, fmt.Errorf("variable %s: %w", v.Key, err)
+			}
+			v.Value = string(value)
+		}
+
+		v.SetDefaults()
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[v.ID()] {
+			return nil, fmt.Errorf("variable %s is defined more than once.", v.ID())
+		}
+		seen[v.ID()] = true
+
+		variables = append(variables, &v)
+	}
+
+	return variables, nil
+}
+
+// computePlan returns the changes required to turn the current variables
+// into the desired ones.
+func computePlan(current, desired []*variableutils.Variable, deleteMissing bool) *plan {
+	p := &plan{}
+
+	existing := make(map[string]*variableutils.Variable, len(current))
+	for _, v := range current {
+		existing[v.ID()] = v
+	}
+
+	for _, v := range desired {
+		cur, ok := existing[v.ID()]
+		if !ok {
+			p.create = append(p.create, change{variable: v})
+			continue
+		}
+		delete(existing, v.ID())
+
+		if fields := changedFields(cur, v); len(fields) > 0 {
+			p.update = append(p.update, change{variable: v, fields: fields})
+		}
+	}
+
+	if deleteMissing {
+		for _, v := range current {
+			if _, ok := existing[v.ID()]; ok {
+				p.delete = append(p.delete, change{variable: v})
+			}
+		}
+	}
+
+	for _, changes := range [][]change{p.create, p.update, p.delete} {
+		sort.SliceStable(changes, func(i, j int) bool {
+			return changes[i].variable.ID() < changes[j].variable.ID()
+		})
+	}
+
+	return p
+}
+
+func changedFields(cur, want *variableutils.Variable) []string {
+	var fields []string
+	// The API doesn't return the value of hidden variables.
+	if !cur.Hidden && cur.Value != want.Value {
+		fields = append(fields, "value")
+	}
+	if cur.VariableType != want.VariableType {
+		fields = append(fields, "variable_type")
+	}
+	if cur.Protected != want.Protected {
+		fields = append(fields, "protected")
+	}
+	if cur.Masked != want.Masked {
+		fields = append(fields, "masked")
+	}
+	if cur.Hidden != want.Hidden {
+		fields = append(fields, "hidden")
+	}
+	if cur.Raw != want.Raw {
+		fields = append(fields, "raw")
+	}
+	if cur.Description != want.Description {
+		fields = append(fields, "description")
+	}
+	return fields
+}
+
+func printPlan(ios *iostreams.IOStreams, p *plan) {
+	c := ios.Color()
+
+	for _, ch := range p.create {
+		fmt.Fprintf(ios.StdOut, "  %s %s\n", c.Green("+"), ch.variable.ID())
+	}
+	for _, ch := range p.update {
+		fmt.Fprintf(ios.StdOut, "  %s %s: %s\n", c.Yellow("~"), ch.variable.ID(), strings.Join(ch.fields, ", "))
+	}
+	for _, ch := range p.delete {
+		fmt.Fprintf(ios.StdOut, "  %s %s\n", c.Red("-"), ch.variable.ID())
+	}
+
+	fmt.Fprintf(ios.StdOut, "\n%d to create, %d to update, %d to delete.\n", len(p.create), len(p.update), len(p.delete))
+}
@@ -0,0 +1,361 @@
+//go:build !integration
+
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/variable/variableutils"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func Test_readVariablesFile(t *testing.T) {
+	t.Run("export output round-trips", func(t *testing.T) {
+		path := writeFile(t, t.TempDir(), "vars.json", heredoc.Doc(`
+			[
+			  {
+			    "key": "VAR1",
+			    "value": "value1",
+			    "variable_type": "env_var",
+			    "protected": true,
+			    "masked": false,
+			    "hidden": false,
+			    "raw": false,
+			    "environment_scope": "*",
+			    "description": "The first variable"
+			  }
+			]
+		`))
+
+		variables, err := readVariablesFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []*variableutils.Variable{{
+			Key:              "VAR1",
+			Value:            "value1",
+			VariableType:     "env_var",
+			Protected:        true,
+			EnvironmentScope: "*",
+			Description:      "The first variable",
+		}}, variables)
+	})
+
+	t.Run("yaml with file reference and defaults", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, "kubeconfig", "apiVersion: v1\n")
+		path := writeFile(t, dir, "vars.yaml", heredoc.Doc(`
+			- key: KUBECONFIG
+			  file: kubeconfig
+			  variable_type: file
+			- key: DEPLOY_URL
+			  value: https://staging.example.com
+			  environment_scope: staging
+		`))
+
+		variables, err := readVariablesFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, []*variableutils.Variable{
+			{Key: "KUBECONFIG", Value: "apiVersion: v1\n", VariableType: "file", EnvironmentScope: "*"},
+			{Key: "DEPLOY_URL", Value: "https://staging.example.com", VariableType: "env_var", EnvironmentScope: "staging"},
+		}, variables)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "invalid key",
+			content: "- key: IN-VALID\n  value: x\n",
+			wantErr: `invalid key "IN-VALID". ` + variableutils.ValidKeyMsg,
+		},
+		{
+			name:    "duplicate variable",
+			content: "- key: A\n  value: x\n- key: A\n  value: y\n  environment_scope: '*'\n",
+			wantErr: "variable A (scope: *) is defined more than once.",
+		},
+		{
+			name:    "value and file",
+			content: "- key: A\n  value: x\n  file: a.txt\n",
+			wantErr: "variable A: set either value or file, not both.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFile(t, t.TempDir(), "vars.yaml", tt.content)
+
+			_, err := readVariablesFile(path)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_computePlan(t *testing.T) {
+	current := []*variableutils.Variable{
+		{Key: "UNCHANGED", Value: "a", VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "CHANGED", Value: "a", VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "CHANGED", Value: "a", VariableType: "env_var", EnvironmentScope: "production"},
+		{Key: "HIDDEN", Hidden: true, Masked: true, VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "TO_HIDE", Value: "a", Masked: true, VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "REMOVED", Value: "a", VariableType: "env_var", EnvironmentScope: "*"},
+	}
+	desired := []*variableutils.Variable{
+		{Key: "UNCHANGED", Value: "a", VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "CHANGED", Value: "b", VariableType: "env_var", EnvironmentScope: "*", Protected: true},
+		{Key: "CHANGED", Value: "a", VariableType: "env_var", EnvironmentScope: "production"},
+		{Key: "HIDDEN", Value: "secret", Hidden: true, Masked: true, VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "TO_HIDE", Value: "a", Hidden: true, Masked: true, VariableType: "env_var", EnvironmentScope: "*"},
+		{Key: "NEW", Value: "a", VariableType: "file", EnvironmentScope: "*"},
+	}
+
+	p := computePlan(current, desired, true)
+	require.Len(t, p.create, 1)
+	assert.Equal(t, "NEW", p.create[0].variable.Key)
+	require.Len(t, p.update, 2)
+	assert.Equal(t, "CHANGED (scope: *)", p.update[0].variable.ID())
+	assert.Equal(t, []string{"value", "protected"}, p.update[0].fields)
+	assert.Equal(t, "TO_HIDE (scope: *)", p.update[1].variable.ID())
+	assert.Equal(t, []string{"hidden"}, p.update[1].fields)
+	require.Len(t, p.delete, 1)
+	assert.Equal(t, "REMOVED", p.delete[0].variable.Key)
+
+	p = computePlan(current, desired, false)
+	assert.Empty(t, p.delete)
+}
+
+func Test_syncRun(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "vars.yaml", heredoc.Doc(`
+		- key: KEEP
+		  value: new
+		- key: NEW
+		  value: value
+		  protected: true
+	`))
+
+	tests := []struct {
+		name       string
+		cli        string
+		setup      func(tc *gitlabtesting.TestClient)
+		wantStdout string
+	}{
+		{
+			name: "project with --yes",
+			cli:  "--yes",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+					{Key: "KEEP", Value: "old", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+					{Key: "OLD", Value: "old", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+				}, &gitlab.Response{}, nil)
+				tc.MockProjectVariables.EXPECT().CreateVariable("owner/repo", &gitlab.CreateProjectVariableOptions{
+					Key:              gitlab.Ptr("NEW"),
+					Value:            gitlab.Ptr("value"),
+					VariableType:     gitlab.Ptr(gitlab.EnvVariableType),
+					Protected:        gitlab.Ptr(true),
+					Masked:           gitlab.Ptr(false),
+					MaskedAndHidden:  gitlab.Ptr(false),
+					Raw:              gitlab.Ptr(false),
+					EnvironmentScope: gitlab.Ptr("*"),
+					Description:      gitlab.Ptr(""),
+				}).Return(&gitlab.ProjectVariable{}, nil, nil)
+				tc.MockProjectVariables.EXPECT().UpdateVariable("owner/repo", "KEEP", gomock.Any()).Return(&gitlab.ProjectVariable{}, nil, nil)
+				tc.MockProjectVariables.EXPECT().RemoveVariable("owner/repo", "OLD", &gitlab.RemoveProjectVariableOptions{
+					Filter: &gitlab.VariableFilter{EnvironmentScope: "*"},
+				}).Return(nil, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				Changes to the variables of project owner/repo:
+
+				  + NEW (scope: *)
+				  ~ KEEP (scope: *): value
+				  - OLD (scope: *)
+
+				1 to create, 1 to update, 1 to delete.
+				✓ Synced variables of project owner/repo: 1 created, 1 updated, 1 deleted.
+			`),
+		},
+		{
+			name: "hidden variable is created again",
+			cli:  "--yes --no-delete",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+					{Key: "KEEP", Value: "new", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*", Hidden: true, Masked: true},
+					{Key: "NEW", Value: "value", Protected: true, VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+				}, &gitlab.Response{}, nil)
+				gomock.InOrder(
+					tc.MockProjectVariables.EXPECT().RemoveVariable("owner/repo", "KEEP", &gitlab.RemoveProjectVariableOptions{
+						Filter: &gitlab.VariableFilter{EnvironmentScope: "*"},
+					}).Return(nil, nil),
+					tc.MockProjectVariables.EXPECT().CreateVariable("owner/repo", &gitlab.CreateProjectVariableOptions{
+						Key:              gitlab.Ptr("KEEP"),
+						Value:            gitlab.Ptr("new"),
+						VariableType:     gitlab.Ptr(gitlab.EnvVariableType),
+						Protected:        gitlab.Ptr(false),
+						Masked:           gitlab.Ptr(false),
+						MaskedAndHidden:  gitlab.Ptr(false),
+						Raw:              gitlab.Ptr(false),
+						EnvironmentScope: gitlab.Ptr("*"),
+						Description:      gitlab.Ptr(""),
+					}).Return(&gitlab.ProjectVariable{}, nil, nil),
+				)
+			},
+			wantStdout: heredoc.Doc(`
+				Changes to the variables of project owner/repo:
+
+				  ~ KEEP (scope: *): masked, hidden
+
+				0 to create, 1 to update, 0 to delete.
+				✓ Synced variables of project owner/repo: 0 created, 1 updated, 0 deleted.
+			`),
+		},
+		{
+			name: "group with --dry-run",
+			cli:  "--group mygroup --dry-run --no-delete",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockGroupVariables.EXPECT().ListVariables("mygroup", gomock.Any(), gomock.Any()).Return([]*gitlab.GroupVariable{
+					{Key: "KEEP", Value: "new", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+					{Key: "OLD", Value: "old", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+				}, &gitlab.Response{}, nil)
+			},
+			wantStdout: heredoc.Doc(`
+				Changes to the variables of group mygroup:
+
+				  + NEW (scope: *)
+
+				1 to create, 0 to update, 0 to delete.
+			`),
+		},
+		{
+			name: "up to date",
+			cli:  "--yes --no-delete",
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+					{Key: "KEEP", Value: "new", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+					{Key: "NEW", Value: "value", Protected: true, VariableType: gitlab.EnvVariableType, EnvironmentScope: "*"},
+				}, &gitlab.Response{}, nil)
+			},
+			wantStdout: "✓ Variables of project owner/repo are up to date.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tt.setup(tc)
+
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				func(f cmdutils.Factory) *cobra.Command {
+					return NewCmdSync(f, nil)
+				},
+				false,
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "testtoken", "gitlab.example.com", api.WithGitLabClient(tc.Client))),
+				cmdtest.WithBaseRepo("owner", "repo", glinstance.DefaultHostname),
+			)
+
+			out, err := exec(tt.cli + " -f " + path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, out.OutBuf.String())
+		})
+	}
+}
+
+func Test_syncHiddenWithoutValue(t *testing.T) {
+	current := []*gitlab.ProjectVariable{
+		{Key: "SECRET", VariableType: gitlab.EnvVariableType, EnvironmentScope: "*", Hidden: true, Masked: true, Description: "old"},
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		setup   func(tc *gitlabtesting.TestClient)
+		wantErr string
+	}{
+		{
+			name: "update keeps the value",
+			file: heredoc.Doc(`
+				- key: SECRET
+				  value: ""
+				  hidden: true
+				  masked: true
+				  description: new
+			`),
+			setup: func(tc *gitlabtesting.TestClient) {
+				tc.MockProjectVariables.EXPECT().UpdateVariable("owner/repo", "SECRET", &gitlab.UpdateProjectVariableOptions{
+					VariableType:     gitlab.Ptr(gitlab.EnvVariableType),
+					Protected:        gitlab.Ptr(false),
+					Masked:           gitlab.Ptr(true),
+					Raw:              gitlab.Ptr(false),
+					EnvironmentScope: gitlab.Ptr("*"),
+					Filter:           &gitlab.VariableFilter{EnvironmentScope: "*"},
+					Description:      gitlab.Ptr("new"),
+				}).Return(&gitlab.ProjectVariable{}, nil, nil)
+			},
+		},
+		{
+			name: "visibility change is refused",
+			file: heredoc.Doc(`
+				- key: SECRET
+				  value: ""
+				  description: old
+			`),
+			setup:   func(tc *gitlabtesting.TestClient) {},
+			wantErr: "variable SECRET (scope: *) has no value in the file. Variables that become hidden or visible are deleted and created again, so they need a value.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			tc.MockProjectVariables.EXPECT().ListVariables("owner/repo", gomock.Any(), gomock.Any()).Return(current, &gitlab.Response{}, nil)
+			tt.setup(tc)
+
+			exec := cmdtest.SetupCmdForTest(
+				t,
+				func(f cmdutils.Factory) *cobra.Command {
+					return NewCmdSync(f, nil)
+				},
+				false,
+				cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "testtoken", "gitlab.example.com", api.WithGitLabClient(tc.Client))),
+				cmdtest.WithBaseRepo("owner", "repo", glinstance.DefaultHostname),
+			)
+
+			_, err := exec("--yes -f " + writeFile(t, t.TempDir(), "vars.yaml", tt.file))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_syncRequiresYesWhenNotInteractive(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdSync(f, nil)
+	}, false)
+
+	_, err := exec("-f vars.yaml")
+	assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+}
@@ -0,0 +1,202 @@
+package variableutils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Variable is a project or group CI/CD variable. It uses the same schema
+// as the JSON output of `glab variable export`.
+type Variable struct {
+	Key              string `json:"key" yaml:"key"`
+	Value            string `json:"value" yaml:"value"`
+	VariableType     string `json:"variable_type,omitempty" yaml:"variable_type,omitempty"`
+	Protected        bool   `json:"protected" yaml:"protected"`
+	Masked           bool   `json:"masked" yaml:"masked"`
+	Hidden           bool   `json:"hidden" yaml:"hidden"`
+	Raw              bool   `json:"raw" yaml:"raw"`
+	EnvironmentScope string `json:"environment_scope,omitempty" yaml:"environment_scope,omitempty"`
+	Description      string `json:"description" yaml:"description"`
+}
+
+// ID identifies a variable: the same key can be defined once per environment scope.
+func (v *Variable) ID() string {
+	return v.Key + " (scope: " + v.EnvironmentScope + ")"
+}
+
+// SetDefaults sets the values the API would use for omitted fields.
+func (v *Variable) SetDefaults() {
+	if v.VariableType == "" {
+		v.VariableType = string(gitlab.EnvVariableType)
+	}
+	if v.EnvironmentScope == "" {
+		v.EnvironmentScope = "*"
+	}
+}
+
+// scopeRE matches the environment scopes GitLab accepts: environment names
+// with wildcards.
+var scopeRE = regexp.MustCompile(`^[a-zA-Z0-9_/${}. *-]{1,255}$`)
+
+// Validate checks the key, the variable type, the environment scope, and that
+// masked values can be masked. The API doesn't return the value of hidden
+// variables, so hidden variables without a value are valid.
+func (v *Variable) Validate() error {
+	if !IsValidKey(v.Key) {
+		return fmt.Errorf("invalid key %q. %s", v.Key, ValidKeyMsg)
+	}
+	if v.VariableType != string(gitlab.EnvVariableType) && v.VariableType != string(gitlab.FileVariableType) {
+		return fmt.Errorf("invalid type %q for variable %s. Must be one of `env_var` or `file`.", v.VariableType, v.Key)
+	}
+	if !scopeRE.MatchString(v.EnvironmentScope) {
+		return fmt.Errorf("invalid environment scope %q for variable %s. Only letters, digits, spaces, and the characters _/${}.*- are allowed.", v.EnvironmentScope, v.Key)
+	}
+	if v.Hidden && !v.Masked {
+		return fmt.Errorf("variable %s is hidden but not masked. Hidden variables must be masked.", v.ID())
+	}
+	if v.Masked && !(v.Hidden && v.Value == "") && !isMaskable(v.Value) {
+		return fmt.Errorf("the value of variable %s can't be masked. A masked value must be a single line of at least 8 characters, without spaces.", v.ID())
+	}
+	return nil
+}
+
+func isMaskable(value string) bool {
+	return len(value) >= 8 && !strings.ContainsAny(value, " \t\r\n")
+}
+
+// ListVariables returns all variables of the group, if group is set,
+// or of the project otherwise.
+func ListVariables(client *gitlab.Client, project, group string) ([]*Variable, error) {
+	var variables []*Variable
+
+	if group != "" {
+		opts := &gitlab.ListGroupVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+		groupVariables, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+			return client.GroupVariables.ListVariables(group, opts, p)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range groupVariables {
+			variables = append(variables, &Variable{
+				Key:              v.Key,
+				Value:            v.Value,
+				VariableType:     string(v.VariableType),
+				Protected:        v.Protected,
+				Masked:           v.Masked,
+				Hidden:           v.Hidden,
+				Raw:              v.Raw,
+				EnvironmentScope: v.EnvironmentScope,
+				Description:      v.Description,
+			})
+		}
+		return variables, nil
+	}
+
+	opts := &gitlab.ListProjectVariablesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	projectVariables, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+		return client.ProjectVariables.ListVariables(project, opts, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range projectVariables {
+		variables = append(variables, &Variable{
+			Key:              v.Key,
+			Value:            v.Value,
+			VariableType:     string(v.VariableType),
+			Protected:        v.Protected,
+			Masked:           v.Masked,
+			Hidden:           v.Hidden,
+			Raw:              v.Raw,
+			EnvironmentScope: v.EnvironmentScope,
+			Description:      v.Description,
+		})
+	}
+	return variables, nil
+}
+
+// CreateVariable creates v in the group, if group is set, or in the project otherwise.
+func CreateVariable(client *gitlab.Client, project, group string, v *Variable) error {
+	if group != "" {
+		_, _, err := client.GroupVariables.CreateVariable(group, &gitlab.CreateGroupVariableOptions{
+			Key:              gitlab.Ptr(v.Key),
+			Value:            gitlab.Ptr(v.Value),
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.VariableType)),
+			Protected:        gitlab.Ptr(v.Protected),
+			Masked:           gitlab.Ptr(v.Masked),
+			MaskedAndHidden:  gitlab.Ptr(v.Hidden),
+			Raw:              gitlab.Ptr(v.Raw),
+			EnvironmentScope: gitlab.Ptr(v.EnvironmentScope),
+			Description:      gitlab.Ptr(v.Description),
+		})
+		return err
+	}
+
+	_, _, err := client.ProjectVariables.CreateVariable(project, &gitlab.CreateProjectVariableOptions{
+		Key:              gitlab.Ptr(v.Key),
+		Value:            gitlab.Ptr(v.Value),
+		VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.VariableType)),
+		Protected:        gitlab.Ptr(v.Protected),
+		Masked:           gitlab.Ptr(v.Masked),
+		MaskedAndHidden:  gitlab.Ptr(v.Hidden),
+		Raw:              gitlab.Ptr(v.Raw),
+		EnvironmentScope: gitlab.Ptr(v.EnvironmentScope),
+		Description:      gitlab.Ptr(v.Description),
+	})
+	return err
+}
+
+// UpdateVariable updates v in the group, if group is set, or in the project otherwise.
+// The value of hidden variables can't be read, so hidden variables without a
+// value, like in the output of 'glab variable export', keep their value.
+func UpdateVariable(client *gitlab.Client, project, group string, v *Variable) error {
+	var value *string
+	if !v.Hidden || v.Value != "" {
+		value = gitlab.Ptr(v.Value)
+	}
+
+	if group != "" {
+		_, _, err := client.GroupVariables.UpdateVariable(group, v.Key, &gitlab.UpdateGroupVariableOptions{
+			Value:            value,
+			VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.VariableType)),
+			Protected:        gitlab.Ptr(v.Protected),
+			Masked:           gitlab.Ptr(v.Masked),
+			Raw:              gitlab.Ptr(v.Raw),
+			EnvironmentScope: gitlab.Ptr(v.EnvironmentScope),
+			Filter:           &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope},
+			Description:      gitlab.Ptr(v.Description),
+		})
+		return err
+	}
+
+	_, _, err := client.ProjectVariables.UpdateVariable(project, v.Key, &gitlab.UpdateProjectVariableOptions{
+		Value:            value,
+		VariableType:     gitlab.Ptr(gitlab.VariableTypeValue(v.VariableType)),
+		Protected:        gitlab.Ptr(v.Protected),
+		Masked:           gitlab.Ptr(v.Masked),
+		Raw:              gitlab.Ptr(v.Raw),
+		EnvironmentScope: gitlab.Ptr(v.EnvironmentScope),
+		Filter:           &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope},
+		Description:      gitlab.Ptr(v.Description),
+	})
+	return err
+}
+
+// DeleteVariable deletes v from the group, if group is set, or from the project otherwise.
+func DeleteVariable(client *gitlab.Client, project, group string, v *Variable) error {
+	if group != "" {
+		_, err := client.GroupVariables.RemoveVariable(group, v.Key, &gitlab.RemoveGroupVariableOptions{
+			Filter: &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope},
+		})
+		return err
+	}
+
+	_, err := client.ProjectVariables.RemoveVariable(project, v.Key, &gitlab.RemoveProjectVariableOptions{
+		Filter: &gitlab.VariableFilter{EnvironmentScope: v.EnvironmentScope},
+	})
+	return err
+}
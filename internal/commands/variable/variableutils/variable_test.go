@@ -0,0 +1,66 @@
+//go:build !integration
+
+package variableutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+func TestVariable_Validate(t *testing.T) {
+	v := &Variable{Key: "KEY"}
+	v.SetDefaults()
+
+	assert.Equal(t, &Variable{Key: "KEY", VariableType: "env_var", EnvironmentScope: "*"}, v)
+	assert.NoError(t, v.Validate())
+
+	v.Masked = true
+	assert.EqualError(t, v.Validate(), "the value of variable KEY (scope: *) can't be masked. A masked value must be a single line of at least 8 characters, without spaces.")
+	v.Value = "a value"
+	assert.Error(t, v.Validate())
+	v.Value = "masked-value"
+	assert.NoError(t, v.Validate())
+
+	v.Hidden = true
+	assert.NoError(t, v.Validate())
+	// The API doesn't return the value of hidden variables.
+	v.Value = ""
+	assert.NoError(t, v.Validate())
+	v.Masked = false
+	assert.EqualError(t, v.Validate(), "variable KEY (scope: *) is hidden but not masked. Hidden variables must be masked.")
+	v.Hidden = false
+
+	v.EnvironmentScope = "review/*"
+	assert.NoError(t, v.Validate())
+	v.EnvironmentScope = "prod|staging"
+	assert.EqualError(t, v.Validate(), "invalid environment scope \"prod|staging\" for variable KEY. Only letters, digits, spaces, and the characters _/${}.*- are allowed.")
+
+	v.VariableType = "secret"
+	assert.EqualError(t, v.Validate(), "invalid type \"secret\" for variable KEY. Must be one of `env_var` or `file`.")
+
+	v.Key = "in valid"
+	assert.EqualError(t, v.Validate(), `invalid key "in valid". `+ValidKeyMsg)
+}
+
+func TestListVariables(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockGroupVariables.EXPECT().ListVariables("group", gomock.Any(), gomock.Any()).Return([]*gitlab.GroupVariable{
+		{Key: "GROUP", Value: "value", VariableType: gitlab.FileVariableType, EnvironmentScope: "*", Protected: true},
+	}, &gitlab.Response{}, nil)
+	tc.MockProjectVariables.EXPECT().ListVariables("group/project", gomock.Any(), gomock.Any()).Return([]*gitlab.ProjectVariable{
+		{Key: "PROJECT", Value: "value", VariableType: gitlab.EnvVariableType, EnvironmentScope: "production", Masked: true},
+	}, &gitlab.Response{}, nil)
+
+	variables, err := ListVariables(tc.Client, "group/project", "group")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Variable{{Key: "GROUP", Value: "value", VariableType: "file", EnvironmentScope: "*", Protected: true}}, variables)
+
+	variables, err = ListVariables(tc.Client, "group/project", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Variable{{Key: "PROJECT", Value: "value", VariableType: "env_var", EnvironmentScope: "production", Masked: true}}, variables)
+}
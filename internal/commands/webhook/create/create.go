@@ -0,0 +1,144 @@
+package create
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/webhook/webhookutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	url             string
+	group           string
+	name            string
+	description     string
+	events          []string
+	branchFilter    string
+	secretToken     string
+	tokenStdin      bool
+	sslVerification bool
+	headers         []string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create <url> [flags]",
+		Short:   `Create a webhook.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Docf(`
+			Create a webhook, which sends events to a URL.
+
+			Without %[1]s--event%[1]s, GitLab sends push events. GitLab sends the secret
+			token in the %[1]sX-Gitlab-Token%[1]s header of the events, and the custom
+			headers set with %[1]s--header%[1]s.
+
+			Events: %[2]s.
+		`, "`", strings.Join(webhookutils.Events, ", ")),
+		Example: heredoc.Doc(`
+			$ glab webhook create https://example.com/hooks/gitlab --event push,merge_requests,pipeline
+
+			# Read the secret token from a variable, instead of the command line
+			$ echo "$WEBHOOK_TOKEN" | glab webhook create https://example.com/hooks/gitlab --stdin --header "X-Environment: staging"
+
+			# Create a webhook for a group
+			$ glab webhook create https://example.com/hooks/gitlab --group my-org --event issues
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.url = args[0]
+			if err := webhookutils.ValidateEvents("event", opts.events, webhookutils.Events); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Create the webhook for a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Name of the webhook.")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the webhook.")
+	cmd.Flags().StringSliceVarP(&opts.events, "event", "e", nil, "Events that trigger the webhook. Can be repeated, or separated by commas.")
+	cmd.Flags().StringVar(&opts.branchFilter, "branch-filter", "", "Only send the push events of branches that match this wildcard pattern.")
+	cmd.Flags().StringVarP(&opts.secretToken, "secret-token", "t", "", "Secret token, sent in the X-Gitlab-Token header of the events.")
+	cmd.Flags().BoolVar(&opts.tokenStdin, "stdin", false, "Read the secret token from standard input.")
+	cmd.Flags().BoolVar(&opts.sslVerification, "ssl-verification", true, "Verify the SSL certificate of the URL.")
+	cmd.Flags().StringArrayVar(&opts.headers, "header", nil, "Custom header to send with the events, in the 'Name: value' format. Can be repeated.")
+	cmd.MarkFlagsMutuallyExclusive("secret-token", "stdin")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	headers, err := webhookutils.ParseHeaders(o.headers)
+	if err != nil {
+		return err
+	}
+
+	if o.tokenStdin {
+		token, err := io.ReadAll(o.io.In)
+		if err != nil {
+			return fmt.Errorf("failed to read the secret token from standard input: %w", err)
+		}
+		o.secretToken = strings.TrimSpace(string(token))
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := webhookutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	opts := &webhookutils.Options{
+		URL:                   gitlab.Ptr(o.url),
+		EnableSSLVerification: gitlab.Ptr(o.sslVerification),
+		Events:                o.events,
+		CustomHeaders:         headers,
+	}
+	if o.name != "" {
+		opts.Name = gitlab.Ptr(o.name)
+	}
+	if o.description != "" {
+		opts.Description = gitlab.Ptr(o.description)
+	}
+	if o.branchFilter != "" {
+		opts.PushEventsBranchFilter = gitlab.Ptr(o.branchFilter)
+	}
+	if o.secretToken != "" {
+		opts.Token = gitlab.Ptr(o.secretToken)
+	}
+
+	hook, err := hooks.Create(opts)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create the webhook for %s.", hooks))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Created webhook %d for %s.\n", o.io.Color().GreenCheck(), hook.ID, hooks)
+	fmt.Fprintf(o.io.StdOut, "Events: %s\n", strings.Join(hook.Events, ", "))
+	return nil
+}
@@ -0,0 +1,88 @@
+//go:build !integration
+
+package create
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string, stdin string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, in, stdout, stderr := cmdtest.TestIOStreams()
+	in.WriteString(stdin)
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdCreate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWebhookCreate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/hooks",
+		`{
+			"url": "https://example.com/hooks/gitlab", "name": "CI bot", "token": "s3cret", "enable_ssl_verification": true,
+			"custom_headers": [{"key": "X-Environment", "value": "staging"}],
+			"push_events": false, "tag_push_events": false, "issues_events": false, "confidential_issues_events": false,
+			"merge_requests_events": true, "note_events": false, "confidential_note_events": false, "job_events": false,
+			"pipeline_events": true, "wiki_page_events": false, "deployment_events": false, "releases_events": false,
+			"resource_access_token_events": false
+		}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 12, "url": "https://example.com/hooks/gitlab", "merge_requests_events": true, "pipeline_events": true}`))
+
+	output, err := runCommand(t, fakeHTTP, `https://example.com/hooks/gitlab --name "CI bot" --event merge_requests -e pipeline --stdin --header "X-Environment: staging"`, "s3cret\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created webhook 12 for OWNER/REPO.\nEvents: merge_requests, pipeline\n", output.String())
+}
+
+func TestWebhookCreate_defaultEvents(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/groups/my-org/hooks",
+		`{"url": "https://example.com/hooks/gitlab", "enable_ssl_verification": false}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 7, "url": "https://example.com/hooks/gitlab", "push_events": true}`))
+
+	output, err := runCommand(t, fakeHTTP, `https://example.com/hooks/gitlab --group my-org --ssl-verification=false`, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created webhook 7 for the group my-org.\nEvents: push\n", output.String())
+}
+
+func TestWebhookCreate_invalidFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "event",
+			cli:     `https://example.com --event pushes`,
+			wantErr: `invalid event "pushes". Use one of: push, tag_push, issues, confidential_issues, merge_requests, note, confidential_note, job, pipeline, wiki_page, deployment, releases, resource_access_token.`,
+		},
+		{
+			name:    "header",
+			cli:     `https://example.com --header X-Environment`,
+			wantErr: `invalid --header "X-Environment". Use the 'Name: value' format.`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runCommand(t, &httpmock.Mocker{}, tc.cli, "")
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/webhook/webhookutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	ids         []int64
+	group       string
+	forceDelete bool
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>... [flags]",
+		Short: `Delete webhooks.`,
+		Example: heredoc.Doc(`
+			$ glab webhook delete 12
+			$ glab webhook delete 12 13 --group my-org --yes
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				id, err := webhookutils.ParseID(arg)
+				if err != nil {
+					return err
+				}
+				opts.ids = append(opts.ids, id)
+			}
+
+			if !opts.forceDelete && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Delete webhooks of a group, instead of the current project.")
+	cmd.Flags().BoolVarP(&opts.forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := webhookutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if !o.forceDelete {
+		err = o.io.Confirm(ctx, &o.forceDelete, fmt.Sprintf("Delete %s of %s?", utils.Pluralize(len(o.ids), "webhook"), hooks))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceDelete {
+		return cmdutils.CancelError()
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, id := range o.ids {
+		if err := hooks.Delete(id); err != nil {
+			failed++
+			if api.Is404(err) {
+				fmt.Fprintf(o.io.StdErr, "%s Webhook %d not found.\n", c.FailedIcon(), id)
+			} else {
+				fmt.Fprintf(o.io.StdErr, "%s Failed to delete webhook %d: %s\n", c.FailedIcon(), id, err)
+			}
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted webhook %d.\n", c.RedCheck(), id)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %s.", utils.Pluralize(failed, "webhook"))
+	}
+
+	return nil
+}
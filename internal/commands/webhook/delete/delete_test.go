@@ -0,0 +1,46 @@
+//go:build !integration
+
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdDelete(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWebhookDelete(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/hooks/12",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/hooks/13",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not Found"}`))
+
+	output, err := runCommand(t, fakeHTTP, `12 13 --yes`)
+	assert.EqualError(t, err, "failed to delete 1 webhook.")
+
+	assert.Equal(t, "✓ Deleted webhook 12.\n", output.String())
+	assert.Equal(t, "x Webhook 13 not found.\n", output.Stderr())
+}
+
+func TestWebhookDelete_invalidID(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `ci-bot --yes`)
+	assert.EqualError(t, err, `invalid webhook ID "ci-bot".`)
+}
@@ -0,0 +1,111 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/webhook/webhookutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List webhooks.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab webhook list
+			$ glab webhook list --group gitlab-org --output json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "List the webhooks of a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := webhookutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	list, err := hooks.List()
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the webhooks of %s.", hooks))
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(list)
+	}
+
+	if len(list) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No webhooks found in %s.\n", hooks)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("ID", "Name", "URL", "Events", "Status")
+	for _, hook := range list {
+		table.AddRow(c.Cyan(strconv.FormatInt(hook.ID, 10)), hook.Name, hook.URL, strings.Join(hook.Events, ", "), status(c, hook))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
+
+// status returns whether GitLab sends events to a webhook. GitLab disables
+// webhooks that fail too often, temporarily and then permanently.
+func status(c *iostreams.ColorPalette, hook *webhookutils.Hook) string {
+	switch hook.AlertStatus {
+	case "temporarily_disabled":
+		return c.Yellow("temporarily disabled")
+	case "disabled":
+		return c.Red("disabled")
+	default:
+		return c.Green("executable")
+	}
+}
@@ -0,0 +1,79 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const hooksJSON = `[
+	{"id": 12, "name": "CI bot", "url": "https://example.com/hooks/gitlab", "push_events": true, "merge_requests_events": true, "alert_status": "executable"},
+	{"id": 13, "name": "", "url": "https://old.example.com/hook", "pipeline_events": true, "alert_status": "disabled"}
+]`
+
+func TestWebhookList(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/hooks",
+		httpmock.NewStringResponse(http.StatusOK, hooksJSON))
+
+	output, err := runCommand(t, fakeHTTP, ``)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ID\tName\tURL\tEvents\tStatus\n"+
+		"12\tCI bot\thttps://example.com/hooks/gitlab\tpush, merge_requests\texecutable\n"+
+		"13\t\thttps://old.example.com/hook\tpipeline\tdisabled\n", output.String())
+}
+
+func TestWebhookList_groupJSON(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/hooks",
+		httpmock.NewStringResponse(http.StatusOK, hooksJSON))
+
+	output, err := runCommand(t, fakeHTTP, `--group my-org --output json`)
+	require.NoError(t, err)
+
+	var hooks []struct {
+		ID     int64    `json:"id"`
+		Events []string `json:"events"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &hooks))
+	require.Len(t, hooks, 2)
+	assert.Equal(t, []string{"push", "merge_requests"}, hooks[0].Events)
+}
+
+func TestWebhookList_empty(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/hooks",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, ``)
+	require.NoError(t, err)
+
+	assert.Equal(t, "No webhooks found in OWNER/REPO.\n", output.Stderr())
+}
@@ -0,0 +1,334 @@
+package listen
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// maxPayloadSize is the size limit of the payloads of events. GitLab sends
+// payloads of up to 25 MB.
+const maxPayloadSize = 25 << 20
+
+type options struct {
+	io         *iostreams.IOStreams
+	httpClient *http.Client
+
+	host         string
+	port         int
+	secretToken  string
+	forwardTo    string
+	outputFormat string
+
+	// mu serializes the output of events received at the same time.
+	mu sync.Mutex
+}
+
+func NewCmdListen(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:         f.IO(),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "listen [flags]",
+		Short: `Receive webhook events locally, to develop webhook consumers.`,
+		Long: heredoc.Docf(`
+			Start a local HTTP server that receives webhook events, and prints them.
+
+			Point a webhook to this server, through a tunnel or from a local GitLab
+			instance, and redeliver its events from the GitLab UI. Or replay recorded
+			events with %[1]scurl%[1]s. With %[1]s--output json%[1]s, each event is printed
+			on one line, with its headers and payload, which records events.
+
+			With %[1]s--secret-token%[1]s, events without this token in their
+			%[1]sX-Gitlab-Token%[1]s header are rejected, like a consumer should.
+
+			With %[1]s--forward-to%[1]s, events are sent to a URL, like a local webhook
+			consumer, with their headers. The response of the consumer is the response
+			to GitLab.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab webhook listen --port 9000 --secret-token "$WEBHOOK_TOKEN"
+
+			# Forward events to a consumer running on port 3000
+			$ glab webhook listen --forward-to http://localhost:3000/hooks/gitlab
+
+			# Record events, and replay the first one
+			$ glab webhook listen --output json > events.jsonl
+			$ head -n 1 events.jsonl | jq .payload | curl http://localhost:8080 -H "X-Gitlab-Event: Push Hook" --json @-
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			if opts.port < 0 || opts.port > 65535 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid port %d.", opts.port)}
+			}
+			if opts.forwardTo != "" {
+				u, err := url.Parse(opts.forwardTo)
+				if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --forward-to URL %q. Use an http or https URL.", opts.forwardTo)}
+				}
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return opts.run(ctx)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.host, "host", "localhost", "Host to listen on. Use 0.0.0.0 to accept events from other machines.")
+	cmd.Flags().IntVarP(&opts.port, "port", "p", 8080, "Port to listen on.")
+	cmd.Flags().StringVarP(&opts.secretToken, "secret-token", "t", "", "Secret token that events must have in their X-Gitlab-Token header.")
+	cmd.Flags().StringVar(&opts.forwardTo, "forward-to", "", "URL to forward the events to.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", net.JoinHostPort(o.host, strconv.Itoa(o.port)))
+	if err != nil {
+		return fmt.Errorf("failed to listen on port %d: %w", o.port, err)
+	}
+
+	fmt.Fprintf(o.io.StdErr, "Listening for webhook events on http://%s. Press Ctrl+C to stop.\n", listener.Addr())
+	if o.forwardTo != "" {
+		fmt.Fprintf(o.io.StdErr, "Forwarding events to %s.\n", o.forwardTo)
+	}
+	if o.secretToken == "" {
+		fmt.Fprintf(o.io.StdErr, "%s Without --secret-token, all events are accepted.\n", o.io.Color().WarnIcon())
+	}
+
+	srv := &http.Server{
+		Handler:           o.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	err = srv.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func (o *options) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "Webhook events must be sent with POST.", http.StatusMethodNotAllowed)
+			return
+		}
+
+		event := r.Header.Get("X-Gitlab-Event")
+		if event == "" {
+			event = "Unknown Hook"
+		}
+
+		c := o.io.Color()
+		if o.secretToken != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), []byte(o.secretToken)) != 1 {
+			o.log("%s %s Rejected a %s event: invalid X-Gitlab-Token header.\n", c.Gray(timestamp()), c.FailedIcon(), event)
+			http.Error(w, "Invalid X-Gitlab-Token header.", http.StatusUnauthorized)
+			return
+		}
+
+		payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+		if err != nil {
+			o.log("%s %s Failed to read a %s event: %s\n", c.Gray(timestamp()), c.FailedIcon(), event, err)
+			http.Error(w, "Failed to read the payload.", http.StatusBadRequest)
+			return
+		}
+
+		o.print(event, r.Header, payload)
+
+		if o.forwardTo == "" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		o.forward(w, r, payload)
+	})
+}
+
+// print prints an event: as a summary line in text, or with its headers and
+// payload in JSON.
+func (o *options) print(event string, header http.Header, payload []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.outputFormat == "json" {
+		headers := map[string]string{}
+		for name := range header {
+			// The secret token is not recorded.
+			if strings.HasPrefix(name, "X-Gitlab-") && name != "X-Gitlab-Token" {
+				headers[name] = header.Get(name)
+			}
+		}
+		record := struct {
+			Event   string            `json:"event"`
+			Headers map[string]string `json:"headers"`
+			Payload any               `json:"payload"`
+		}{Event: event, Headers: headers, Payload: string(payload)}
+		if json.Valid(payload) {
+			record.Payload = json.RawMessage(payload)
+		}
+		_ = json.NewEncoder(o.io.StdOut).Encode(record)
+		return
+	}
+
+	c := o.io.Color()
+	line := c.Gray(timestamp()) + " " + c.Bold(event)
+	if summary := summarize(payload); summary != "" {
+		line += " " + summary
+	}
+	fmt.Fprintln(o.io.StdOut, line)
+}
+
+// forward sends an event to the consumer, and its response to GitLab.
+func (o *options) forward(w http.ResponseWriter, r *http.Request, payload []byte) {
+	c := o.io.Color()
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, o.forwardTo, bytes.NewReader(payload))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.Header = r.Header.Clone()
+	// The client sets these headers for the new connection.
+	for _, name := range []string{"Connection", "Content-Length", "Accept-Encoding"} {
+		req.Header.Del(name)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		o.log("  %s Failed to forward the event: %s\n", c.FailedIcon(), err)
+		http.Error(w, "Failed to forward the event.", http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	status := c.Green(resp.Status)
+	if resp.StatusCode >= 300 {
+		status = c.Red(resp.Status)
+	}
+	o.log("  → %s from %s\n", status, o.forwardTo)
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = io.Copy(w, io.LimitReader(resp.Body, maxPayloadSize))
+}
+
+func (o *options) log(format string, a ...any) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	fmt.Fprintf(o.io.StdErr, format, a...)
+}
+
+func timestamp() string {
+	return time.Now().Format(time.TimeOnly)
+}
+
+// event are the fields of payloads that summarize events.
+type event struct {
+	ObjectKind string `json:"object_kind"`
+	Ref        string `json:"ref"`
+	Username   string `json:"user_username"`
+	User       *struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project *struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes *struct {
+		ID     int64  `json:"id"`
+		IID    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+		Status string `json:"status"`
+		Ref    string `json:"ref"`
+		Note   string `json:"note"`
+	} `json:"object_attributes"`
+}
+
+// summarize returns a summary of the payload of an event, like its project,
+// and what changed.
+func summarize(payload []byte) string {
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return ""
+	}
+
+	var parts []string
+	if e.Project != nil && e.Project.PathWithNamespace != "" {
+		parts = append(parts, e.Project.PathWithNamespace)
+	}
+
+	attrs := e.ObjectAttributes
+	switch {
+	case (e.ObjectKind == "push" || e.ObjectKind == "tag_push") && e.Ref != "":
+		parts = append(parts, e.Ref)
+	case e.ObjectKind == "merge_request" && attrs != nil:
+		parts = append(parts, fmt.Sprintf("!%d", attrs.IID), attrs.Action, strconv.Quote(attrs.Title))
+	case (e.ObjectKind == "issue" || e.ObjectKind == "work_item") && attrs != nil:
+		parts = append(parts, fmt.Sprintf("#%d", attrs.IID), attrs.Action, strconv.Quote(attrs.Title))
+	case e.ObjectKind == "pipeline" && attrs != nil:
+		parts = append(parts, fmt.Sprintf("pipeline %d", attrs.ID), attrs.Status, "on "+attrs.Ref)
+	case e.ObjectKind == "note" && attrs != nil:
+		parts = append(parts, strconv.Quote(firstLine(attrs.Note)))
+	}
+
+	username := e.Username
+	if username == "" && e.User != nil {
+		username = e.User.Username
+	}
+	if username != "" {
+		parts = append(parts, "by @"+username)
+	}
+
+	// Some actions are empty, like the ones of the first events of merge requests.
+	var summary []string
+	for _, part := range parts {
+		if part != "" {
+			summary = append(summary, part)
+		}
+	}
+	return strings.Join(summary, " ")
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
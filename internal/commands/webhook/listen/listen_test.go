@@ -0,0 +1,173 @@
+//go:build !integration
+
+package listen
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const pushPayload = `{"object_kind": "push", "ref": "refs/heads/main", "user_username": "alice", "project": {"path_with_namespace": "OWNER/REPO"}}`
+
+func newOptions(t *testing.T) (*options, func() (string, string)) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	opts := &options{io: ios, httpClient: http.DefaultClient, outputFormat: "text"}
+	return opts, func() (string, string) { return stdout.String(), stderr.String() }
+}
+
+func sendEvent(handler http.Handler, token, payload string) *http.Response {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Event-UUID", "7c1b5d5e")
+	if token != "" {
+		req.Header.Set("X-Gitlab-Token", token)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec.Result()
+}
+
+func TestListen_text(t *testing.T) {
+	opts, output := newOptions(t)
+	opts.secretToken = "s3cret"
+
+	resp := sendEvent(opts.handler(), "s3cret", pushPayload)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stdout, stderr := output()
+	assert.Regexp(t, `^\d\d:\d\d:\d\d Push Hook OWNER/REPO refs/heads/main by @alice\n$`, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestListen_invalidToken(t *testing.T) {
+	opts, output := newOptions(t)
+	opts.secretToken = "s3cret"
+
+	resp := sendEvent(opts.handler(), "wrong", pushPayload)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	stdout, stderr := output()
+	assert.Empty(t, stdout)
+	assert.Contains(t, stderr, "x Rejected a Push Hook event: invalid X-Gitlab-Token header.")
+}
+
+func TestListen_json(t *testing.T) {
+	opts, output := newOptions(t)
+	opts.outputFormat = "json"
+
+	resp := sendEvent(opts.handler(), "s3cret", pushPayload)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stdout, _ := output()
+	var record struct {
+		Event   string            `json:"event"`
+		Headers map[string]string `json:"headers"`
+		Payload map[string]any    `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &record))
+	assert.Equal(t, "Push Hook", record.Event)
+	assert.Equal(t, map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Event-Uuid": "7c1b5d5e"}, record.Headers)
+	assert.Equal(t, "refs/heads/main", record.Payload["ref"])
+}
+
+func TestListen_forward(t *testing.T) {
+	consumer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, pushPayload, string(body))
+		assert.Equal(t, "Push Hook", r.Header.Get("X-Gitlab-Event"))
+		assert.Equal(t, "s3cret", r.Header.Get("X-Gitlab-Token"))
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("queued"))
+	}))
+	defer consumer.Close()
+
+	opts, output := newOptions(t)
+	opts.forwardTo = consumer.URL + "/hooks/gitlab"
+
+	resp := sendEvent(opts.handler(), "s3cret", pushPayload)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "queued", string(body))
+
+	_, stderr := output()
+	assert.Equal(t, "  → 202 Accepted from "+opts.forwardTo+"\n", stderr)
+}
+
+func TestListen_forwardError(t *testing.T) {
+	consumer := httptest.NewServer(http.NotFoundHandler())
+	consumer.Close()
+
+	opts, output := newOptions(t)
+	opts.forwardTo = consumer.URL
+
+	resp := sendEvent(opts.handler(), "", pushPayload)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	_, stderr := output()
+	assert.Contains(t, stderr, "x Failed to forward the event:")
+}
+
+func TestListen_method(t *testing.T) {
+	opts, _ := newOptions(t)
+
+	rec := httptest.NewRecorder()
+	opts.handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{
+			name:    "merge request",
+			payload: `{"object_kind": "merge_request", "user": {"username": "bob"}, "project": {"path_with_namespace": "OWNER/REPO"}, "object_attributes": {"iid": 7, "action": "open", "title": "Add webhooks"}}`,
+			want:    `OWNER/REPO !7 open "Add webhooks" by @bob`,
+		},
+		{
+			name:    "pipeline",
+			payload: `{"object_kind": "pipeline", "project": {"path_with_namespace": "OWNER/REPO"}, "object_attributes": {"id": 31, "status": "failed", "ref": "main"}}`,
+			want:    `OWNER/REPO pipeline 31 failed on main`,
+		},
+		{
+			name:    "note",
+			payload: `{"object_kind": "note", "object_attributes": {"note": "LGTM\n\nThanks!"}}`,
+			want:    `"LGTM"`,
+		},
+		{
+			name:    "not JSON",
+			payload: `payload=1`,
+			want:    ``,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, summarize([]byte(tc.payload)))
+		})
+	}
+}
+
+func TestListen_invalidForwardURL(t *testing.T) {
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	cmd := NewCmdListen(cmdtest.NewTestFactory(ios))
+
+	_, err := cmdtest.ExecuteCommand(cmd, `--forward-to localhost:3000`, stdout, stderr)
+	assert.EqualError(t, err, `invalid --forward-to URL "localhost:3000". Use an http or https URL.`)
+}
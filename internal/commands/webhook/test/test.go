@@ -0,0 +1,100 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/webhook/webhookutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	id    int64
+	group string
+	event string
+}
+
+func NewCmdTest(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "test <id> [flags]",
+		Short: `Send a test event to a webhook.`,
+		Long: heredoc.Docf(`
+			Make GitLab send a test event to a webhook, with the data of the latest event
+			of this kind. GitLab limits the number of test events per minute.
+
+			Events: %s.
+		`, strings.Join(webhookutils.TestEvents, ", ")),
+		Example: heredoc.Doc(`
+			$ glab webhook test 12
+			$ glab webhook test 12 --event merge_requests
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := webhookutils.ParseID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.id = id
+
+			if err := webhookutils.ValidateEvents("event", []string{opts.event}, webhookutils.TestEvents); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Test a webhook of a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.event, "event", "e", "push", "Event to send.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := webhookutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if err := hooks.Test(o.id, o.event); err != nil {
+		var errResp *gitlab.ErrorResponse
+		switch {
+		case api.Is404(err):
+			return fmt.Errorf("webhook %d not found in %s.", o.id, hooks)
+		case errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusTooManyRequests:
+			return fmt.Errorf("too many test events for webhook %d. Try again in a minute.", o.id)
+		}
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to send a test event to webhook %d.", o.id))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Sent a test %s event to webhook %d.\n", o.io.Color().GreenCheck(), o.event, o.id)
+	return nil
+}
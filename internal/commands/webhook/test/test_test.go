@@ -0,0 +1,44 @@
+//go:build !integration
+
+package test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	clitest "gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*clitest.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdTest(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWebhookTest(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER%2FREPO/hooks/12/test/merge_requests_events",
+		httpmock.NewStringResponse(http.StatusCreated, `{"message": "201 Created"}`))
+
+	output, err := runCommand(t, fakeHTTP, `12 --event merge_requests`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Sent a test merge_requests event to webhook 12.\n", output.String())
+}
+
+func TestWebhookTest_invalidEvent(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `12 --event deployment`)
+	assert.ErrorContains(t, err, `invalid event "deployment".`)
+}
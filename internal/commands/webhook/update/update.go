@@ -0,0 +1,183 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/webhook/webhookutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	id            int64
+	group         string
+	tokenStdin    bool
+	headers       []string
+	removeHeaders []string
+	// changes are the settings of the flags that are set.
+	changes webhookutils.Options
+}
+
+// settingFlags are the flags that change the settings of the webhook.
+var settingFlags = []string{"url", "name", "description", "event", "branch-filter", "secret-token", "stdin", "ssl-verification", "header", "remove-header"}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	var url, name, description, branchFilter, secretToken string
+	var events []string
+	var sslVerification bool
+
+	cmd := &cobra.Command{
+		Use:   "update <id> [flags]",
+		Short: `Update a webhook.`,
+		Long: heredoc.Docf(`
+			Update the settings of a webhook. Only the settings of the flags that are set
+			change.
+
+			%[1]s--event%[1]s sets all the events of the webhook: the other events are
+			disabled. %[1]s--header%[1]s adds a custom header, or replaces the header with
+			the same name.
+
+			Events: %[2]s.
+		`, "`", strings.Join(webhookutils.Events, ", ")),
+		Example: heredoc.Doc(`
+			$ glab webhook update 12 --event push,tag_push
+			$ glab webhook update 12 --url https://example.com/hooks/v2 --header "X-Version: 2"
+			$ glab webhook update 12 --remove-header X-Environment
+			$ echo "$NEW_WEBHOOK_TOKEN" | glab webhook update 12 --stdin
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := webhookutils.ParseID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.id = id
+
+			flags := cmd.Flags()
+			if !slices.ContainsFunc(settingFlags, flags.Changed) {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one setting to update.")}
+			}
+
+			if flags.Changed("url") {
+				opts.changes.URL = &url
+			}
+			if flags.Changed("name") {
+				opts.changes.Name = &name
+			}
+			if flags.Changed("description") {
+				opts.changes.Description = &description
+			}
+			if flags.Changed("branch-filter") {
+				opts.changes.PushEventsBranchFilter = &branchFilter
+			}
+			if flags.Changed("secret-token") {
+				opts.changes.Token = &secretToken
+			}
+			if flags.Changed("ssl-verification") {
+				opts.changes.EnableSSLVerification = &sslVerification
+			}
+			if flags.Changed("event") {
+				if err := webhookutils.ValidateEvents("event", events, webhookutils.Events); err != nil {
+					return err
+				}
+				opts.changes.Events = append([]string{}, events...)
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Update a webhook of a group, instead of the current project.")
+	cmd.Flags().StringVar(&url, "url", "", "URL of the webhook.")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Name of the webhook.")
+	cmd.Flags().StringVarP(&description, "description", "d", "", "Description of the webhook.")
+	cmd.Flags().StringSliceVarP(&events, "event", "e", nil, "Events that trigger the webhook. Can be repeated, or separated by commas.")
+	cmd.Flags().StringVar(&branchFilter, "branch-filter", "", "Only send the push events of branches that match this wildcard pattern. Use \"\" to send all branches.")
+	cmd.Flags().StringVarP(&secretToken, "secret-token", "t", "", "Secret token, sent in the X-Gitlab-Token header of the events.")
+	cmd.Flags().BoolVar(&opts.tokenStdin, "stdin", false, "Read the secret token from standard input.")
+	cmd.Flags().BoolVar(&sslVerification, "ssl-verification", true, "Verify the SSL certificate of the URL.")
+	cmd.Flags().StringArrayVar(&opts.headers, "header", nil, "Custom header to send with the events, in the 'Name: value' format. Can be repeated.")
+	cmd.Flags().StringArrayVar(&opts.removeHeaders, "remove-header", nil, "Name of a custom header to remove. Can be repeated.")
+	cmd.MarkFlagsMutuallyExclusive("secret-token", "stdin")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	headers, err := webhookutils.ParseHeaders(o.headers)
+	if err != nil {
+		return err
+	}
+	o.changes.CustomHeaders = headers
+
+	if o.tokenStdin {
+		token, err := io.ReadAll(o.io.In)
+		if err != nil {
+			return fmt.Errorf("failed to read the secret token from standard input: %w", err)
+		}
+		o.changes.Token = gitlab.Ptr(strings.TrimSpace(string(token)))
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	hooks, err := webhookutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	// Editing a webhook requires its URL: the one of the webhook when it doesn't change.
+	if o.changes.URL == nil {
+		hook, err := hooks.Get(o.id)
+		if err != nil {
+			return o.wrapError(err, hooks)
+		}
+		o.changes.URL = &hook.URL
+	}
+
+	if err := hooks.RemoveHeaders(o.id, o.removeHeaders); err != nil {
+		return o.wrapError(err, hooks)
+	}
+
+	hook, err := hooks.Update(o.id, &o.changes)
+	if err != nil {
+		return o.wrapError(err, hooks)
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated webhook %d for %s.\n", o.io.Color().GreenCheck(), hook.ID, hooks)
+	fmt.Fprintf(o.io.StdOut, "Events: %s\n", strings.Join(hook.Events, ", "))
+	return nil
+}
+
+func (o *options) wrapError(err error, hooks *webhookutils.Hooks) error {
+	if api.Is404(err) {
+		return fmt.Errorf("webhook %d not found in %s.", o.id, hooks)
+	}
+	return cmdutils.WrapError(err, fmt.Sprintf("failed to update webhook %d.", o.id))
+}
@@ -0,0 +1,69 @@
+//go:build !integration
+
+package update
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdUpdate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWebhookUpdate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/hooks/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 12, "url": "https://example.com/hooks/gitlab", "push_events": true}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/hooks/12/custom_headers/X-Environment",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/hooks/12/custom_headers/X-Version",
+		`{"value": "2"}`,
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/hooks/12",
+		`{
+			"url": "https://example.com/hooks/gitlab", "push_events_branch_filter": "",
+			"push_events": true, "tag_push_events": true, "issues_events": false, "confidential_issues_events": false,
+			"merge_requests_events": false, "note_events": false, "confidential_note_events": false, "job_events": false,
+			"pipeline_events": false, "wiki_page_events": false, "deployment_events": false, "releases_events": false,
+			"resource_access_token_events": false
+		}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 12, "url": "https://example.com/hooks/gitlab", "push_events": true, "tag_push_events": true}`))
+
+	output, err := runCommand(t, fakeHTTP, `12 --event push,tag_push --branch-filter "" --header "X-Version: 2" --remove-header X-Environment`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Updated webhook 12 for OWNER/REPO.\nEvents: push, tag_push\n", output.String())
+}
+
+func TestWebhookUpdate_notFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/hooks/99",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not Found"}`))
+
+	_, err := runCommand(t, fakeHTTP, `99 --group my-org --name "CI bot"`)
+	assert.EqualError(t, err, "webhook 99 not found in the group my-org.")
+}
+
+func TestWebhookUpdate_noSettings(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `12 --group my-org`)
+	assert.EqualError(t, err, "specify at least one setting to update.")
+}
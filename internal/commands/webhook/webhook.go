@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	webhookCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/create"
+	webhookDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/delete"
+	webhookListCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/list"
+	webhookListenCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/listen"
+	webhookTestCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/test"
+	webhookUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/webhook/update"
+)
+
+func NewCmdWebhook(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "webhook <command> [flags]",
+		Short:   `Manage webhooks, and receive their events locally.`,
+		Aliases: []string{"hook"},
+		Long: heredoc.Docf(`
+			Manage the webhooks of a project or group, and receive webhook events locally.
+
+			Webhooks are identified by their ID. They belong to the current project, or
+			to a group when %[1]s--group%[1]s is set.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab webhook list
+			$ glab webhook create https://example.com/hooks/gitlab --event push,merge_requests
+			$ glab webhook test 12
+			$ glab webhook listen --forward-to http://localhost:3000/hooks/gitlab
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(webhookListCmd.NewCmdList(f))
+	cmd.AddCommand(webhookCreateCmd.NewCmdCreate(f))
+	cmd.AddCommand(webhookUpdateCmd.NewCmdUpdate(f))
+	cmd.AddCommand(webhookDeleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(webhookTestCmd.NewCmdTest(f))
+	cmd.AddCommand(webhookListenCmd.NewCmdListen(f))
+
+	return cmd
+}
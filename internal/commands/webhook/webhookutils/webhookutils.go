@@ -0,0 +1,438 @@
+package webhookutils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Events are the events that trigger webhooks of both projects and groups.
+// They're the names of the fields of the API, without the '_events' suffix.
+var Events = []string{
+	"push",
+	"tag_push",
+	"issues",
+	"confidential_issues",
+	"merge_requests",
+	"note",
+	"confidential_note",
+	"job",
+	"pipeline",
+	"wiki_page",
+	"deployment",
+	"releases",
+	"resource_access_token",
+}
+
+// TestEvents are the events that GitLab can send to test webhooks.
+var TestEvents = []string{
+	"push",
+	"tag_push",
+	"issues",
+	"confidential_issues",
+	"merge_requests",
+	"note",
+	"job",
+	"pipeline",
+	"wiki_page",
+	"releases",
+	"resource_access_token",
+}
+
+// ValidateEvents returns a flag error if an event is not one of valid.
+func ValidateEvents(flag string, events, valid []string) error {
+	for _, event := range events {
+		if !slices.Contains(valid, event) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("invalid %s %q. Use one of: %s.", flag, event, strings.Join(valid, ", "))}
+		}
+	}
+	return nil
+}
+
+// ParseID returns the ID of a webhook argument.
+func ParseID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid webhook ID %q.", arg)
+	}
+	return id, nil
+}
+
+// ParseHeaders returns the custom headers of --header flags, in the
+// 'Name: value' format.
+func ParseHeaders(headers []string) ([]*gitlab.HookCustomHeader, error) {
+	parsed := make([]*gitlab.HookCustomHeader, 0, len(headers))
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, ":")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			return nil, &cmdutils.FlagError{Err: fmt.Errorf("invalid --header %q. Use the 'Name: value' format.", header)}
+		}
+		parsed = append(parsed, &gitlab.HookCustomHeader{Key: name, Value: value})
+	}
+	return parsed, nil
+}
+
+// Hook is a webhook of a project or a group.
+type Hook struct {
+	ID                     int64                      `json:"id"`
+	URL                    string                     `json:"url"`
+	Name                   string                     `json:"name"`
+	Description            string                     `json:"description"`
+	Events                 []string                   `json:"events"`
+	PushEventsBranchFilter string                     `json:"push_events_branch_filter"`
+	EnableSSLVerification  bool                       `json:"enable_ssl_verification"`
+	AlertStatus            string                     `json:"alert_status"`
+	CustomHeaders          []*gitlab.HookCustomHeader `json:"custom_headers"`
+	CreatedAt              *time.Time                 `json:"created_at"`
+}
+
+// Options are the settings of a webhook to create or update. Unset fields are
+// not changed. Events lists all the events of the webhook: the others are
+// disabled.
+type Options struct {
+	URL                    *string
+	Name                   *string
+	Description            *string
+	Token                  *string
+	PushEventsBranchFilter *string
+	EnableSSLVerification  *bool
+	Events                 []string
+	CustomHeaders          []*gitlab.HookCustomHeader
+}
+
+// event returns whether the options enable an event, or nil without events.
+func (o *Options) event(name string) *bool {
+	if o.Events == nil {
+		return nil
+	}
+	return gitlab.Ptr(slices.Contains(o.Events, name))
+}
+
+func (o *Options) customHeaders() *[]*gitlab.HookCustomHeader {
+	if len(o.CustomHeaders) == 0 {
+		return nil
+	}
+	return &o.CustomHeaders
+}
+
+// Hooks are the webhooks of a project, or of a group. The API of group
+// webhooks is close to the one of project webhooks, and Hooks hides the
+// difference.
+type Hooks struct {
+	client *gitlab.Client
+	// group is the path of the group, for group webhooks.
+	group string
+	// project is the path of the project, for project webhooks.
+	project string
+}
+
+// New returns the webhooks of the group if set, or else of the current project.
+func New(client *gitlab.Client, group string, baseRepo func() (glrepo.Interface, error)) (*Hooks, error) {
+	if group != "" {
+		return &Hooks{client: client, group: group}, nil
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return nil, err
+	}
+	return &Hooks{client: client, project: repo.FullName()}, nil
+}
+
+// String returns the owner of the webhooks, for messages.
+func (h *Hooks) String() string {
+	if h.group != "" {
+		return "the group " + h.group
+	}
+	return h.project
+}
+
+// List returns all the webhooks.
+func (h *Hooks) List() ([]*Hook, error) {
+	if h.group != "" {
+		hooks, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupHook, *gitlab.Response, error) {
+			return h.client.Groups.ListGroupHooks(h.group, &gitlab.ListGroupHooksOptions{}, p)
+		})
+		return convert(hooks, fromGroupHook), err
+	}
+	hooks, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectHook, *gitlab.Response, error) {
+		return h.client.Projects.ListProjectHooks(h.project, &gitlab.ListProjectHooksOptions{}, p)
+	})
+	return convert(hooks, fromProjectHook), err
+}
+
+// Get returns a webhook.
+func (h *Hooks) Get(id int64) (*Hook, error) {
+	if h.group != "" {
+		hook, _, err := h.client.Groups.GetGroupHook(h.group, id)
+		if err != nil {
+			return nil, err
+		}
+		return fromGroupHook(hook), nil
+	}
+	hook, _, err := h.client.Projects.GetProjectHook(h.project, id)
+	if err != nil {
+		return nil, err
+	}
+	return fromProjectHook(hook), nil
+}
+
+// Create creates a webhook.
+func (h *Hooks) Create(opts *Options) (*Hook, error) {
+	if h.group != "" {
+		hook, _, err := h.client.Groups.AddGroupHook(h.group, &gitlab.AddGroupHookOptions{
+			URL:                       opts.URL,
+			Name:                      opts.Name,
+			Description:               opts.Description,
+			Token:                     opts.Token,
+			PushEventsBranchFilter:    opts.PushEventsBranchFilter,
+			EnableSSLVerification:     opts.EnableSSLVerification,
+			CustomHeaders:             opts.customHeaders(),
+			PushEvents:                opts.event("push"),
+			TagPushEvents:             opts.event("tag_push"),
+			IssuesEvents:              opts.event("issues"),
+			ConfidentialIssuesEvents:  opts.event("confidential_issues"),
+			MergeRequestsEvents:       opts.event("merge_requests"),
+			NoteEvents:                opts.event("note"),
+			ConfidentialNoteEvents:    opts.event("confidential_note"),
+			JobEvents:                 opts.event("job"),
+			PipelineEvents:            opts.event("pipeline"),
+			WikiPageEvents:            opts.event("wiki_page"),
+			DeploymentEvents:          opts.event("deployment"),
+			ReleasesEvents:            opts.event("releases"),
+			ResourceAccessTokenEvents: opts.event("resource_access_token"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fromGroupHook(hook), nil
+	}
+
+	hook, _, err := h.client.Projects.AddProjectHook(h.project, &gitlab.AddProjectHookOptions{
+		URL:                       opts.URL,
+		Name:                      opts.Name,
+		Description:               opts.Description,
+		Token:                     opts.Token,
+		PushEventsBranchFilter:    opts.PushEventsBranchFilter,
+		EnableSSLVerification:     opts.EnableSSLVerification,
+		CustomHeaders:             opts.customHeaders(),
+		PushEvents:                opts.event("push"),
+		TagPushEvents:             opts.event("tag_push"),
+		IssuesEvents:              opts.event("issues"),
+		ConfidentialIssuesEvents:  opts.event("confidential_issues"),
+		MergeRequestsEvents:       opts.event("merge_requests"),
+		NoteEvents:                opts.event("note"),
+		ConfidentialNoteEvents:    opts.event("confidential_note"),
+		JobEvents:                 opts.event("job"),
+		PipelineEvents:            opts.event("pipeline"),
+		WikiPageEvents:            opts.event("wiki_page"),
+		DeploymentEvents:          opts.event("deployment"),
+		ReleasesEvents:            opts.event("releases"),
+		ResourceAccessTokenEvents: opts.event("resource_access_token"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProjectHook(hook), nil
+}
+
+// Update changes the settings of a webhook. The custom headers of the options
+// are added to the webhook, or replace the ones with the same names.
+func (h *Hooks) Update(id int64, opts *Options) (*Hook, error) {
+	if err := h.setHeaders(id, opts.CustomHeaders); err != nil {
+		return nil, err
+	}
+
+	if h.group != "" {
+		hook, _, err := h.client.Groups.EditGroupHook(h.group, id, &gitlab.EditGroupHookOptions{
+			URL:                       opts.URL,
+			Name:                      opts.Name,
+			Description:               opts.Description,
+			Token:                     opts.Token,
+			PushEventsBranchFilter:    opts.PushEventsBranchFilter,
+			EnableSSLVerification:     opts.EnableSSLVerification,
+			PushEvents:                opts.event("push"),
+			TagPushEvents:             opts.event("tag_push"),
+			IssuesEvents:              opts.event("issues"),
+			ConfidentialIssuesEvents:  opts.event("confidential_issues"),
+			MergeRequestsEvents:       opts.event("merge_requests"),
+			NoteEvents:                opts.event("note"),
+			ConfidentialNoteEvents:    opts.event("confidential_note"),
+			JobEvents:                 opts.event("job"),
+			PipelineEvents:            opts.event("pipeline"),
+			WikiPageEvents:            opts.event("wiki_page"),
+			DeploymentEvents:          opts.event("deployment"),
+			ReleasesEvents:            opts.event("releases"),
+			ResourceAccessTokenEvents: opts.event("resource_access_token"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return fromGroupHook(hook), nil
+	}
+
+	hook, _, err := h.client.Projects.EditProjectHook(h.project, id, &gitlab.EditProjectHookOptions{
+		URL:                       opts.URL,
+		Name:                      opts.Name,
+		Description:               opts.Description,
+		Token:                     opts.Token,
+		PushEventsBranchFilter:    opts.PushEventsBranchFilter,
+		EnableSSLVerification:     opts.EnableSSLVerification,
+		PushEvents:                opts.event("push"),
+		TagPushEvents:             opts.event("tag_push"),
+		IssuesEvents:              opts.event("issues"),
+		ConfidentialIssuesEvents:  opts.event("confidential_issues"),
+		MergeRequestsEvents:       opts.event("merge_requests"),
+		NoteEvents:                opts.event("note"),
+		ConfidentialNoteEvents:    opts.event("confidential_note"),
+		JobEvents:                 opts.event("job"),
+		PipelineEvents:            opts.event("pipeline"),
+		WikiPageEvents:            opts.event("wiki_page"),
+		DeploymentEvents:          opts.event("deployment"),
+		ReleasesEvents:            opts.event("releases"),
+		ResourceAccessTokenEvents: opts.event("resource_access_token"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProjectHook(hook), nil
+}
+
+func (h *Hooks) setHeaders(id int64, headers []*gitlab.HookCustomHeader) error {
+	for _, header := range headers {
+		var err error
+		opt := &gitlab.SetHookCustomHeaderOptions{Value: gitlab.Ptr(header.Value)}
+		if h.group != "" {
+			_, err = h.client.Groups.SetGroupCustomHeader(h.group, id, header.Key, opt)
+		} else {
+			_, err = h.client.Projects.SetProjectCustomHeader(h.project, id, header.Key, opt)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set the header %s: %w", header.Key, err)
+		}
+	}
+	return nil
+}
+
+// RemoveHeaders removes custom headers of a webhook, by name.
+func (h *Hooks) RemoveHeaders(id int64, names []string) error {
+	for _, name := range names {
+		var err error
+		if h.group != "" {
+			_, err = h.client.Groups.DeleteGroupCustomHeader(h.group, id, name)
+		} else {
+			_, err = h.client.Projects.DeleteProjectCustomHeader(h.project, id, name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to remove the header %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes a webhook.
+func (h *Hooks) Delete(id int64) error {
+	if h.group != "" {
+		_, err := h.client.Groups.DeleteGroupHook(h.group, id)
+		return err
+	}
+	_, err := h.client.Projects.DeleteProjectHook(h.project, id)
+	return err
+}
+
+// Test makes GitLab send a test event to a webhook, with the data of the
+// last event of this kind.
+func (h *Hooks) Test(id int64, event string) error {
+	trigger := event + "_events"
+	if h.group != "" {
+		_, err := h.client.Groups.TriggerTestGroupHook(h.group, id, gitlab.GroupHookTrigger(trigger))
+		return err
+	}
+	_, err := h.client.Projects.TriggerTestProjectHook(h.project, id, gitlab.ProjectHookEvent(trigger))
+	return err
+}
+
+func convert[T any](hooks []T, from func(T) *Hook) []*Hook {
+	converted := make([]*Hook, 0, len(hooks))
+	for _, hook := range hooks {
+		converted = append(converted, from(hook))
+	}
+	return converted
+}
+
+// enabled returns the names of the enabled events, in the order of Events.
+func enabled(events map[string]bool) []string {
+	names := []string{}
+	for _, name := range Events {
+		if events[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func fromProjectHook(hook *gitlab.ProjectHook) *Hook {
+	return &Hook{
+		ID:                     hook.ID,
+		URL:                    hook.URL,
+		Name:                   hook.Name,
+		Description:            hook.Description,
+		PushEventsBranchFilter: hook.PushEventsBranchFilter,
+		EnableSSLVerification:  hook.EnableSSLVerification,
+		AlertStatus:            hook.AlertStatus,
+		CustomHeaders:          hook.CustomHeaders,
+		CreatedAt:              hook.CreatedAt,
+		Events: enabled(map[string]bool{
+			"push":                  hook.PushEvents,
+			"tag_push":              hook.TagPushEvents,
+			"issues":                hook.IssuesEvents,
+			"confidential_issues":   hook.ConfidentialIssuesEvents,
+			"merge_requests":        hook.MergeRequestsEvents,
+			"note":                  hook.NoteEvents,
+			"confidential_note":     hook.ConfidentialNoteEvents,
+			"job":                   hook.JobEvents,
+			"pipeline":              hook.PipelineEvents,
+			"wiki_page":             hook.WikiPageEvents,
+			"deployment":            hook.DeploymentEvents,
+			"releases":              hook.ReleasesEvents,
+			"resource_access_token": hook.ResourceAccessTokenEvents,
+		}),
+	}
+}
+
+func fromGroupHook(hook *gitlab.GroupHook) *Hook {
+	return &Hook{
+		ID:                     hook.ID,
+		URL:                    hook.URL,
+		Name:                   hook.Name,
+		Description:            hook.Description,
+		PushEventsBranchFilter: hook.PushEventsBranchFilter,
+		EnableSSLVerification:  hook.EnableSSLVerification,
+		AlertStatus:            hook.AlertStatus,
+		CustomHeaders:          hook.CustomHeaders,
+		CreatedAt:              hook.CreatedAt,
+		Events: enabled(map[string]bool{
+			"push":                  hook.PushEvents,
+			"tag_push":              hook.TagPushEvents,
+			"issues":                hook.IssuesEvents,
+			"confidential_issues":   hook.ConfidentialIssuesEvents,
+			"merge_requests":        hook.MergeRequestsEvents,
+			"note":                  hook.NoteEvents,
+			"confidential_note":     hook.ConfidentialNoteEvents,
+			"job":                   hook.JobEvents,
+			"pipeline":              hook.PipelineEvents,
+			"wiki_page":             hook.WikiPageEvents,
+			"deployment":            hook.DeploymentEvents,
+			"releases":              hook.ReleasesEvents,
+			"resource_access_token": hook.ResourceAccessTokenEvents,
+		}),
+	}
+}
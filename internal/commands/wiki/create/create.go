@@ -0,0 +1,127 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+
+	title   string
+	group   string
+	content string
+	file    string
+	format  string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create <title> [flags]",
+		Short:   `Create a wiki page.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Doc(`
+			Create a wiki page. Slashes in the title create the page in a
+			directory: the page "guides/Setup" has the slug "guides/Setup".
+
+			Without --content or --file, the content is written in your editor.
+		`),
+		Example: heredoc.Doc(`
+			$ glab wiki create "Release process" --file docs/release.md
+			$ glab wiki create "runbooks/Database failover" --content "Promote the replica first."
+			$ cat notes.adoc | glab wiki create "Meeting notes" --file - --format asciidoc
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.title = args[0]
+			if opts.content == "" && opts.file == "" && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("the '--content' or '--file' flag is required when not running interactively.")}
+			}
+			if opts.format == "" {
+				opts.format = string(gitlab.WikiFormatMarkdown)
+				if format, ok := wikiutils.FormatFromPath(opts.file); ok {
+					opts.format = string(format)
+				}
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Create the page in the wiki of a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.content, "content", "c", "", "Content of the page.")
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read the content of the page from a file. Use '-' to read from the standard input.")
+	cmd.Flags().StringVar(&opts.format, "format", "", "Format of the page: markdown, rdoc, asciidoc, org. Defaults to the format of the extension of --file, or markdown.")
+	cmd.MarkFlagsMutuallyExclusive("content", "file")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	format, err := wikiutils.ParseFormat(o.format)
+	if err != nil {
+		return err
+	}
+
+	content := o.content
+	switch {
+	case o.file != "":
+		if content, err = wikiutils.ReadContent(o.io, o.file); err != nil {
+			return err
+		}
+	case content == "":
+		editor, err := cmdutils.GetEditor(o.config)
+		if err != nil {
+			return err
+		}
+		if err := cmdutils.EditorPrompt(ctx, o.io, &content, "Content", "", editor); err != nil {
+			return err
+		}
+	}
+	if content == "" {
+		return errors.New("the content of the page can't be empty.")
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	page, err := wiki.Create(o.title, content, format)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to create the page %q.", o.title))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Created the page %s in %s.\n%s\n", o.io.Color().GreenCheck(), page.Slug, wiki, wiki.WebURL(page.Slug))
+	return nil
+}
@@ -0,0 +1,64 @@
+//go:build !integration
+
+package create
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdCreate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWikiCreate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/wikis",
+		`{"title": "Release process", "content": "# Releases", "format": "markdown"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"slug": "Release-process", "title": "Release process", "format": "markdown"}`))
+
+	output, err := runCommand(t, fakeHTTP, `"Release process" --content "# Releases"`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created the page Release-process in the wiki of OWNER/REPO.\nhttps://gitlab.com/OWNER/REPO/-/wikis/Release-process\n", output.String())
+}
+
+func TestWikiCreate_formatFromFile(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	file := filepath.Join(t.TempDir(), "setup.adoc")
+	require.NoError(t, os.WriteFile(file, []byte("= Setup\n"), 0o644))
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/groups/my-org/wikis",
+		`{"title": "setup", "content": "= Setup\n", "format": "asciidoc"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"slug": "setup", "title": "setup", "format": "asciidoc"}`))
+
+	output, err := runCommand(t, fakeHTTP, `setup --group my-org --file `+file)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created the page setup in the wiki of the group my-org.\nhttps://gitlab.com/groups/my-org/-/wikis/setup\n", output.String())
+}
+
+func TestWikiCreate_noContent(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `"Release process"`)
+	assert.EqualError(t, err, "the '--content' or '--file' flag is required when not running interactively.")
+}
@@ -0,0 +1,109 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	slugs       []string
+	group       string
+	forceDelete bool
+}
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <slug>... [flags]",
+		Short: `Delete wiki pages.`,
+		Example: heredoc.Doc(`
+			$ glab wiki delete drafts/old-notes
+			$ glab wiki delete page-one page-two --yes
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.slugs = args
+
+			if !opts.forceDelete && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Delete pages of the wiki of a group, instead of the current project.")
+	cmd.Flags().BoolVarP(&opts.forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if !o.forceDelete {
+		err = o.io.Confirm(ctx, &o.forceDelete, fmt.Sprintf("Delete %s from %s?", utils.Pluralize(len(o.slugs), "page"), wiki))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+	}
+
+	if !o.forceDelete {
+		return cmdutils.CancelError()
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, slug := range o.slugs {
+		if err := wiki.Delete(slug); err != nil {
+			failed++
+			if api.Is404(err) {
+				fmt.Fprintf(o.io.StdErr, "%s Page %s not found.\n", c.FailedIcon(), slug)
+			} else {
+				fmt.Fprintf(o.io.StdErr, "%s Failed to delete page %s: %s\n", c.FailedIcon(), slug, err)
+			}
+			continue
+		}
+		fmt.Fprintf(o.io.StdOut, "%s Deleted page %s.\n", c.RedCheck(), slug)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %s.", utils.Pluralize(failed, "page"))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+//go:build !integration
+
+package delete
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdDelete(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWikiDelete(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/wikis/drafts%2Fold-notes",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/wikis/missing",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Wiki Page Not Found"}`))
+
+	output, err := runCommand(t, fakeHTTP, `drafts/old-notes missing --yes`)
+	assert.EqualError(t, err, "failed to delete 1 page.")
+
+	assert.Equal(t, "✓ Deleted page drafts/old-notes.\n", output.String())
+	assert.Equal(t, "x Page missing not found.\n", output.Stderr())
+}
+
+func TestWikiDelete_noConfirmation(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `home`)
+	require.Error(t, err)
+	assert.Equal(t, "--yes or -y flag is required when not running interactively.", err.Error())
+}
@@ -0,0 +1,148 @@
+package edit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+
+	slug    string
+	group   string
+	title   string
+	content string
+	file    string
+	format  string
+}
+
+func NewCmdEdit(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "edit <slug> [flags]",
+		Short:   `Edit a wiki page.`,
+		Aliases: []string{"update"},
+		Long: heredoc.Doc(`
+			Edit a wiki page. Without flags, the content of the page opens in your
+			editor.
+		`),
+		Example: heredoc.Doc(`
+			$ glab wiki edit home
+			$ glab wiki edit release-process --file docs/release.md
+			$ glab wiki edit old-name --title "New name"
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.slug = args[0]
+			if opts.title == "" && opts.content == "" && opts.file == "" && opts.format == "" && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one of '--title', '--content', '--file', or '--format' when not running interactively.")}
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Edit a page of the wiki of a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "New title of the page.")
+	cmd.Flags().StringVarP(&opts.content, "content", "c", "", "New content of the page.")
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read the new content of the page from a file. Use '-' to read from the standard input.")
+	cmd.Flags().StringVar(&opts.format, "format", "", "New format of the page: markdown, rdoc, asciidoc, org.")
+	cmd.MarkFlagsMutuallyExclusive("content", "file")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	var edit wikiutils.EditOptions
+	if o.format != "" {
+		format, err := wikiutils.ParseFormat(o.format)
+		if err != nil {
+			return err
+		}
+		edit.Format = &format
+	}
+	if o.title != "" {
+		edit.Title = gitlab.Ptr(o.title)
+	}
+	if o.content != "" {
+		edit.Content = gitlab.Ptr(o.content)
+	}
+	if o.file != "" {
+		content, err := wikiutils.ReadContent(o.io, o.file)
+		if err != nil {
+			return err
+		}
+		edit.Content = &content
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	// Without changes, edit the content in the editor.
+	if edit == (wikiutils.EditOptions{}) {
+		page, err := wiki.Get(o.slug)
+		if err != nil {
+			if api.Is404(err) {
+				return fmt.Errorf("page %q not found in %s.", o.slug, wiki)
+			}
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to get the page %q.", o.slug))
+		}
+
+		editor, err := cmdutils.GetEditor(o.config)
+		if err != nil {
+			return err
+		}
+		content := page.Content
+		if err := cmdutils.EditorPrompt(ctx, o.io, &content, "Content", "", editor); err != nil {
+			return err
+		}
+		if content == page.Content {
+			fmt.Fprintf(o.io.StdErr, "The page %s has not changed.\n", o.slug)
+			return nil
+		}
+		edit.Content = &content
+	}
+
+	page, err := wiki.Edit(o.slug, edit)
+	if err != nil {
+		if api.Is404(err) {
+			return fmt.Errorf("page %q not found in %s.", o.slug, wiki)
+		}
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to edit the page %q.", o.slug))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Updated the page %s in %s.\n%s\n", o.io.Color().GreenCheck(), page.Slug, wiki, wiki.WebURL(page.Slug))
+	return nil
+}
@@ -0,0 +1,45 @@
+//go:build !integration
+
+package edit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdEdit(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWikiEdit(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/wikis/guides/setup",
+		`{"title": "guides/install", "content": "Run make."}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"slug": "guides/install", "title": "install", "format": "markdown"}`))
+
+	output, err := runCommand(t, fakeHTTP, `guides/setup --title guides/install --content "Run make."`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Updated the page guides/install in the wiki of OWNER/REPO.\nhttps://gitlab.com/OWNER/REPO/-/wikis/guides/install\n", output.String())
+}
+
+func TestWikiEdit_invalidFormat(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `home --format textile`)
+	assert.EqualError(t, err, `invalid format "textile". Use one of: markdown, rdoc, asciidoc, org.`)
+}
@@ -0,0 +1,96 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the pages of a wiki.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab wiki list
+			$ glab wiki list --group gitlab-org --output json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "List the pages of the wiki of a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	pages, err := wiki.List(false)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the pages of %s.", wiki))
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(pages)
+	}
+
+	if len(pages) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No pages found in %s.\n", wiki)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(o.io.IsOutputTTY())
+	table.AddRow("Slug", "Title", "Format")
+	for _, page := range pages {
+		table.AddRow(c.Cyan(page.Slug), page.Title, c.Gray(string(page.Format)))
+	}
+	fmt.Fprint(o.io.StdOut, table.String())
+	return nil
+}
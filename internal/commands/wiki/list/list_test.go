@@ -0,0 +1,56 @@
+//go:build !integration
+
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestWikiList(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis?with_content=false",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"slug": "home", "title": "home", "format": "markdown"},
+			{"slug": "guides/setup", "title": "setup", "format": "asciidoc"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, ``)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Slug\tTitle\tFormat\nhome\thome\tmarkdown\nguides/setup\tsetup\tasciidoc\n", output.String())
+}
+
+func TestWikiList_group(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/wikis?with_content=false",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, `--group my-org`)
+	require.NoError(t, err)
+
+	assert.Empty(t, output.String())
+	assert.Equal(t, "No pages found in the wiki of the group my-org.\n", output.Stderr())
+}
@@ -0,0 +1,389 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	dir    string
+	group  string
+	push   bool
+	pull   bool
+	delete bool
+	dryRun bool
+	yes    bool
+}
+
+func NewCmdSync(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync <dir> [flags]",
+		Short: `Synchronize a wiki with a local directory of pages.`,
+		Long: heredoc.Docf(`
+			Synchronize a wiki with a local directory of pages, in one direction.
+
+			%[1]s--push%[1]s creates and updates the pages of the wiki from the files of
+			the directory. %[1]s--pull%[1]s writes the pages of the wiki to the directory.
+			Pages that are the same on both sides are not changed.
+
+			Each file is a page. Its path in the directory, without the extension,
+			is the slug of the page: %[1]sguides/setup.md%[1]s is the page %[1]sguides/setup%[1]s.
+			The extension is the format of the page: %[1]s.md%[1]s or %[1]s.markdown%[1]s for
+			Markdown, %[1]s.rdoc%[1]s for RDoc, %[1]s.adoc%[1]s or %[1]s.asciidoc%[1]s for AsciiDoc,
+			and %[1]s.org%[1]s for Org. Other files, and hidden files and directories,
+			are ignored.
+
+			Pages and files that only exist on the source side are kept, unless
+			%[1]s--delete%[1]s is set. Files deleted by %[1]s--pull --delete%[1]s can't be
+			recovered from the wiki, so they are listed and deleted after a confirmation,
+			or with %[1]s--yes%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Publish the docs directory to the wiki, for example from a CI/CD job
+			$ glab wiki sync docs --push --delete
+
+			# Preview the changes, without making them
+			$ glab wiki sync docs --push --delete --dry-run
+
+			# Download the wiki of a group
+			$ glab wiki sync handbook --pull --group my-org
+
+			# Mirror the wiki to a directory, deleting the files that aren't pages anymore
+			$ glab wiki sync docs --pull --delete --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.dir = args[0]
+
+			if opts.pull && opts.delete && !opts.dryRun && !opts.yes && !opts.io.PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Synchronize the wiki of a group, instead of the current project.")
+	cmd.Flags().BoolVar(&opts.push, "push", false, "Update the wiki from the directory.")
+	cmd.Flags().BoolVar(&opts.pull, "pull", false, "Update the directory from the wiki.")
+	cmd.Flags().BoolVar(&opts.delete, "delete", false, "Delete the pages or files that don't exist on the source side.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the changes, without making them.")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt of --pull --delete.")
+	cmd.MarkFlagsMutuallyExclusive("push", "pull")
+	cmd.MarkFlagsOneRequired("push", "pull")
+
+	return cmd
+}
+
+// localPage is a page of the local directory.
+type localPage struct {
+	path    string
+	title   string
+	format  gitlab.WikiFormatValue
+	content string
+}
+
+// changes counts the changes of a synchronization.
+type changes struct {
+	created, updated, deleted, unchanged int
+}
+
+func (o *options) run(ctx context.Context) error {
+	// Pulling creates the directory, but pushing needs it.
+	info, err := os.Stat(o.dir)
+	if (err == nil && !info.IsDir()) || (err != nil && o.push) {
+		return fmt.Errorf("%s is not a directory.", o.dir)
+	}
+
+	local, err := readDir(o.dir)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	pages, err := wiki.List(true)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to list the pages of %s.", wiki))
+	}
+	remote := make(map[string]*gitlab.Wiki, len(pages))
+	for _, page := range pages {
+		remote[page.Slug] = page
+	}
+
+	var c changes
+	if o.push {
+		c, err = o.pushPages(wiki, local, remote)
+	} else {
+		if o.delete && !o.dryRun {
+			if err := o.confirmDelete(ctx, wiki, local, remote); err != nil {
+				return err
+			}
+		}
+		c, err = o.pullPages(local, remote)
+	}
+	if err != nil {
+		return err
+	}
+
+	color := o.io.Color()
+	summary := fmt.Sprintf("%d created, %d updated, %d deleted, %d unchanged", c.created, c.updated, c.deleted, c.unchanged)
+	switch {
+	case o.dryRun:
+		fmt.Fprintf(o.io.StdErr, "%s Dry run: nothing was changed. Would have %s.\n", color.WarnIcon(), summary)
+	case o.push:
+		fmt.Fprintf(o.io.StdErr, "%s Pushed %s to %s: %s.\n", color.GreenCheck(), o.dir, wiki, summary)
+	default:
+		fmt.Fprintf(o.io.StdErr, "%s Pulled %s to %s: %s.\n", color.GreenCheck(), wiki, o.dir, summary)
+	}
+	return nil
+}
+
+func (o *options) pushPages(wiki *wikiutils.Wiki, local map[string]*localPage, remote map[string]*gitlab.Wiki) (changes, error) {
+	var c changes
+	for _, slug := range slices.Sorted(maps.Keys(local)) {
+		page := local[slug]
+		existing, ok := remote[slug]
+		switch {
+		case !ok:
+			o.report("+", slug)
+			c.created++
+			if !o.dryRun {
+				if _, err := wiki.Create(page.title, page.content, page.format); err != nil {
+					return c, cmdutils.WrapError(err, fmt.Sprintf("failed to create the page %s.", slug))
+				}
+			}
+		case existing.Format != page.format || !sameContent(existing.Content, page.content):
+			o.report("~", slug)
+			c.updated++
+			if !o.dryRun {
+				edit := wikiutils.EditOptions{Content: gitlab.Ptr(page.content), Format: gitlab.Ptr(page.format)}
+				if _, err := wiki.Edit(slug, edit); err != nil {
+					return c, cmdutils.WrapError(err, fmt.Sprintf("failed to update the page %s.", slug))
+				}
+			}
+		default:
+			c.unchanged++
+		}
+	}
+
+	if !o.delete {
+		return c, nil
+	}
+	for _, slug := range slices.Sorted(maps.Keys(remote)) {
+		if _, ok := local[slug]; ok {
+			continue
+		}
+		o.report("-", slug)
+		c.deleted++
+		if !o.dryRun {
+			if err := wiki.Delete(slug); err != nil {
+				return c, cmdutils.WrapError(err, fmt.Sprintf("failed to delete the page %s.", slug))
+			}
+		}
+	}
+	return c, nil
+}
+
+// confirmDelete lists the local files that aren't pages of the wiki, and asks
+// to confirm their deletion, unless --yes is set.
+func (o *options) confirmDelete(ctx context.Context, wiki *wikiutils.Wiki, local map[string]*localPage, remote map[string]*gitlab.Wiki) error {
+	var paths []string
+	for _, slug := range slices.Sorted(maps.Keys(local)) {
+		if _, ok := remote[slug]; !ok {
+			paths = append(paths, local[slug].path)
+		}
+	}
+	if len(paths) == 0 || o.yes {
+		return nil
+	}
+
+	fmt.Fprintf(o.io.StdErr, "These files aren't pages of %s:\n", wiki)
+	for _, path := range paths {
+		fmt.Fprintf(o.io.StdErr, "  %s\n", path)
+	}
+	err := o.io.Confirm(ctx, &o.yes, fmt.Sprintf("Delete %s?", utils.Pluralize(len(paths), "file")))
+	if err != nil {
+		return cmdutils.WrapError(err, "could not prompt")
+	}
+	if !o.yes {
+		return cmdutils.CancelError()
+	}
+	return nil
+}
+
+func (o *options) pullPages(local map[string]*localPage, remote map[string]*gitlab.Wiki) (changes, error) {
+	var c changes
+	for _, slug := range slices.Sorted(maps.Keys(remote)) {
+		page := remote[slug]
+		// Slugs come from the server: never write outside of the directory.
+		if !filepath.IsLocal(filepath.FromSlash(slug)) {
+			fmt.Fprintf(o.io.StdErr, "%s Skipped the page %s, which can't be a file of %s.\n", o.io.Color().WarnIcon(), slug, o.dir)
+			continue
+		}
+
+		path := filepath.Join(o.dir, filepath.FromSlash(slug)+wikiutils.Extension(page.Format))
+		existing, ok := local[slug]
+		switch {
+		case !ok:
+			o.report("+", slug)
+			c.created++
+		case existing.format != page.Format || !sameContent(existing.content, page.Content):
+			o.report("~", slug)
+			c.updated++
+			if existing.format == page.Format {
+				path = existing.path
+			} else if !o.dryRun {
+				if err := os.Remove(existing.path); err != nil {
+					return c, err
+				}
+			}
+		default:
+			c.unchanged++
+			continue
+		}
+
+		if !o.dryRun {
+			if err := writePage(path, page.Content); err != nil {
+				return c, err
+			}
+		}
+	}
+
+	if !o.delete {
+		return c, nil
+	}
+	for _, slug := range slices.Sorted(maps.Keys(local)) {
+		if _, ok := remote[slug]; ok {
+			continue
+		}
+		o.report("-", slug)
+		c.deleted++
+		if !o.dryRun {
+			if err := os.Remove(local[slug].path); err != nil {
+				return c, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// report prints a change to a page: + for created, ~ for updated, and - for
+// deleted.
+func (o *options) report(change, slug string) {
+	c := o.io.Color()
+	switch change {
+	case "+":
+		change = c.Green(change)
+	case "~":
+		change = c.Yellow(change)
+	case "-":
+		change = c.Red(change)
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s\n", change, slug)
+}
+
+// readDir returns the pages of a directory, by slug. A directory that
+// doesn't exist has no pages.
+func readDir(dir string) (map[string]*localPage, error) {
+	pages := map[string]*localPage{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == dir {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		format, ok := wikiutils.FormatFromPath(path)
+		if !ok {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		title := filepath.ToSlash(strings.TrimSuffix(rel, filepath.Ext(rel)))
+		slug := strings.ReplaceAll(title, " ", "-")
+		if other, ok := pages[slug]; ok {
+			return fmt.Errorf("%s and %s are both the page %s. Rename one of them.", other.path, path, slug)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		pages[slug] = &localPage{path: path, title: title, format: format, content: string(content)}
+		return nil
+	})
+	return pages, err
+}
+
+func writePage(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+// sameContent compares the content of pages, ignoring line endings and
+// trailing newlines, which GitLab and editors change.
+func sameContent(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	}
+	return normalize(a) == normalize(b)
+}
@@ -0,0 +1,207 @@
+//go:build !integration
+
+package sync
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/survivorbat/huhtest"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdSync(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+const pagesJSON = `[
+	{"slug": "home", "title": "home", "format": "markdown", "content": "# Home"},
+	{"slug": "guides/setup", "title": "setup", "format": "markdown", "content": "Old setup"},
+	{"slug": "old-notes", "title": "old notes", "format": "markdown", "content": "Notes"}
+]`
+
+func TestWikiSync_push(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"home.md":              "# Home\r\n\r\n",
+		"guides/setup.md":      "New setup\n",
+		"Release process.adoc": "= Releases\n",
+		"README.txt":           "Not a page",
+		".gitlab/notes.md":     "Hidden",
+	})
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis?with_content=true",
+		httpmock.NewStringResponse(http.StatusOK, pagesJSON))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/wikis",
+		`{"title": "Release process", "content": "= Releases\n", "format": "asciidoc"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"slug": "Release-process"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/wikis/guides/setup",
+		`{"content": "New setup\n", "format": "markdown"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"slug": "guides/setup"}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER%2FREPO/wikis/old-notes",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(t, fakeHTTP, dir+` --push --delete`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "+ Release-process\n~ guides/setup\n- old-notes\n", output.String())
+	assert.Equal(t, "✓ Pushed "+dir+" to the wiki of OWNER/REPO: 1 created, 1 updated, 1 deleted, 1 unchanged.\n", output.Stderr())
+}
+
+func TestWikiSync_pushDryRun(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"home.md": "# Welcome\n"})
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/wikis?with_content=true",
+		httpmock.NewStringResponse(http.StatusOK, pagesJSON))
+
+	output, err := runCommand(t, fakeHTTP, dir+` --push --delete --dry-run --group my-org`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "~ home\n- guides/setup\n- old-notes\n", output.String())
+	assert.Equal(t, "! Dry run: nothing was changed. Would have 0 created, 1 updated, 2 deleted, 0 unchanged.\n", output.Stderr())
+}
+
+func TestWikiSync_pull(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"home.md":   "# Home\n",
+		"stale.org": "* Stale",
+	})
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis?with_content=true",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"slug": "home", "title": "home", "format": "markdown", "content": "# Home"},
+			{"slug": "guides/setup", "title": "setup", "format": "asciidoc", "content": "= Setup"},
+			{"slug": "../escape", "title": "escape", "format": "markdown", "content": "Outside"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, dir+` --pull --delete --yes`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "+ guides/setup\n- stale\n", output.String())
+	assert.Contains(t, output.Stderr(), "Skipped the page ../escape")
+	assert.Contains(t, output.Stderr(), "1 created, 0 updated, 1 deleted, 1 unchanged.")
+
+	content, err := os.ReadFile(filepath.Join(dir, "guides", "setup.adoc"))
+	require.NoError(t, err)
+	assert.Equal(t, "= Setup\n", string(content))
+	assert.NoFileExists(t, filepath.Join(dir, "stale.org"))
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "escape.md"))
+}
+
+func TestWikiSync_pullDeleteRequiresYesWhenNotInteractive(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"README.md": "# Docs\n"})
+
+	_, err := runCommand(t, &httpmock.Mocker{}, dir+` --pull --delete`)
+	assert.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+	assert.FileExists(t, filepath.Join(dir, "README.md"))
+}
+
+func TestWikiSync_pullDeleteConfirmation(t *testing.T) {
+	tests := []struct {
+		name    string
+		confirm bool
+	}{
+		{name: "confirmed", confirm: true},
+		{name: "cancelled", confirm: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{
+				"home.md":   "# Home\n",
+				"README.md": "# Docs\n",
+			})
+
+			fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+			defer fakeHTTP.Verify(t)
+
+			fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis?with_content=true",
+				httpmock.NewStringResponse(http.StatusOK, `[
+					{"slug": "home", "title": "home", "format": "markdown", "content": "# Home"},
+					{"slug": "setup", "title": "setup", "format": "markdown", "content": "Setup"}
+				]`))
+
+			answer := huhtest.ConfirmNegative
+			if tc.confirm {
+				answer = huhtest.ConfirmAffirm
+			}
+			exec := cmdtest.SetupCmdForTest(t, NewCmdSync, true,
+				cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", "gitlab.com").Lab()),
+				cmdtest.WithResponder(t, huhtest.NewResponder().AddConfirm("Delete 1 file?", answer)),
+			)
+
+			output, err := exec(dir + ` --pull --delete`)
+			assert.Contains(t, output.Stderr(), "These files aren't pages of the wiki of OWNER/REPO:\n  "+filepath.Join(dir, "README.md")+"\n")
+
+			if !tc.confirm {
+				var exitErr *cmdutils.ExitError
+				require.ErrorAs(t, err, &exitErr)
+				assert.Equal(t, "action cancelled", exitErr.Details)
+				assert.FileExists(t, filepath.Join(dir, "README.md"))
+				assert.NoFileExists(t, filepath.Join(dir, "setup.md"))
+				return
+			}
+			require.NoError(t, err)
+			assert.NoFileExists(t, filepath.Join(dir, "README.md"))
+			assert.FileExists(t, filepath.Join(dir, "setup.md"))
+		})
+	}
+}
+
+func TestWikiSync_pushMissingDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "missing")
+
+	_, err := runCommand(t, &httpmock.Mocker{}, dir+` --push`)
+	assert.EqualError(t, err, dir+" is not a directory.")
+}
+
+func TestWikiSync_duplicateSlugs(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"home.md": "# Home", "home.org": "* Home"})
+
+	_, err := runCommand(t, &httpmock.Mocker{}, dir+` --push`)
+	assert.ErrorContains(t, err, "are both the page home.")
+}
+
+func TestWikiSync_direction(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, `docs`)
+	assert.ErrorContains(t, err, "at least one of the flags in the group [push pull] is required")
+}
@@ -0,0 +1,137 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/wiki/wikiutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+
+	slug         string
+	group        string
+	raw          bool
+	web          bool
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "view <slug> [flags]",
+		Short:   `Display a wiki page.`,
+		Aliases: []string{"show"},
+		Long: heredoc.Doc(`
+			Display a wiki page. Markdown pages are rendered when the output is a
+			terminal. Pages in other formats, and the output of --raw, are printed
+			as they are.
+		`),
+		Example: heredoc.Doc(`
+			$ glab wiki view home
+			$ glab wiki view guides/setup --raw > setup.md
+			$ glab wiki view runbooks/database --group my-org --web
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.slug = args[0]
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "View a page of the wiki of a group, instead of the current project.")
+	cmd.Flags().BoolVar(&opts.raw, "raw", false, "Print the content of the page without rendering it.")
+	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the page in the browser.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+	cmd.MarkFlagsMutuallyExclusive("raw", "web", "output")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	wiki, err := wikiutils.New(client, o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	if o.web {
+		webURL := wiki.WebURL(o.slug)
+		if o.io.IsOutputTTY() {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(webURL))
+		}
+		browser, _ := o.config().Get(client.BaseURL().Hostname(), "browser")
+		return utils.OpenInBrowser(webURL, browser)
+	}
+
+	page, err := wiki.Get(o.slug)
+	if err != nil {
+		if api.Is404(err) {
+			return fmt.Errorf("page %q not found in %s.", o.slug, wiki)
+		}
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to get the page %q.", o.slug))
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(page)
+	}
+
+	if o.raw || !o.io.IsOutputTTY() {
+		fmt.Fprint(o.io.StdOut, page.Content)
+		if !strings.HasSuffix(page.Content, "\n") {
+			fmt.Fprintln(o.io.StdOut)
+		}
+		return nil
+	}
+
+	content := page.Content
+	if page.Format == gitlab.WikiFormatMarkdown {
+		glamourStyle, _ := o.config().Get(client.BaseURL().Hostname(), "glamour_style")
+		o.io.ResolveBackgroundColor(glamourStyle)
+		if rendered, err := utils.RenderMarkdown(content, o.io.BackgroundColor()); err == nil {
+			content = rendered
+		}
+	}
+
+	if err := o.io.StartPager(); err != nil {
+		return err
+	}
+	defer o.io.StopPager()
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s\n\n%s\n\n%s\n", c.Bold(page.Title), strings.TrimRight(content, "\n"), c.Gray("View this page on GitLab: "+wiki.WebURL(page.Slug)))
+	return nil
+}
@@ -0,0 +1,70 @@
+//go:build !integration
+
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams(cmdtest.WithTestIOStreamsAsTTY(isTTY))
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdView(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+const pageJSON = `{"slug": "home", "title": "Home", "format": "markdown", "content": "# Welcome\n\nStart with the **setup** guide."}`
+
+func TestWikiView_raw(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis/home",
+		httpmock.NewStringResponse(http.StatusOK, pageJSON))
+
+	output, err := runCommand(t, fakeHTTP, false, `home`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "# Welcome\n\nStart with the **setup** guide.\n", output.String())
+}
+
+func TestWikiView_rendered(t *testing.T) {
+	t.Setenv("NO_COLOR", "true")
+
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER%2FREPO/wikis/home",
+		httpmock.NewStringResponse(http.StatusOK, pageJSON))
+
+	output, err := runCommand(t, fakeHTTP, true, `home`)
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "Home\n")
+	assert.Contains(t, out, "  Start with the **setup** guide.")
+	assert.Contains(t, out, "View this page on GitLab: https://gitlab.com/OWNER/REPO/-/wikis/home")
+}
+
+func TestWikiView_notFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-org/wikis/missing",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Wiki Page Not Found"}`))
+
+	_, err := runCommand(t, fakeHTTP, false, `missing --group my-org`)
+	assert.EqualError(t, err, `page "missing" not found in the wiki of the group my-org.`)
+}
@@ -0,0 +1,45 @@
+package wiki
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	wikiCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/create"
+	wikiDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/delete"
+	wikiEditCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/edit"
+	wikiListCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/list"
+	wikiSyncCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/sync"
+	wikiViewCmd "gitlab.com/gitlab-org/cli/internal/commands/wiki/view"
+)
+
+func NewCmdWiki(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wiki <command> [flags]",
+		Short: `Work with GitLab wikis.`,
+		Long: heredoc.Docf(`
+			Work with the pages of a wiki.
+
+			Pages are identified by their slug, which is the path of the page in the
+			wiki, like %[1]sguides/setup%[1]s. They belong to the wiki of the current
+			project, or to the wiki of a group when %[1]s--group%[1]s is set.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab wiki list
+			$ glab wiki view home
+			$ glab wiki create "Release process" --file release.md
+			$ glab wiki sync docs --push
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(wikiListCmd.NewCmdList(f))
+	cmd.AddCommand(wikiViewCmd.NewCmdView(f))
+	cmd.AddCommand(wikiCreateCmd.NewCmdCreate(f))
+	cmd.AddCommand(wikiEditCmd.NewCmdEdit(f))
+	cmd.AddCommand(wikiDeleteCmd.NewCmdDelete(f))
+	cmd.AddCommand(wikiSyncCmd.NewCmdSync(f))
+
+	return cmd
+}
@@ -0,0 +1,211 @@
+package wikiutils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// Formats are the formats of wiki pages.
+var Formats = []string{
+	string(gitlab.WikiFormatMarkdown),
+	string(gitlab.WikiFormatRDoc),
+	string(gitlab.WikiFormatASCIIDoc),
+	string(gitlab.WikiFormatOrg),
+}
+
+// ParseFormat returns the wiki format of a flag value.
+func ParseFormat(format string) (gitlab.WikiFormatValue, error) {
+	if !slices.Contains(Formats, format) {
+		return "", &cmdutils.FlagError{Err: fmt.Errorf("invalid format %q. Use one of: %s.", format, strings.Join(Formats, ", "))}
+	}
+	return gitlab.WikiFormatValue(format), nil
+}
+
+// Wiki is the wiki of a project, or of a group. The API of group wikis is
+// the same as the one of project wikis, and Wiki hides the difference.
+type Wiki struct {
+	client *gitlab.Client
+	// group is the path of the group, for group wikis.
+	group string
+	// project is the path of the project, for project wikis.
+	project string
+}
+
+// New returns the wiki of the group if set, or else of the current project.
+func New(client *gitlab.Client, group string, baseRepo func() (glrepo.Interface, error)) (*Wiki, error) {
+	if group != "" {
+		return &Wiki{client: client, group: group}, nil
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return nil, err
+	}
+	return &Wiki{client: client, project: repo.FullName()}, nil
+}
+
+// String returns the name of the wiki, for messages.
+func (w *Wiki) String() string {
+	if w.group != "" {
+		return "the wiki of the group " + w.group
+	}
+	return "the wiki of " + w.project
+}
+
+// List returns the pages of the wiki. Their content is only returned when
+// withContent is true.
+func (w *Wiki) List(withContent bool) ([]*gitlab.Wiki, error) {
+	if w.group != "" {
+		pages, _, err := w.client.GroupWikis.ListGroupWikis(w.group, &gitlab.ListGroupWikisOptions{WithContent: gitlab.Ptr(withContent)})
+		return fromGroupWikis(pages), err
+	}
+	pages, _, err := w.client.Wikis.ListWikis(w.project, &gitlab.ListWikisOptions{WithContent: gitlab.Ptr(withContent)})
+	return pages, err
+}
+
+// Get returns a page of the wiki.
+func (w *Wiki) Get(slug string) (*gitlab.Wiki, error) {
+	if w.group != "" {
+		page, _, err := w.client.GroupWikis.GetGroupWikiPage(w.group, slug, &gitlab.GetGroupWikiPageOptions{})
+		return (*gitlab.Wiki)(page), err
+	}
+	page, _, err := w.client.Wikis.GetWikiPage(w.project, slug, &gitlab.GetWikiPageOptions{})
+	return page, err
+}
+
+// Create creates a page in the wiki.
+func (w *Wiki) Create(title, content string, format gitlab.WikiFormatValue) (*gitlab.Wiki, error) {
+	if w.group != "" {
+		page, _, err := w.client.GroupWikis.CreateGroupWikiPage(w.group, &gitlab.CreateGroupWikiPageOptions{
+			Title:   gitlab.Ptr(title),
+			Content: gitlab.Ptr(content),
+			Format:  gitlab.Ptr(format),
+		})
+		return (*gitlab.Wiki)(page), err
+	}
+	page, _, err := w.client.Wikis.CreateWikiPage(w.project, &gitlab.CreateWikiPageOptions{
+		Title:   gitlab.Ptr(title),
+		Content: gitlab.Ptr(content),
+		Format:  gitlab.Ptr(format),
+	})
+	return page, err
+}
+
+// EditOptions are the changes to a page. Unset fields are not changed.
+type EditOptions struct {
+	Title   *string
+	Content *string
+	Format  *gitlab.WikiFormatValue
+}
+
+// Edit changes a page of the wiki.
+func (w *Wiki) Edit(slug string, opts EditOptions) (*gitlab.Wiki, error) {
+	if w.group != "" {
+		page, _, err := w.client.GroupWikis.EditGroupWikiPage(w.group, slug, &gitlab.EditGroupWikiPageOptions{
+			Title:   opts.Title,
+			Content: opts.Content,
+			Format:  opts.Format,
+		})
+		return (*gitlab.Wiki)(page), err
+	}
+	page, _, err := w.client.Wikis.EditWikiPage(w.project, slug, &gitlab.EditWikiPageOptions{
+		Title:   opts.Title,
+		Content: opts.Content,
+		Format:  opts.Format,
+	})
+	return page, err
+}
+
+// Delete deletes a page of the wiki.
+func (w *Wiki) Delete(slug string) error {
+	if w.group != "" {
+		_, err := w.client.GroupWikis.DeleteGroupWikiPage(w.group, slug)
+		return err
+	}
+	_, err := w.client.Wikis.DeleteWikiPage(w.project, slug)
+	return err
+}
+
+// WebURL returns the URL of a page in the web UI.
+func (w *Wiki) WebURL(slug string) string {
+	u := *w.client.BaseURL()
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api/v4") + "/"
+	if w.group != "" {
+		u.Path += "groups/" + w.group
+	} else {
+		u.Path += w.project
+	}
+	u.Path += "/-/wikis/" + slug
+	return u.String()
+}
+
+func fromGroupWikis(pages []*gitlab.GroupWiki) []*gitlab.Wiki {
+	if pages == nil {
+		return nil
+	}
+	wikis := make([]*gitlab.Wiki, 0, len(pages))
+	for _, page := range pages {
+		wikis = append(wikis, (*gitlab.Wiki)(page))
+	}
+	return wikis
+}
+
+// extensions are the file extensions that glab reads as wiki pages. It
+// writes pages with the extensions of Extension.
+var extensions = map[string]gitlab.WikiFormatValue{
+	".md":       gitlab.WikiFormatMarkdown,
+	".markdown": gitlab.WikiFormatMarkdown,
+	".rdoc":     gitlab.WikiFormatRDoc,
+	".adoc":     gitlab.WikiFormatASCIIDoc,
+	".asciidoc": gitlab.WikiFormatASCIIDoc,
+	".org":      gitlab.WikiFormatOrg,
+}
+
+// FormatFromPath returns the wiki format of a file, from its extension.
+func FormatFromPath(path string) (gitlab.WikiFormatValue, bool) {
+	format, ok := extensions[strings.ToLower(filepath.Ext(path))]
+	return format, ok
+}
+
+// Extension returns the file extension of a wiki format.
+func Extension(format gitlab.WikiFormatValue) string {
+	switch format {
+	case gitlab.WikiFormatRDoc:
+		return ".rdoc"
+	case gitlab.WikiFormatASCIIDoc:
+		return ".adoc"
+	case gitlab.WikiFormatOrg:
+		return ".org"
+	default:
+		return ".md"
+	}
+}
+
+// ReadContent reads the content of a page from a file, or from the standard
+// input when file is "-".
+func ReadContent(ios *iostreams.IOStreams, file string) (string, error) {
+	if file != "-" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		return string(content), nil
+	}
+
+	defer ios.In.Close()
+	content, err := io.ReadAll(ios.In)
+	if err != nil {
+		return "", fmt.Errorf("failed to read content from STDIN: %w", err)
+	}
+	return string(content), nil
+}
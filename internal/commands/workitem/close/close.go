@@ -0,0 +1,106 @@
+package close
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+const closeMutation = `mutation($input: WorkItemUpdateInput!) {
+  workItemUpdate(input: $input) {
+    workItem { ` + workitemutils.Fields + ` }
+    errors
+  }
+}`
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group string
+	iids  []string
+}
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "close <work-item>... [flags]",
+		Short: `Close work items.`,
+		Example: heredoc.Doc(`
+			$ glab work-item close 42
+			$ glab work-item close 7 8 --group gitlab-org
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				iid, err := workitemutils.ParseIID(arg)
+				if err != nil {
+					return err
+				}
+				opts.iids = append(opts.iids, iid)
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Close work items of a group, instead of the current project.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	ns, err := workitemutils.NamespaceFrom(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	for _, iid := range o.iids {
+		item, err := workitemutils.Get(client, ns, iid, "id")
+		if err != nil {
+			return err
+		}
+
+		var data struct {
+			WorkItemUpdate struct {
+				WorkItem *workitemutils.WorkItem `json:"workItem"`
+				Errors   []string                `json:"errors"`
+			} `json:"workItemUpdate"`
+		}
+		input := map[string]any{"id": item.ID, "stateEvent": "CLOSE"}
+		if err := api.GraphQL(client, closeMutation, map[string]any{"input": input}, &data); err != nil {
+			return fmt.Errorf("failed to close work item #%s: %w", iid, err)
+		}
+		if len(data.WorkItemUpdate.Errors) > 0 {
+			return fmt.Errorf("failed to close work item #%s: %s", iid, strings.Join(data.WorkItemUpdate.Errors, "; "))
+		}
+
+		closed := data.WorkItemUpdate.WorkItem
+		fmt.Fprintf(o.io.StdOut, "%s Closed %s #%s: %s\n", o.io.Color().RedCheck(), strings.ToLower(closed.WorkItemType.Name), closed.IID, closed.Title)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build !integration
+
+package close
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdClose(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+type graphQLCall struct {
+	// query is a part of the expected query.
+	query string
+	// variables are the expected variables, as JSON. Not checked if empty.
+	variables string
+	response  string
+}
+
+// graphQLResponder returns a responder that answers GraphQL requests with
+// the calls, in order.
+func graphQLResponder(t *testing.T, calls ...graphQLCall) httpmock.Responder {
+	t.Helper()
+
+	n := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, n, len(calls), "unexpected GraphQL request")
+		call := calls[n]
+		n++
+
+		var body struct {
+			Query     string          `json:"query"`
+			Variables json.RawMessage `json:"variables"`
+		}
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body.Query, call.query)
+		if call.variables != "" {
+			assert.JSONEq(t, call.variables, string(body.Variables))
+		}
+		return httpmock.NewStringResponse(http.StatusOK, call.response)(req)
+	}
+}
+
+func TestWorkItemClose(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql", graphQLResponder(t,
+		graphQLCall{
+			query:     "namespace: group(fullPath: $fullPath)",
+			variables: `{"fullPath": "gitlab-org", "iid": "7"}`,
+			response:  `{"data": {"namespace": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/70"}]}}}}`,
+		},
+		graphQLCall{
+			query:     "workItemUpdate(input: $input)",
+			variables: `{"input": {"id": "gid://gitlab/WorkItem/70", "stateEvent": "CLOSE"}}`,
+			response: `{"data": {"workItemUpdate": {"errors": [], "workItem": {
+				"iid": "7", "title": "Faster pipelines", "state": "CLOSED", "workItemType": {"name": "Objective"}
+			}}}}`,
+		},
+	))
+
+	output, err := runCommand(t, fakeHTTP, "7 --group gitlab-org")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Closed objective #7: Faster pipelines\n", output.String())
+}
@@ -0,0 +1,166 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+const typeQuery = `query($fullPath: ID!, $name: IssueType) {
+  namespace: %s(fullPath: $fullPath) {
+    workItemTypes(name: $name) { nodes { id name } }
+  }
+}`
+
+const createMutation = `mutation($input: WorkItemCreateInput!) {
+  workItemCreate(input: $input) {
+    workItem { ` + workitemutils.Fields + ` }
+    errors
+  }
+}`
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group       string
+	itemType    string
+	title       string
+	description string
+	parent      string
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create a work item.`,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			# Create a task under issue 42
+			$ glab work-item create --type task --title "Write the migration" --parent 42
+
+			# Create a key result under an objective of a group
+			$ glab work-item create --group gitlab-org --type key_result --title "Reduce p95 latency" --parent 7
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := workitemutils.ParseType(opts.itemType); err != nil {
+				return err
+			}
+			if opts.parent != "" {
+				var err error
+				if opts.parent, err = workitemutils.ParseIID(opts.parent); err != nil {
+					return err
+				}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Create the work item in a group, instead of the current project.")
+	cmd.Flags().StringVar(&opts.itemType, "type", "", "Type of the work item: task, objective, key_result, issue, incident, epic, requirement, test_case, ticket.")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the work item.")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the work item.")
+	cmd.Flags().StringVar(&opts.parent, "parent", "", "IID of the parent work item.")
+	_ = cmd.MarkFlagRequired("type")
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	ns, err := workitemutils.NamespaceFrom(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	typeID, err := o.typeID(client, ns)
+	if err != nil {
+		return err
+	}
+
+	input := map[string]any{
+		"namespacePath":  ns.FullPath,
+		"title":          o.title,
+		"workItemTypeId": typeID,
+	}
+	if o.description != "" {
+		input["descriptionWidget"] = map[string]any{"description": o.description}
+	}
+	if o.parent != "" {
+		parent, err := workitemutils.Get(client, ns, o.parent, "id")
+		if err != nil {
+			return err
+		}
+		input["hierarchyWidget"] = map[string]any{"parentId": parent.ID}
+	}
+
+	var data struct {
+		WorkItemCreate struct {
+			WorkItem *workitemutils.WorkItem `json:"workItem"`
+			Errors   []string                `json:"errors"`
+		} `json:"workItemCreate"`
+	}
+	if err := api.GraphQL(client, createMutation, map[string]any{"input": input}, &data); err != nil {
+		return fmt.Errorf("failed to create the work item: %w", err)
+	}
+	if len(data.WorkItemCreate.Errors) > 0 {
+		return fmt.Errorf("failed to create the work item: %s", strings.Join(data.WorkItemCreate.Errors, "; "))
+	}
+
+	item := data.WorkItemCreate.WorkItem
+	fmt.Fprintf(o.io.StdOut, "%s Created %s #%s: %s\n%s\n", o.io.Color().GreenCheck(), strings.ToLower(item.WorkItemType.Name), item.IID, item.Title, item.WebURL)
+	return nil
+}
+
+// typeID returns the global ID of the work item type in the namespace.
+func (o *options) typeID(client *gitlab.Client, ns workitemutils.Namespace) (string, error) {
+	name, _ := workitemutils.ParseType(o.itemType)
+
+	var data struct {
+		Namespace *struct {
+			WorkItemTypes struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+			} `json:"workItemTypes"`
+		} `json:"namespace"`
+	}
+	query := fmt.Sprintf(typeQuery, ns.Field())
+	if err := api.GraphQL(client, query, map[string]any{"fullPath": ns.FullPath, "name": name}, &data); err != nil {
+		return "", fmt.Errorf("failed to get the work item types: %w", err)
+	}
+	if data.Namespace == nil {
+		return "", fmt.Errorf("%s %s not found.", ns.Field(), ns.FullPath)
+	}
+	if len(data.Namespace.WorkItemTypes.Nodes) == 0 {
+		return "", fmt.Errorf("work item type %q is not available in %s.", o.itemType, ns.FullPath)
+	}
+	return data.Namespace.WorkItemTypes.Nodes[0].ID, nil
+}
@@ -0,0 +1,128 @@
+//go:build !integration
+
+package create
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdCreate(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+type graphQLCall struct {
+	// query is a part of the expected query.
+	query string
+	// variables are the expected variables, as JSON. Not checked if empty.
+	variables string
+	response  string
+}
+
+// graphQLResponder returns a responder that answers GraphQL requests with
+// the calls, in order.
+func graphQLResponder(t *testing.T, calls ...graphQLCall) httpmock.Responder {
+	t.Helper()
+
+	n := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, n, len(calls), "unexpected GraphQL request")
+		call := calls[n]
+		n++
+
+		var body struct {
+			Query     string          `json:"query"`
+			Variables json.RawMessage `json:"variables"`
+		}
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body.Query, call.query)
+		if call.variables != "" {
+			assert.JSONEq(t, call.variables, string(body.Variables))
+		}
+		return httpmock.NewStringResponse(http.StatusOK, call.response)(req)
+	}
+}
+
+func TestWorkItemCreate(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql", graphQLResponder(t,
+		graphQLCall{
+			query:     "workItemTypes(name: $name)",
+			variables: `{"fullPath": "OWNER/REPO", "name": "TASK"}`,
+			response:  `{"data": {"namespace": {"workItemTypes": {"nodes": [{"id": "gid://gitlab/WorkItems::Type/5"}]}}}}`,
+		},
+		graphQLCall{
+			query:     "workItems(iid: $iid)",
+			variables: `{"fullPath": "OWNER/REPO", "iid": "42"}`,
+			response:  `{"data": {"namespace": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/420"}]}}}}`,
+		},
+		graphQLCall{
+			query: "workItemCreate(input: $input)",
+			variables: `{"input": {
+				"namespacePath": "OWNER/REPO",
+				"title": "Write the migration",
+				"workItemTypeId": "gid://gitlab/WorkItems::Type/5",
+				"descriptionWidget": {"description": "Add the column."},
+				"hierarchyWidget": {"parentId": "gid://gitlab/WorkItem/420"}
+			}}`,
+			response: `{"data": {"workItemCreate": {"errors": [], "workItem": {
+				"iid": "43", "title": "Write the migration", "workItemType": {"name": "Task"},
+				"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/43"
+			}}}}`,
+		},
+	))
+
+	output, err := runCommand(t, fakeHTTP, `--type task --title "Write the migration" --description "Add the column." --parent 42`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Created task #43: Write the migration\nhttps://gitlab.com/OWNER/REPO/-/work_items/43\n", output.String())
+}
+
+func TestWorkItemCreate_errors(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterReusableResponder(http.MethodPost, "/api/graphql", graphQLResponder(t,
+		graphQLCall{
+			response: `{"data": {"namespace": {"workItemTypes": {"nodes": [{"id": "gid://gitlab/WorkItems::Type/6"}]}}}}`,
+		},
+		graphQLCall{
+			response: `{"data": {"workItemCreate": {"errors": ["Objectives are not enabled."], "workItem": null}}}`,
+		},
+	))
+
+	_, err := runCommand(t, fakeHTTP, `--type objective --title Goal`)
+	assert.EqualError(t, err, "failed to create the work item: Objectives are not enabled.")
+}
+
+func TestWorkItemCreate_unavailableType(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", graphQLResponder(t, graphQLCall{
+		response: `{"data": {"namespace": {"workItemTypes": {"nodes": []}}}}`,
+	}))
+
+	_, err := runCommand(t, fakeHTTP, `--type ticket --title Help`)
+	assert.EqualError(t, err, `work item type "ticket" is not available in OWNER/REPO.`)
+}
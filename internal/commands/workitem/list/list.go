@@ -0,0 +1,146 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	types        []string
+	state        string
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List work items.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			# List the open tasks of the current project
+			$ glab work-item list --type task
+
+			# List the objectives and key results of a group
+			$ glab work-item list --group gitlab-org --type objective,key_result --state all
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "List the work items of a group, instead of the current project.")
+	cmd.Flags().StringSliceVarP(&opts.types, "type", "t", []string{}, "Filter work items by type: task, objective, key_result, issue, incident, epic, requirement, test_case, ticket.")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "opened", "Filter work items by state: opened, closed, all.")
+	cmd.Flags().IntVarP(&opts.perPage, "per-page", "P", 30, "Number of work items to list.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	for _, t := range o.types {
+		if _, err := workitemutils.ParseType(t); err != nil {
+			return err
+		}
+	}
+	switch o.state {
+	case "opened", "closed", "all":
+	default:
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid state %q. Use one of: opened, closed, all.", o.state)}
+	}
+	if o.outputFormat != "text" && o.outputFormat != "json" {
+		return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", o.outputFormat)}
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	ns, err := workitemutils.NamespaceFrom(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	variables := map[string]any{
+		"fullPath": ns.FullPath,
+		"state":    o.state,
+		"first":    o.perPage,
+	}
+	if len(o.types) > 0 {
+		types := make([]string, 0, len(o.types))
+		for _, t := range o.types {
+			enum, _ := workitemutils.ParseType(t)
+			types = append(types, enum)
+		}
+		variables["types"] = types
+	}
+
+	query := fmt.Sprintf(`query($fullPath: ID!, $types: [IssueType!], $state: IssuableState, $first: Int) {
+  namespace: %s(fullPath: $fullPath) {
+    workItems(types: $types, state: $state, first: $first) { nodes { %s } }
+  }
+}`, ns.Field(), workitemutils.Fields)
+
+	var data struct {
+		Namespace *struct {
+			WorkItems struct {
+				Nodes []*workitemutils.WorkItem `json:"nodes"`
+			} `json:"workItems"`
+		} `json:"namespace"`
+	}
+	if err := api.GraphQL(client, query, variables, &data); err != nil {
+		return fmt.Errorf("failed to list work items: %w", err)
+	}
+	if data.Namespace == nil {
+		return fmt.Errorf("%s %s not found.", ns.Field(), ns.FullPath)
+	}
+	items := data.Namespace.WorkItems.Nodes
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(items)
+	}
+
+	title := utils.NewListTitle("work item")
+	title.RepoName = ns.FullPath
+	title.Page = 0
+	title.CurrentPageTotal = len(items)
+
+	fmt.Fprintf(o.io.StdOut, "%s\n%s\n", title.Describe(), workitemutils.DisplayList(o.io, items))
+	return nil
+}
@@ -0,0 +1,105 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdList(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+type graphQLCall struct {
+	// query is a part of the expected query.
+	query string
+	// variables are the expected variables, as JSON. Not checked if empty.
+	variables string
+	response  string
+}
+
+// graphQLResponder returns a responder that answers GraphQL requests with
+// the calls, in order.
+func graphQLResponder(t *testing.T, calls ...graphQLCall) httpmock.Responder {
+	t.Helper()
+
+	n := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, n, len(calls), "unexpected GraphQL request")
+		call := calls[n]
+		n++
+
+		var body struct {
+			Query     string          `json:"query"`
+			Variables json.RawMessage `json:"variables"`
+		}
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body.Query, call.query)
+		if call.variables != "" {
+			assert.JSONEq(t, call.variables, string(body.Variables))
+		}
+		return httpmock.NewStringResponse(http.StatusOK, call.response)(req)
+	}
+}
+
+func TestWorkItemList(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", graphQLResponder(t, graphQLCall{
+		query:     "namespace: project(fullPath: $fullPath)",
+		variables: `{"fullPath": "OWNER/REPO", "state": "opened", "first": 30, "types": ["OBJECTIVE", "KEY_RESULT"]}`,
+		response: `{"data": {"namespace": {"workItems": {"nodes": [
+			{"iid": "7", "title": "Faster pipelines", "state": "OPEN", "workItemType": {"name": "Objective"}, "author": {"username": "alice"}},
+			{"iid": "8", "title": "p95 under 10 minutes", "state": "OPEN", "workItemType": {"name": "Key Result"}, "author": {"username": "bob"}}
+		]}}}}`,
+	}))
+
+	output, err := runCommand(t, fakeHTTP, "--type objective,key_result")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "Showing 2 work items on OWNER/REPO.")
+	assert.Contains(t, out, "#7\tObjective\tFaster pipelines\t@alice")
+	assert.Contains(t, out, "#8\tKey Result\tp95 under 10 minutes\t@bob")
+}
+
+func TestWorkItemList_group(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", graphQLResponder(t, graphQLCall{
+		query:     "namespace: group(fullPath: $fullPath)",
+		variables: `{"fullPath": "gitlab-org", "state": "all", "first": 5}`,
+		response:  `{"data": {"namespace": {"workItems": {"nodes": []}}}}`,
+	}))
+
+	output, err := runCommand(t, fakeHTTP, "--group gitlab-org --state all -P 5")
+	require.NoError(t, err)
+
+	assert.Equal(t, "No work items available on gitlab-org.\n\n", output.String())
+}
+
+func TestWorkItemList_invalidType(t *testing.T) {
+	_, err := runCommand(t, &httpmock.Mocker{}, "--type story")
+	assert.EqualError(t, err, `invalid work item type "story". Use one of: task, objective, key_result, issue, incident, epic, requirement, test_case, ticket.`)
+}
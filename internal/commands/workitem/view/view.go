@@ -0,0 +1,160 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/workitem/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// fields are the fields of the work item and its widgets that view displays.
+var fields = workitemutils.Fields + `
+      widgets {
+        type
+        ... on WorkItemWidgetDescription { description }
+        ... on WorkItemWidgetAssignees { assignees { nodes { username } } }
+        ... on WorkItemWidgetLabels { labels { nodes { title } } }
+        ... on WorkItemWidgetProgress { progress }
+        ... on WorkItemWidgetHierarchy {
+          parent { ` + workitemutils.Fields + ` }
+          children { nodes { ` + workitemutils.Fields + ` } }
+        }
+      }`
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	group        string
+	iid          string
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "view <work-item> [flags]",
+		Short:   `Display a work item, with its parent and children.`,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab work-item view 42
+			$ glab work-item view 7 --group gitlab-org
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if opts.iid, err = workitemutils.ParseIID(args[0]); err != nil {
+				return err
+			}
+			if opts.outputFormat != "text" && opts.outputFormat != "json" {
+				return cmdutils.FlagError{Err: fmt.Errorf("invalid output format %q. Use one of: text, json.", opts.outputFormat)}
+			}
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Get the work item from a group, instead of the current project.")
+	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	ns, err := workitemutils.NamespaceFrom(o.group, o.baseRepo)
+	if err != nil {
+		return err
+	}
+
+	item, err := workitemutils.Get(client, ns, o.iid, fields)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		return json.NewEncoder(o.io.StdOut).Encode(item)
+	}
+
+	c := o.io.Color()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s %s\n", workitemutils.State(c, item), c.Gray("["+item.WorkItemType.Name+"]"), c.Bold(item.Title))
+
+	state := "Open"
+	if item.State != "OPEN" {
+		state = "Closed"
+	}
+	author := ""
+	if item.Author != nil {
+		author = " by @" + item.Author.Username
+	}
+	created := ""
+	if item.CreatedAt != nil {
+		created = " " + utils.TimeToPrettyTimeAgo(*item.CreatedAt)
+	}
+	fmt.Fprintf(&sb, "%s%s%s\n", state, author, c.Gray(created))
+
+	if w := item.Widget("ASSIGNEES"); w != nil && w.Assignees != nil && len(w.Assignees.Nodes) > 0 {
+		assignees := make([]string, 0, len(w.Assignees.Nodes))
+		for _, a := range w.Assignees.Nodes {
+			assignees = append(assignees, "@"+a.Username)
+		}
+		fmt.Fprintf(&sb, "Assignees: %s\n", strings.Join(assignees, ", "))
+	}
+	if w := item.Widget("LABELS"); w != nil && w.Labels != nil && len(w.Labels.Nodes) > 0 {
+		labels := make([]string, 0, len(w.Labels.Nodes))
+		for _, l := range w.Labels.Nodes {
+			labels = append(labels, l.Title)
+		}
+		fmt.Fprintf(&sb, "Labels: %s\n", c.Cyan(strings.Join(labels, ", ")))
+	}
+	if w := item.Widget("PROGRESS"); w != nil && w.Progress != nil {
+		fmt.Fprintf(&sb, "Progress: %d%%\n", *w.Progress)
+	}
+
+	hierarchy := item.Widget("HIERARCHY")
+	if hierarchy != nil && hierarchy.Parent != nil {
+		fmt.Fprintf(&sb, "Parent: %s\n", workitemutils.Label(c, hierarchy.Parent))
+	}
+
+	if w := item.Widget("DESCRIPTION"); w != nil && w.Description != "" {
+		description, _ := utils.RenderMarkdown(w.Description, o.io.BackgroundColor())
+		fmt.Fprintf(&sb, "\n%s\n", strings.TrimSpace(description))
+	}
+
+	if hierarchy != nil && hierarchy.Children != nil && len(hierarchy.Children.Nodes) > 0 {
+		tree := &utils.TreeNode{Label: workitemutils.Label(c, item)}
+		for _, child := range hierarchy.Children.Nodes {
+			tree.Children = append(tree.Children, &utils.TreeNode{Label: workitemutils.Label(c, child)})
+		}
+		fmt.Fprintf(&sb, "\n%s\n%s", c.Bold("Children"), utils.RenderTree(tree))
+	}
+
+	fmt.Fprintf(&sb, "\n%s\n", c.Gray("View this work item on GitLab: "+item.WebURL))
+
+	fmt.Fprint(o.io.StdOut, sb.String())
+	return nil
+}
@@ -0,0 +1,107 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	factory := cmdtest.NewTestFactory(ios,
+		cmdtest.WithGitLabClient(cmdtest.NewTestApiClient(t, &http.Client{Transport: rt}, "", "gitlab.com").Lab()),
+	)
+	cmd := NewCmdView(factory)
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+type graphQLCall struct {
+	// query is a part of the expected query.
+	query string
+	// variables are the expected variables, as JSON. Not checked if empty.
+	variables string
+	response  string
+}
+
+// graphQLResponder returns a responder that answers GraphQL requests with
+// the calls, in order.
+func graphQLResponder(t *testing.T, calls ...graphQLCall) httpmock.Responder {
+	t.Helper()
+
+	n := 0
+	return func(req *http.Request) (*http.Response, error) {
+		require.Less(t, n, len(calls), "unexpected GraphQL request")
+		call := calls[n]
+		n++
+
+		var body struct {
+			Query     string          `json:"query"`
+			Variables json.RawMessage `json:"variables"`
+		}
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.Contains(t, body.Query, call.query)
+		if call.variables != "" {
+			assert.JSONEq(t, call.variables, string(body.Variables))
+		}
+		return httpmock.NewStringResponse(http.StatusOK, call.response)(req)
+	}
+}
+
+func TestWorkItemView(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", graphQLResponder(t, graphQLCall{
+		query:     "... on WorkItemWidgetHierarchy",
+		variables: `{"fullPath": "OWNER/REPO", "iid": "7"}`,
+		response: `{"data": {"namespace": {"workItems": {"nodes": [{
+			"id": "gid://gitlab/WorkItem/70", "iid": "7", "title": "Faster pipelines", "state": "OPEN",
+			"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/7",
+			"workItemType": {"name": "Objective"}, "author": {"username": "alice"},
+			"widgets": [
+				{"type": "ASSIGNEES", "assignees": {"nodes": [{"username": "bob"}]}},
+				{"type": "LABELS", "labels": {"nodes": [{"title": "ci"}]}},
+				{"type": "PROGRESS", "progress": 40},
+				{"type": "HIERARCHY", "parent": null, "children": {"nodes": [
+					{"iid": "8", "title": "p95 under 10 minutes", "state": "OPEN", "workItemType": {"name": "Key Result"}},
+					{"iid": "9", "title": "Cache dependencies", "state": "CLOSED", "workItemType": {"name": "Key Result"}}
+				]}}
+			]
+		}]}}}}`,
+	}))
+
+	output, err := runCommand(t, fakeHTTP, "7")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "#7 [Objective] Faster pipelines\nOpen by @alice\n")
+	assert.Contains(t, out, "Assignees: @bob\nLabels: ci\nProgress: 40%\n")
+	assert.Contains(t, out, "Children\n├── #8 [Key Result] p95 under 10 minutes\n└── #9 [Key Result] Cache dependencies (closed)\n")
+	assert.Contains(t, out, "View this work item on GitLab: https://gitlab.com/OWNER/REPO/-/work_items/7")
+}
+
+func TestWorkItemView_notFound(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql", graphQLResponder(t, graphQLCall{
+		response: `{"data": {"namespace": {"workItems": {"nodes": []}}}}`,
+	}))
+
+	_, err := runCommand(t, fakeHTTP, "7")
+	assert.EqualError(t, err, "work item #7 not found in OWNER/REPO.")
+}
@@ -0,0 +1,40 @@
+package workitem
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	workItemCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/close"
+	workItemCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/create"
+	workItemListCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/list"
+	workItemViewCmd "gitlab.com/gitlab-org/cli/internal/commands/workitem/view"
+)
+
+func NewCmdWorkItem(f cmdutils.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "work-item <command> [flags]",
+		Short:   `Work with GitLab work items, like tasks, objectives, and key results.`,
+		Aliases: []string{"workitem", "wi"},
+		Long: heredoc.Docf(`
+			Work with the work items of a project or group, through the GraphQL work items API.
+
+			Work items are identified by their IID. They belong to the current project, or
+			to a group when %[1]s--group%[1]s is set.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab work-item list --type objective
+			$ glab work-item view 42
+			$ glab work-item create --type task --title "Write the migration" --parent 42
+		`),
+	}
+
+	cmdutils.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(workItemListCmd.NewCmdList(f))
+	cmd.AddCommand(workItemViewCmd.NewCmdView(f))
+	cmd.AddCommand(workItemCreateCmd.NewCmdCreate(f))
+	cmd.AddCommand(workItemCloseCmd.NewCmdClose(f))
+
+	return cmd
+}
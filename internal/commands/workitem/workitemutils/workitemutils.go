@@ -0,0 +1,205 @@
+package workitemutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// Types maps the work item types accepted by the --type flag to their
+// GraphQL enum values.
+var Types = map[string]string{
+	"task":        "TASK",
+	"objective":   "OBJECTIVE",
+	"key_result":  "KEY_RESULT",
+	"issue":       "ISSUE",
+	"incident":    "INCIDENT",
+	"epic":        "EPIC",
+	"requirement": "REQUIREMENT",
+	"test_case":   "TEST_CASE",
+	"ticket":      "TICKET",
+}
+
+// TypeNames returns the work item types accepted by the --type flag.
+func TypeNames() []string {
+	return []string{"task", "objective", "key_result", "issue", "incident", "epic", "requirement", "test_case", "ticket"}
+}
+
+// ParseType returns the GraphQL enum value of a work item type.
+func ParseType(name string) (string, error) {
+	if t, ok := Types[strings.ToLower(strings.ReplaceAll(name, "-", "_"))]; ok {
+		return t, nil
+	}
+	return "", cmdutils.FlagError{Err: fmt.Errorf("invalid work item type %q. Use one of: %s.", name, strings.Join(TypeNames(), ", "))}
+}
+
+// ParseIID parses a work item IID, with or without the # prefix.
+func ParseIID(arg string) (string, error) {
+	iid, err := strconv.ParseInt(strings.TrimPrefix(arg, "#"), 10, 64)
+	if err != nil || iid <= 0 {
+		return "", cmdutils.FlagError{Err: fmt.Errorf("invalid work item %q. Use the work item IID, like 12.", arg)}
+	}
+	return strconv.FormatInt(iid, 10), nil
+}
+
+// Namespace is the project or group that contains work items.
+type Namespace struct {
+	FullPath string
+	IsGroup  bool
+}
+
+// Field returns the GraphQL query field of the namespace.
+func (n Namespace) Field() string {
+	if n.IsGroup {
+		return "group"
+	}
+	return "project"
+}
+
+// NamespaceFrom returns the group flag if set, or else the current project.
+func NamespaceFrom(group string, baseRepo func() (glrepo.Interface, error)) (Namespace, error) {
+	if group != "" {
+		return Namespace{FullPath: group, IsGroup: true}, nil
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return Namespace{}, err
+	}
+	return Namespace{FullPath: repo.FullName()}, nil
+}
+
+// WorkItem is a work item, with the widgets requested by the query.
+type WorkItem struct {
+	ID           string     `json:"id"`
+	IID          string     `json:"iid"`
+	Title        string     `json:"title"`
+	State        string     `json:"state"`
+	WebURL       string     `json:"webUrl"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	WorkItemType struct {
+		Name string `json:"name"`
+	} `json:"workItemType"`
+	Author *struct {
+		Username string `json:"username"`
+	} `json:"author,omitempty"`
+	Widgets []Widget `json:"widgets,omitempty"`
+}
+
+// Widget holds the fields of all widgets the commands query. Only the fields
+// of its type are set.
+type Widget struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Assignees   *struct {
+		Nodes []struct {
+			Username string `json:"username"`
+		} `json:"nodes"`
+	} `json:"assignees,omitempty"`
+	Labels *struct {
+		Nodes []struct {
+			Title string `json:"title"`
+		} `json:"nodes"`
+	} `json:"labels,omitempty"`
+	Parent   *WorkItem `json:"parent,omitempty"`
+	Children *struct {
+		Nodes []*WorkItem `json:"nodes"`
+	} `json:"children,omitempty"`
+	Progress *int `json:"progress,omitempty"`
+}
+
+// Widget returns the widget of a type, or nil if the work item has none.
+func (w *WorkItem) Widget(widgetType string) *Widget {
+	for i := range w.Widgets {
+		if w.Widgets[i].Type == widgetType {
+			return &w.Widgets[i]
+		}
+	}
+	return nil
+}
+
+// Fields are the fields of a work item that all commands query.
+const Fields = `id iid title state webUrl createdAt workItemType { name } author { username }`
+
+// Get returns the work item with an IID in a namespace.
+func Get(client *gitlab.Client, ns Namespace, iid, fields string) (*WorkItem, error) {
+	query := fmt.Sprintf(`query($fullPath: ID!, $iid: String) {
+  namespace: %s(fullPath: $fullPath) {
+    workItems(iid: $iid) { nodes { %s } }
+  }
+}`, ns.Field(), fields)
+
+	var data struct {
+		Namespace *struct {
+			WorkItems struct {
+				Nodes []*WorkItem `json:"nodes"`
+			} `json:"workItems"`
+		} `json:"namespace"`
+	}
+	if err := api.GraphQL(client, query, map[string]any{"fullPath": ns.FullPath, "iid": iid}, &data); err != nil {
+		return nil, fmt.Errorf("failed to get work item #%s: %w", iid, err)
+	}
+	if data.Namespace == nil {
+		return nil, fmt.Errorf("%s %s not found.", ns.Field(), ns.FullPath)
+	}
+	if len(data.Namespace.WorkItems.Nodes) == 0 {
+		return nil, fmt.Errorf("work item #%s not found in %s.", iid, ns.FullPath)
+	}
+	return data.Namespace.WorkItems.Nodes[0], nil
+}
+
+// State returns the reference of a work item, colored by its state.
+func State(c *iostreams.ColorPalette, w *WorkItem) string {
+	if w.State == "OPEN" {
+		return c.Green("#" + w.IID)
+	}
+	return c.Red("#" + w.IID)
+}
+
+// Label returns the tree label of a work item.
+func Label(c *iostreams.ColorPalette, w *WorkItem) string {
+	label := fmt.Sprintf("%s %s %s", State(c, w), c.Gray("["+w.WorkItemType.Name+"]"), w.Title)
+	if w.State != "OPEN" {
+		label += c.Gray(" (closed)")
+	}
+	return label
+}
+
+func DisplayList(streams *iostreams.IOStreams, items []*WorkItem) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(streams.IsOutputTTY())
+
+	if len(items) > 0 {
+		table.AddRow("IID", "Type", "Title", "Author", "Created at")
+	}
+
+	for _, item := range items {
+		table.AddCell(streams.Hyperlink(State(c, item), item.WebURL))
+		table.AddCell(item.WorkItemType.Name)
+		table.AddCell(item.Title)
+		if item.Author != nil {
+			table.AddCell("@" + item.Author.Username)
+		} else {
+			table.AddCell("")
+		}
+		if item.CreatedAt != nil {
+			table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(*item.CreatedAt)))
+		} else {
+			table.AddCell("")
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
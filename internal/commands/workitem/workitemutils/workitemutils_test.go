@@ -0,0 +1,81 @@
+//go:build !integration
+
+package workitemutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/testing/httpmock"
+)
+
+func TestParseType(t *testing.T) {
+	for name, want := range map[string]string{"task": "TASK", "key_result": "KEY_RESULT", "Key-Result": "KEY_RESULT"} {
+		got, err := ParseType(name)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	_, err := ParseType("story")
+	assert.EqualError(t, err, `invalid work item type "story". Use one of: task, objective, key_result, issue, incident, epic, requirement, test_case, ticket.`)
+}
+
+func TestParseIID(t *testing.T) {
+	iid, err := ParseIID("#42")
+	require.NoError(t, err)
+	assert.Equal(t, "42", iid)
+
+	_, err = ParseIID("abc")
+	assert.EqualError(t, err, `invalid work item "abc". Use the work item IID, like 12.`)
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "found",
+			response: `{"data": {"namespace": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/1", "iid": "42"}]}}}}`,
+		},
+		{
+			name:     "not found",
+			response: `{"data": {"namespace": {"workItems": {"nodes": []}}}}`,
+			wantErr:  "work item #42 not found in OWNER/REPO.",
+		},
+		{
+			name:     "no project",
+			response: `{"data": {"namespace": null}}`,
+			wantErr:  "project OWNER/REPO not found.",
+		},
+		{
+			name:     "graphql errors",
+			response: `{"data": null, "errors": [{"message": "first"}, {"message": "second"}]}`,
+			wantErr:  "failed to get work item #42: first; second",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := &httpmock.Mocker{MatchURL: httpmock.PathAndQuerystring}
+			defer fakeHTTP.Verify(t)
+
+			fakeHTTP.RegisterResponder(http.MethodPost, "/api/graphql",
+				httpmock.NewStringResponse(http.StatusOK, tc.response))
+
+			client := cmdtest.NewTestApiClient(t, &http.Client{Transport: fakeHTTP}, "", "gitlab.com").Lab()
+			item, err := Get(client, Namespace{FullPath: "OWNER/REPO"}, "42", "id iid")
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "gid://gitlab/WorkItem/1", item.ID)
+		})
+	}
+}
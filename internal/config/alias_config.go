@@ -7,7 +7,8 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-func aliasesConfigFile() string {
+// AliasesConfigFile returns the path of the file of the aliases.
+func AliasesConfigFile() string {
 	return path.Join(ConfigDir(), "aliases.yml")
 }
 
@@ -50,7 +51,7 @@ func (a *AliasConfig) Write() error {
 	if err != nil {
 		return err
 	}
-	err = WriteConfigFile(aliasesConfigFile(), yamlNormalize(aliasesBytes))
+	err = WriteConfigFile(AliasesConfigFile(), yamlNormalize(aliasesBytes))
 	if err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"sync"
 
 	"github.com/zalando/go-keyring"
 	"gopkg.in/yaml.v3"
@@ -50,6 +51,9 @@ func isNotFoundError(err error) bool {
 type HostConfig struct {
 	ConfigMap
 	Host string
+
+	// profile is the name of the profile the configuration belongs to, if any.
+	profile string
 }
 
 // ConfigMap type implements a low-level get/set config that is backed by an in-memory tree of YAML
@@ -161,10 +165,25 @@ func NewBlankRoot() *yaml.Node {
 	return rootConfig()
 }
 
+// DefaultSource is the source of the values that are not configured.
+const DefaultSource = "default"
+
 // A fileConfig reads and writes glab configuration to a file on disk.
 type fileConfig struct {
 	ConfigMap
 	documentRoot *yaml.Node
+
+	// repoFile is the path of the repository configuration file, if any.
+	repoFile string
+	// loadRepo is whether to load the repository configuration file, which
+	// repoOnce does on first use, for the commands that read its settings.
+	loadRepo bool
+	repoOnce sync.Once
+	// skipRepo is whether to ignore the repository configuration.
+	skipRepo bool
+	// systemWide is whether the configuration file is a system-wide one,
+	// which the repository configuration takes precedence over.
+	systemWide bool
 }
 
 func (c *fileConfig) Root() *yaml.Node {
@@ -186,6 +205,17 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (st
 
 	key = ConfigKeyEquivalence(key)
 
+	// The host of the active profile is the default host.
+	if hostname == "" && key == "host" {
+		profile, err := c.activeProfile()
+		if err != nil {
+			return "", "", err
+		}
+		if profile != nil && profile.Host != "" {
+			return profile.Host, ConfigFile(), nil
+		}
+	}
+
 	var cfgError error
 
 	if hostname != "" {
@@ -201,7 +231,8 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (st
 				return "", "", err
 			}
 
-			if (err != nil || hostValue == "") && key == "token" {
+			// Profiles store their token in the configuration file only.
+			if (err != nil || hostValue == "") && key == "token" && hostCfg.profile == "" {
 				token, err := keyring.Get("glab:"+hostname, "")
 
 				if err == nil {
@@ -222,23 +253,31 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (st
 	value, err := l.GetStringValue(key)
 
 	if (err != nil && isNotFoundError(err)) || value == "" {
+		// The repository configuration takes precedence over system-wide
+		// configuration files, but not over the configuration of the user.
+		if c.systemWide {
+			if value := c.repoValue(key); value != "" {
+				return value, c.repoConfigFile(), cfgError
+			}
+		}
+
 		value, err = c.GetStringValue(key)
-		if err != nil && isNotFoundError(err) {
-			return defaultFor(key), source, cfgError
-		} else if err != nil {
+		if err != nil && !isNotFoundError(err) {
 			if hostname != "" {
 				err = cfgError
 			}
 			return "", LocalConfigFile(), err
 		}
-	} else if value != "" {
+		if value == "" {
+			if value := c.repoValue(key); value != "" {
+				return value, c.repoConfigFile(), cfgError
+			}
+			return defaultFor(key), DefaultSource, cfgError
+		}
+	} else {
 		source = LocalConfigFile()
 	}
 
-	if value == "" {
-		return defaultFor(key), source, cfgError
-	}
-
 	return value, source, cfgError
 }
 
@@ -278,7 +317,7 @@ func (c *fileConfig) Write() error {
 
 	nodes := c.documentRoot.Content[0].Content
 	for i := 0; i < len(nodes)-1; i += 2 {
-		if nodes[i].Value == "aliases" || nodes[i].Value == "local" {
+		if nodes[i].Value == "aliases" || nodes[i].Value == "local" || nodes[i].Value == repoKey {
 			continue
 		} else {
 			mainData.Content = append(mainData.Content, nodes[i], nodes[i+1])
@@ -188,7 +188,11 @@ func ParseDefaultConfig() (Config, error) {
 		// No config found, use default writable location
 		configPath = ConfigFile()
 	}
-	return ParseConfig(configPath)
+	cfg, err := ParseConfig(configPath)
+	if fc, ok := cfg.(*fileConfig); ok {
+		fc.systemWide = configPath != ConfigFile()
+	}
+	return cfg, err
 }
 
 var ReadConfigFile = func(filename string) ([]byte, error) {
@@ -284,7 +288,7 @@ func ParseConfig(filename string) (Config, error) {
 	}
 
 	// Load aliases config file
-	if _, aliasesRoot, err := ParseConfigFile(aliasesConfigFile()); err == nil {
+	if _, aliasesRoot, err := ParseConfigFile(AliasesConfigFile()); err == nil {
 		if len(aliasesRoot.Content[0].Content) > 0 {
 			newContent := []*yaml.Node{
 				{Value: "aliases"},
@@ -297,7 +301,9 @@ func ParseConfig(filename string) (Config, error) {
 		return nil, err
 	}
 
-	return NewConfig(root), confError
+	cfg := NewConfig(root)
+	cfg.(*fileConfig).loadRepo = true
+	return cfg, confError
 }
 
 func pathError(err error) error {
@@ -5,6 +5,14 @@ import (
 )
 
 func (c *fileConfig) configForHost(hostname string) (*HostConfig, error) {
+	profile, err := c.activeProfile()
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil && profile.Host == hostname {
+		return &profile.HostConfig, nil
+	}
+
 	hosts, err := c.hostEntries()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse hosts config: %w", err)
@@ -0,0 +1,200 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileEnv is the environment variable that selects a profile. The
+// --profile flag sets it too, so commands run by glab inherit the profile.
+const ProfileEnv = "GLAB_PROFILE"
+
+// profileKey is the key of the active profile in the global and local
+// configuration files.
+const profileKey = "profile"
+
+// ProfileKeys are the settings a profile can hold, besides custom headers.
+var ProfileKeys = []string{"host", "token", "api_host", "api_protocol", "git_protocol"}
+
+// Profile is a named identity on a host: its token, and the settings to
+// connect to the host. When a profile is active, it replaces the settings
+// of its host in the hosts section, so several profiles can use the same host.
+type Profile struct {
+	HostConfig
+	Name string
+}
+
+// ProfileNotFoundError is returned when the selected profile does not exist.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e *ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("profile %q does not exist. Run 'glab profile list' to see the available profiles.", e.Name)
+}
+
+func (c *fileConfig) profiles() ([]*Profile, error) {
+	entry, err := c.FindEntry("profiles")
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if entry.ValueNode == nil || entry.ValueNode.Kind != yaml.MappingNode {
+		return nil, errors.New("profiles must be a mapping of profile names to settings")
+	}
+
+	var profiles []*Profile
+	content := entry.ValueNode.Content
+	for i := 0; i < len(content)-1; i += 2 {
+		profile := &Profile{
+			Name:       content[i].Value,
+			HostConfig: HostConfig{ConfigMap: ConfigMap{Root: content[i+1]}, profile: content[i].Value},
+		}
+		profile.Host, _ = profile.GetStringValue("host")
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+func (c *fileConfig) profile(name string) (*Profile, error) {
+	profiles, err := c.profiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range profiles {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, &ProfileNotFoundError{Name: name}
+}
+
+// activeProfile returns the active profile, or nil if no profile is active.
+func (c *fileConfig) activeProfile() (*Profile, error) {
+	name, _ := ActiveProfile(c)
+	if name == "" {
+		return nil, nil
+	}
+	return c.profile(name)
+}
+
+func asFileConfig(cfg Config) (*fileConfig, error) {
+	fc, ok := cfg.(*fileConfig)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config type: %T, expected *fileConfig", cfg)
+	}
+	return fc, nil
+}
+
+// ActiveProfile returns the name of the active profile, and where it was
+// selected: the GLAB_PROFILE environment variable, which the --profile flag
+// also sets, then the local configuration of the repository, then the global
+// configuration. The name is empty if no profile is active.
+func ActiveProfile(cfg Config) (string, string) {
+	if name := os.Getenv(ProfileEnv); name != "" {
+		return name, ProfileEnv
+	}
+	name, source, _ := cfg.GetWithSource("", profileKey, false)
+	if name == "" {
+		return "", ""
+	}
+	return name, source
+}
+
+// Profiles returns the profiles, sorted by name.
+func Profiles(cfg Config) ([]*Profile, error) {
+	fc, err := asFileConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := fc.profiles()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles, nil
+}
+
+// GetProfile returns a profile. It returns a *ProfileNotFoundError if the
+// profile does not exist.
+func GetProfile(cfg Config, name string) (*Profile, error) {
+	fc, err := asFileConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return fc.profile(name)
+}
+
+// AddProfile adds a profile with the settings in values, which are keys of
+// ProfileKeys, and custom headers. The host is required.
+func AddProfile(cfg Config, name string, values map[string]string, headers []CustomHeader) error {
+	fc, err := asFileConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if _, err := fc.profile(name); err == nil {
+		return fmt.Errorf("profile %q already exists.", name)
+	}
+	if values["host"] == "" {
+		return errors.New("a profile requires a host.")
+	}
+
+	profileRoot := &yaml.Node{Kind: yaml.MappingNode}
+	profileMap := ConfigMap{Root: profileRoot}
+	for _, key := range ProfileKeys {
+		if values[key] != "" {
+			if err := profileMap.SetStringValue(key, values[key]); err != nil {
+				return err
+			}
+		}
+	}
+	if len(headers) > 0 {
+		headersNode := &yaml.Node{}
+		if err := headersNode.Encode(headers); err != nil {
+			return err
+		}
+		profileRoot.Content = append(profileRoot.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "custom_headers"}, headersNode)
+	}
+
+	entry, err := fc.FindEntry("profiles")
+	if isNotFoundError(err) {
+		entry.KeyNode = &yaml.Node{Kind: yaml.ScalarNode, Value: "profiles"}
+		entry.ValueNode = &yaml.Node{Kind: yaml.MappingNode}
+		fc.Root().Content = append(fc.Root().Content, entry.KeyNode, entry.ValueNode)
+	} else if err != nil {
+		return err
+	}
+
+	entry.ValueNode.Content = append(entry.ValueNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, profileRoot)
+	return nil
+}
+
+// RemoveProfile removes a profile. If the profile is active in the global
+// configuration, it is deactivated.
+func RemoveProfile(cfg Config, name string) error {
+	fc, err := asFileConfig(cfg)
+	if err != nil {
+		return err
+	}
+	if _, err := fc.profile(name); err != nil {
+		return err
+	}
+
+	entry, err := fc.FindEntry("profiles")
+	if err != nil {
+		return err
+	}
+	profilesMap := ConfigMap{Root: entry.ValueNode}
+	profilesMap.RemoveEntry(name)
+
+	if active, _ := fc.GetStringValue(profileKey); active == name {
+		fc.RemoveEntry(profileKey)
+	}
+	return nil
+}
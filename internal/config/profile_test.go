@@ -0,0 +1,191 @@
+//go:build !integration
+
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const profilesConfig = `
+hosts:
+  gitlab.com:
+    token: personal-token
+    is_oauth2: "true"
+  gitlab.example.com:
+    token: work-token
+profiles:
+  bot:
+    host: gitlab.com
+    token: bot-token
+    git_protocol: https
+    custom_headers:
+      - name: X-Bot
+        value: "yes"
+  selfmanaged:
+    host: gitlab.internal
+    token: internal-token
+    api_host: api.gitlab.internal
+`
+
+func TestProfile_replacesHostSettings(t *testing.T) {
+	t.Setenv(ProfileEnv, "bot")
+	t.Setenv("GITLAB_TOKEN", "")
+	keyring.MockInit()
+
+	cfg := NewFromString(profilesConfig)
+
+	token, err := cfg.Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "bot-token", token)
+
+	// Settings of the host are not merged into the profile
+	isOAuth2, err := cfg.Get("gitlab.com", "is_oauth2")
+	require.NoError(t, err)
+	assert.Empty(t, isOAuth2)
+
+	gitProtocol, err := cfg.Get("gitlab.com", "git_protocol")
+	require.NoError(t, err)
+	assert.Equal(t, "https", gitProtocol)
+
+	headers, err := ResolveCustomHeaders(cfg, "gitlab.com")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Bot": "yes"}, headers)
+
+	// Other hosts are not affected
+	token, err = cfg.Get("gitlab.example.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "work-token", token)
+
+	host, err := cfg.Get("", "host")
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab.com", host)
+}
+
+func TestProfile_defaultHost(t *testing.T) {
+	t.Setenv(ProfileEnv, "selfmanaged")
+	t.Setenv("GITLAB_HOST", "")
+	t.Setenv("GITLAB_TOKEN", "")
+
+	cfg := NewFromString(profilesConfig)
+
+	host, err := cfg.Get("", "host")
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab.internal", host)
+
+	apiHost, err := cfg.Get("gitlab.internal", "api_host")
+	require.NoError(t, err)
+	assert.Equal(t, "api.gitlab.internal", apiHost)
+}
+
+func TestProfile_setWritesToProfile(t *testing.T) {
+	t.Setenv(ProfileEnv, "bot")
+	mainBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := NewFromString(profilesConfig)
+	require.NoError(t, cfg.Set("gitlab.com", "token", "new-bot-token"))
+	require.NoError(t, cfg.Write())
+
+	assert.Contains(t, mainBuf.String(), "token: new-bot-token")
+	assert.Contains(t, mainBuf.String(), "token: personal-token")
+}
+
+func TestProfile_notFound(t *testing.T) {
+	t.Setenv(ProfileEnv, "missing")
+
+	cfg := NewFromString(profilesConfig)
+
+	_, err := cfg.Get("gitlab.com", "token")
+	var notFound *ProfileNotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.EqualError(t, err, `profile "missing" does not exist. Run 'glab profile list' to see the available profiles.`)
+}
+
+func TestActiveProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        string
+		config     string
+		wantName   string
+		wantSource string
+	}{
+		{
+			name:   "none",
+			config: profilesConfig,
+		},
+		{
+			name:       "global",
+			config:     profilesConfig + "profile: bot\n",
+			wantName:   "bot",
+			wantSource: ConfigFile(),
+		},
+		{
+			name:       "local overrides global",
+			config:     profilesConfig + "profile: bot\nlocal:\n  profile: selfmanaged\n",
+			wantName:   "selfmanaged",
+			wantSource: LocalConfigFile(),
+		},
+		{
+			name:       "environment overrides local",
+			env:        "bot",
+			config:     profilesConfig + "local:\n  profile: selfmanaged\n",
+			wantName:   "bot",
+			wantSource: ProfileEnv,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(ProfileEnv, tc.env)
+
+			name, source := ActiveProfile(NewFromString(tc.config))
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantSource, source)
+		})
+	}
+}
+
+func TestAddAndRemoveProfile(t *testing.T) {
+	t.Setenv(ProfileEnv, "")
+	mainBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := NewFromString("hosts:\n  gitlab.com:\n    token: personal-token\n")
+
+	err := AddProfile(cfg, "bot", map[string]string{"host": "gitlab.com", "token": "bot-token"},
+		[]CustomHeader{{Name: "X-Bot", Value: "yes"}})
+	require.NoError(t, err)
+	require.NoError(t, cfg.Set("", "profile", "bot"))
+	require.NoError(t, cfg.Write())
+
+	assert.Equal(t, heredoc.Doc(`
+		hosts:
+		    gitlab.com:
+		        token: personal-token
+		profiles:
+		    bot:
+		        host: gitlab.com
+		        token: bot-token
+		        custom_headers:
+		            - name: X-Bot
+		              value: "yes"
+		profile: bot
+	`), mainBuf.String())
+
+	err = AddProfile(cfg, "bot", map[string]string{"host": "gitlab.com"}, nil)
+	assert.EqualError(t, err, `profile "bot" already exists.`)
+
+	require.NoError(t, RemoveProfile(cfg, "bot"))
+	mainBuf.Reset()
+	require.NoError(t, cfg.Write())
+	assert.Equal(t, "hosts:\n    gitlab.com:\n        token: personal-token\nprofiles: {}\n", mainBuf.String())
+
+	var notFound *ProfileNotFoundError
+	assert.ErrorAs(t, RemoveProfile(cfg, "bot"), &notFound)
+}
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RepoConfigFileName is the name of the configuration file committed at the
+// root of a repository, to share defaults with everyone working on it.
+const RepoConfigFileName = ".glab.yml"
+
+// repoKey is the key of the repository configuration in the configuration tree.
+const repoKey = "repo"
+
+// RepoConfigKeys are the settings a repository configuration can hold.
+// Anyone who can push to a repository can change its configuration file, so
+// it can't set credentials, hosts, or commands to run, like the editor.
+var RepoConfigKeys = []string{
+	"mr_target_branch",
+	"mr_reviewers",
+	"mr_labels",
+	"mr_squash",
+	"mr_template",
+	"ci_variables",
+}
+
+// RepoConfigFile returns the path of the repository configuration file, or an
+// empty string outside of a Git repository.
+var RepoConfigFile = func() string {
+	out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(strings.TrimSpace(string(out)), RepoConfigFileName)
+}
+
+// parseRepoConfig parses a repository configuration file, and keeps the
+// settings of RepoConfigKeys and the aliases that run glab commands. Lists
+// are joined with commas, the format of the flags they are defaults for, and
+// so are ci_variables, which can also be a mapping of keys to values.
+func parseRepoConfig(data []byte) (*yaml.Node, error) {
+	root, err := parseConfigData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	repoRoot := &yaml.Node{Kind: yaml.MappingNode}
+	content := root.Content[0].Content
+	for i := 0; i < len(content)-1; i += 2 {
+		key, value := content[i], content[i+1]
+		switch {
+		case key.Value == "aliases" && value.Kind == yaml.MappingNode:
+			aliases := &yaml.Node{Kind: yaml.MappingNode}
+			for j := 0; j < len(value.Content)-1; j += 2 {
+				expansion := value.Content[j+1]
+				if expansion.Kind == yaml.ScalarNode && !strings.HasPrefix(expansion.Value, "!") {
+					aliases.Content = append(aliases.Content, value.Content[j], expansion)
+				}
+			}
+			repoRoot.Content = append(repoRoot.Content, key, aliases)
+		case slices.Contains(RepoConfigKeys, key.Value):
+			scalar, ok := repoConfigValue(value)
+			if ok {
+				repoRoot.Content = append(repoRoot.Content, key, scalar)
+			}
+		}
+	}
+	return repoRoot, nil
+}
+
+func repoConfigValue(node *yaml.Node) (*yaml.Node, bool) {
+	var values []string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: node.Value}, true
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return nil, false
+			}
+			values = append(values, item.Value)
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content)-1; i += 2 {
+			if node.Content[i+1].Kind != yaml.ScalarNode {
+				return nil, false
+			}
+			values = append(values, node.Content[i].Value+":"+node.Content[i+1].Value)
+		}
+	default:
+		return nil, false
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: JoinList(values)}, true
+}
+
+// JoinList joins values with commas, and quotes the values that contain
+// commas, like the flags that accept lists.
+func JoinList(values []string) string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(values)
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// SplitList splits a list of values joined with JoinList.
+func SplitList(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	r := csv.NewReader(strings.NewReader(value))
+	r.TrimLeadingSpace = true
+	values, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values, nil
+}
+
+// SkipRepoConfig makes a configuration ignore the repository configuration
+// file of the working directory, for commands that run on another repository.
+func SkipRepoConfig(cfg Config) {
+	if fc, ok := cfg.(*fileConfig); ok {
+		fc.skipRepo = true
+	}
+}
+
+func (c *fileConfig) repo() *ConfigMap {
+	if c.skipRepo {
+		return nil
+	}
+	if c.loadRepo {
+		c.repoOnce.Do(c.loadRepoConfig)
+	}
+	entry, err := c.FindEntry(repoKey)
+	if err != nil || entry.ValueNode.Kind != yaml.MappingNode {
+		return nil
+	}
+	return &ConfigMap{Root: entry.ValueNode}
+}
+
+// loadRepoConfig adds the repository configuration file to the configuration
+// tree. Its permissions aren't checked, because it's committed to the
+// repository.
+func (c *fileConfig) loadRepoConfig() {
+	c.repoFile = RepoConfigFile()
+	if c.repoFile == "" {
+		return
+	}
+	data, err := ReadConfigFile(c.repoFile)
+	if err != nil {
+		return
+	}
+	repoRoot, err := parseRepoConfig(data)
+	if err != nil || len(repoRoot.Content) == 0 {
+		return
+	}
+	c.ConfigMap.Root.Content = append([]*yaml.Node{{Value: repoKey}, repoRoot}, c.ConfigMap.Root.Content...)
+}
+
+// repoValue returns the value of a setting of the repository configuration,
+// which can only hold RepoConfigKeys.
+func (c *fileConfig) repoValue(key string) string {
+	if !slices.Contains(RepoConfigKeys, key) {
+		return ""
+	}
+	repo := c.repo()
+	if repo == nil {
+		return ""
+	}
+	value, _ := repo.GetStringValue(key)
+	return value
+}
+
+func (c *fileConfig) repoConfigFile() string {
+	if c.repoFile == "" {
+		return RepoConfigFileName
+	}
+	return c.repoFile
+}
+
+// RepoAlias returns the expansion of an alias of the repository
+// configuration. Aliases of the user take precedence over them.
+func RepoAlias(cfg Config, name string) (string, bool) {
+	fc, ok := cfg.(*fileConfig)
+	if !ok {
+		return "", false
+	}
+	repo := fc.repo()
+	if repo == nil {
+		return "", false
+	}
+	entry, err := repo.FindEntry("aliases")
+	if err != nil || entry.ValueNode.Kind != yaml.MappingNode {
+		return "", false
+	}
+	aliases := ConfigMap{Root: entry.ValueNode}
+	value, _ := aliases.GetStringValue(name)
+	return value, value != ""
+}
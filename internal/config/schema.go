@@ -0,0 +1,344 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scope is where a setting can be configured. Scopes are combined with |.
+type Scope int
+
+const (
+	// ScopeGlobal is the top level of the global configuration file.
+	ScopeGlobal Scope = 1 << iota
+	// ScopeHost is the configuration of a host, in the hosts section.
+	ScopeHost
+	// ScopeProfile is the configuration of a profile, in the profiles section.
+	ScopeProfile
+	// ScopeLocal is the local configuration of a repository.
+	ScopeLocal
+	// ScopeRepo is the configuration committed to a repository.
+	ScopeRepo
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopeGlobal:
+		return "the global configuration"
+	case ScopeHost:
+		return "the configuration of a host"
+	case ScopeProfile:
+		return "a profile"
+	case ScopeLocal:
+		return "the local configuration"
+	case ScopeRepo:
+		return "the repository configuration"
+	default:
+		return "this configuration"
+	}
+}
+
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	boolValue
+	enumValue
+	// listValue is a comma-separated list, which the repository
+	// configuration can also write as a YAML list, or a mapping for
+	// ci_variables.
+	listValue
+	headersValue
+)
+
+type keySchema struct {
+	scopes Scope
+	kind   valueKind
+	values []string
+}
+
+const (
+	scopeTopLevel = ScopeGlobal | ScopeLocal
+	scopeAnyHost  = ScopeGlobal | ScopeHost | ScopeLocal
+	scopeDefaults = ScopeGlobal | ScopeLocal | ScopeRepo
+)
+
+// configSchema describes the settings glab reads, and where it reads them
+// from. The settings of a host can also be set at the top level, for all hosts.
+var configSchema = map[string]keySchema{
+	"browser":                     {scopes: scopeTopLevel},
+	"branch_prefix":               {scopes: scopeTopLevel},
+	"check_update":                {scopes: scopeTopLevel, kind: boolValue},
+	"debug":                       {scopes: scopeTopLevel, kind: boolValue},
+	"display_hyperlinks":          {scopes: scopeTopLevel, kind: boolValue},
+	"editor":                      {scopes: scopeTopLevel},
+	"glab_pager":                  {scopes: scopeTopLevel},
+	"glamour_style":               {scopes: scopeTopLevel},
+	"host":                        {scopes: scopeTopLevel | ScopeProfile},
+	"last_update_check_timestamp": {scopes: ScopeGlobal},
+	"no_prompt":                   {scopes: scopeTopLevel, kind: boolValue},
+	"profile":                     {scopes: scopeTopLevel},
+	"remote_alias":                {scopes: scopeTopLevel},
+	"telemetry":                   {scopes: scopeTopLevel, kind: boolValue},
+
+	"api_host":                   {scopes: scopeAnyHost | ScopeProfile},
+	"api_protocol":               {scopes: scopeAnyHost | ScopeProfile, kind: enumValue, values: []string{"https", "http"}},
+	"ca_cert":                    {scopes: scopeAnyHost},
+	"client_cert":                {scopes: scopeAnyHost},
+	"client_id":                  {scopes: scopeAnyHost},
+	"client_key":                 {scopes: scopeAnyHost},
+	"container_registry_domains": {scopes: scopeAnyHost},
+	"custom_headers":             {scopes: ScopeHost | ScopeProfile, kind: headersValue},
+	"git_protocol":               {scopes: scopeAnyHost | ScopeProfile, kind: enumValue, values: []string{"ssh", "https", "http"}},
+	"is_oauth2":                  {scopes: ScopeHost, kind: boolValue},
+	"job_token":                  {scopes: scopeAnyHost},
+	"oauth2_expiry_date":         {scopes: ScopeHost},
+	"oauth2_refresh_token":       {scopes: ScopeHost},
+	"skip_tls_verify":            {scopes: scopeAnyHost, kind: boolValue},
+	"token":                      {scopes: scopeAnyHost | ScopeProfile},
+	"user":                       {scopes: ScopeHost},
+
+	"mr_target_branch": {scopes: scopeDefaults},
+	"mr_reviewers":     {scopes: scopeDefaults, kind: listValue},
+	"mr_labels":        {scopes: scopeDefaults, kind: listValue},
+	"mr_squash":        {scopes: scopeDefaults, kind: boolValue},
+	"mr_template":      {scopes: scopeDefaults},
+	"ci_variables":     {scopes: scopeDefaults, kind: listValue},
+}
+
+// Problem is a setting of a configuration file that glab ignores, or can't use.
+type Problem struct {
+	File string
+	Line int
+	// Key is the path of the setting, like hosts.gitlab.com.api_protocol.
+	Key     string
+	Message string
+	// Fix suggests how to fix the problem.
+	Fix string
+}
+
+func (p Problem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+	}
+	return fmt.Sprintf("%s: %s", p.File, p.Message)
+}
+
+type validator struct {
+	file     string
+	problems []Problem
+}
+
+func (v *validator) report(node *yaml.Node, key, message, fix string) {
+	v.problems = append(v.problems, Problem{File: v.file, Line: node.Line, Key: key, Message: message, Fix: fix})
+}
+
+// ValidateConfig validates the global configuration file, with its hosts,
+// profiles, and aliases.
+func ValidateConfig(file string, data []byte) ([]Problem, error) {
+	return validateFile(file, data, ScopeGlobal)
+}
+
+// ValidateLocalConfig validates the local configuration file of a repository.
+func ValidateLocalConfig(file string, data []byte) ([]Problem, error) {
+	return validateFile(file, data, ScopeLocal)
+}
+
+// ValidateRepoConfig validates the configuration file committed to a repository.
+func ValidateRepoConfig(file string, data []byte) ([]Problem, error) {
+	return validateFile(file, data, ScopeRepo)
+}
+
+// ValidateAliasesConfig validates the aliases file.
+func ValidateAliasesConfig(file string, data []byte) ([]Problem, error) {
+	root, err := parseConfigData(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid YAML: %w", file, err)
+	}
+	v := &validator{file: file}
+	v.validateAliases("", root.Content[0], ScopeGlobal)
+	return v.problems, nil
+}
+
+func validateFile(file string, data []byte, scope Scope) ([]Problem, error) {
+	root, err := parseConfigData(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid YAML: %w", file, err)
+	}
+
+	v := &validator{file: file}
+	content := root.Content[0].Content
+	for i := 0; i < len(content)-1; i += 2 {
+		key, value := content[i], content[i+1]
+		switch {
+		case key.Value == "aliases" && scope&(ScopeGlobal|ScopeRepo) != 0:
+			v.validateAliases("aliases.", value, scope)
+		case key.Value == "hosts" && scope == ScopeGlobal:
+			v.validateSections(key.Value, value, ScopeHost)
+		case key.Value == "profiles" && scope == ScopeGlobal:
+			v.validateSections(key.Value, value, ScopeProfile)
+		case key.Value == "aliases" || key.Value == "hosts" || key.Value == "profiles":
+			v.report(key, key.Value, fmt.Sprintf("%q can't be set in %s.", key.Value, scope), "Move it to the global configuration, with 'glab config edit'.")
+		default:
+			v.validateKey("", key, value, scope)
+		}
+	}
+	return v.problems, nil
+}
+
+// validateSections validates the hosts or profiles section: a mapping of
+// names to settings.
+func (v *validator) validateSections(name string, node *yaml.Node, scope Scope) {
+	if node.Kind != yaml.MappingNode {
+		if node.Kind == yaml.ScalarNode && node.Value == "" {
+			return
+		}
+		v.report(node, name, fmt.Sprintf("%s must be a mapping of names to settings.", name), fmt.Sprintf("Run 'glab config edit' to fix the %s section.", name))
+		return
+	}
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		sectionName, section := node.Content[i].Value, node.Content[i+1]
+		prefix := name + "." + sectionName + "."
+		if section.Kind != yaml.MappingNode {
+			v.report(section, strings.TrimSuffix(prefix, "."), fmt.Sprintf("the settings of %s must be a mapping.", sectionName), fmt.Sprintf("Run 'glab config edit' to fix %s.", sectionName))
+			continue
+		}
+		for j := 0; j < len(section.Content)-1; j += 2 {
+			v.validateKey(prefix, section.Content[j], section.Content[j+1], scope)
+		}
+	}
+}
+
+func (v *validator) validateAliases(prefix string, node *yaml.Node, scope Scope) {
+	if node.Kind != yaml.MappingNode {
+		if node.Kind == yaml.ScalarNode && node.Value == "" {
+			return
+		}
+		v.report(node, "aliases", "aliases must be a mapping of names to expansions.", "Run 'glab alias list' to see your aliases, and 'glab alias set' to define them.")
+		return
+	}
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		name, expansion := node.Content[i], node.Content[i+1]
+		if expansion.Kind != yaml.ScalarNode {
+			v.report(expansion, prefix+name.Value, fmt.Sprintf("the expansion of the alias %q must be a string.", name.Value), fmt.Sprintf("Run 'glab alias set %s <expansion>' to fix it.", name.Value))
+		} else if scope == ScopeRepo && strings.HasPrefix(expansion.Value, "!") {
+			v.report(expansion, prefix+name.Value, fmt.Sprintf("the shell alias %q is ignored, because repository configurations can't run commands.", name.Value), fmt.Sprintf("Run 'glab alias set --shell %s <expansion>' to define it for yourself.", name.Value))
+		}
+	}
+}
+
+func (v *validator) validateKey(prefix string, keyNode, value *yaml.Node, scope Scope) {
+	key := keyNode.Value
+	path := prefix + key
+
+	schema, known := configSchema[key]
+	if !known {
+		if equivalent := ConfigKeyEquivalence(key); equivalent != key {
+			v.report(keyNode, path, fmt.Sprintf("glab does not read %q from configuration files.", key), fmt.Sprintf("Rename it to %q.", equivalent))
+			return
+		}
+		fix := "Remove it."
+		if suggestion := suggestKey(key, scope); suggestion != "" {
+			fix = fmt.Sprintf("Did you mean %q?", suggestion)
+		}
+		v.report(keyNode, path, fmt.Sprintf("unknown setting %q.", key), fix)
+		return
+	}
+
+	if schema.scopes&scope == 0 {
+		v.report(keyNode, path, fmt.Sprintf("%q can't be set in %s.", key, scope), scopeFix(key, schema.scopes))
+		return
+	}
+
+	switch schema.kind {
+	case headersValue:
+		hostCfg := HostConfig{ConfigMap: ConfigMap{Root: &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{keyNode, value}}}}
+		if _, err := hostCfg.GetCustomHeaders(); err != nil {
+			v.report(value, path, err.Error()+".", "Each header needs a 'name', and a 'value' or a 'valueFromEnv'.")
+		}
+		return
+	case listValue:
+		if scope == ScopeRepo && value.Kind != yaml.ScalarNode {
+			if _, ok := repoConfigValue(value); !ok {
+				v.report(value, path, fmt.Sprintf("%s must be a value, a list of values, or a mapping of values.", key), "Remove the nested lists and mappings.")
+			}
+			return
+		}
+	}
+
+	if value.Kind != yaml.ScalarNode {
+		v.report(value, path, fmt.Sprintf("%s must be a value, not a list or a mapping.", key), fmt.Sprintf("Run 'glab config set %s <value>' to fix it.", key))
+		return
+	}
+	if value.Value == "" {
+		return
+	}
+
+	switch schema.kind {
+	case boolValue:
+		if _, err := strconv.ParseBool(value.Value); err != nil {
+			v.report(value, path, fmt.Sprintf("invalid value %q for %s.", value.Value, key), "Use true or false.")
+		}
+	case enumValue:
+		if !slices.Contains(schema.values, value.Value) {
+			v.report(value, path, fmt.Sprintf("invalid value %q for %s.", value.Value, key), fmt.Sprintf("Use one of: %s.", strings.Join(schema.values, ", ")))
+		}
+	}
+}
+
+func scopeFix(key string, scopes Scope) string {
+	switch {
+	case scopes&ScopeGlobal != 0:
+		return fmt.Sprintf("Move it to the top level of the global configuration, with 'glab config set --global %s <value>'.", key)
+	case scopes&ScopeHost != 0:
+		return fmt.Sprintf("Move it to the configuration of a host, with 'glab config set --host <host> %s <value>'.", key)
+	default:
+		return "Remove it."
+	}
+}
+
+// suggestKey returns the known setting of a scope that is closest to an
+// unknown one, if it's close enough to be a typo.
+func suggestKey(key string, scope Scope) string {
+	var names []string
+	for name, schema := range configSchema {
+		if schema.scopes&scope != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	best, bestDistance := "", len(key)/3+1
+	for _, name := range names {
+		if d := editDistance(key, name); d < bestDistance {
+			best, bestDistance = name, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
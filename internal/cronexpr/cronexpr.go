@@ -0,0 +1,370 @@
+// Package cronexpr parses the cron syntax of GitLab pipeline schedules, and
+// computes when a schedule runs next.
+//
+// It accepts five fields (minute, hour, day of month, month, day of week) with
+// lists, ranges, and steps, month and weekday names, L for the last day of the
+// month, nth weekdays like mon#2 or fri#L, and the @yearly, @annually, @monthly,
+// @weekly, @daily, @midnight, and @hourly macros. Like in GitLab, when both the
+// day of month and the day of week are restricted, a day matches either of them.
+package cronexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// searchLimit bounds the search for the next run, so that patterns that never
+// match, like 0 0 30 2 *, don't loop forever.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// Expression is a parsed cron expression.
+type Expression struct {
+	minute, hour, dom, month, dow uint64
+
+	// lastDom matches the last day of the month.
+	lastDom bool
+	// nthDow matches the nth weekday of the month, keyed by weekday.
+	// The value -1 means the last such weekday of the month.
+	nthDow map[int][]int
+
+	domRestricted, dowRestricted bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: monthNames}
+	// Both 0 and 7 are Sunday.
+	dowField = field{name: "day of week", min: 0, max: 7, names: weekdayNames}
+)
+
+// Parse parses a cron expression.
+func Parse(expr string) (*Expression, error) {
+	expr = strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(expr)]; ok {
+		expr = m
+	} else if strings.HasPrefix(expr, "@") {
+		return nil, fmt.Errorf("invalid cron expression %q: unknown macro %s.", expr, expr)
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields (minute, hour, day of month, month, day of week), got %d.", expr, len(fields))
+	}
+
+	e := &Expression{nthDow: map[int][]int{}}
+	var err error
+	if e.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, wrap(expr, err)
+	}
+	if e.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, wrap(expr, err)
+	}
+	if e.dom, err = e.parseDom(fields[2]); err != nil {
+		return nil, wrap(expr, err)
+	}
+	if e.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, wrap(expr, err)
+	}
+	if e.dow, err = e.parseDow(fields[4]); err != nil {
+		return nil, wrap(expr, err)
+	}
+
+	e.domRestricted = fields[2] != "*" && fields[2] != "?"
+	e.dowRestricted = fields[4] != "*" && fields[4] != "?"
+
+	return e, nil
+}
+
+func wrap(expr string, err error) error {
+	return fmt.Errorf("invalid cron expression %q: %w", expr, err)
+}
+
+func (e *Expression) parseDom(s string) (uint64, error) {
+	var rest []string
+	for _, part := range strings.Split(s, ",") {
+		if strings.EqualFold(part, "L") {
+			e.lastDom = true
+			continue
+		}
+		rest = append(rest, part)
+	}
+	if len(rest) == 0 {
+		return 0, nil
+	}
+	return parseField(strings.Join(rest, ","), domField)
+}
+
+func (e *Expression) parseDow(s string) (uint64, error) {
+	var rest []string
+	for _, part := range strings.Split(s, ",") {
+		day, nth, ok := strings.Cut(part, "#")
+		if !ok {
+			rest = append(rest, part)
+			continue
+		}
+
+		weekday, err := parseValue(day, dowField)
+		if err != nil {
+			return 0, err
+		}
+		weekday %= 7
+
+		n := -1
+		if !strings.EqualFold(nth, "L") && nth != "-1" {
+			n, err = strconv.Atoi(nth)
+			if err != nil || n < 1 || n > 5 {
+				return 0, fmt.Errorf("invalid day of week %q: the number after # must be between 1 and 5, or L.", part)
+			}
+		}
+		e.nthDow[weekday] = append(e.nthDow[weekday], n)
+	}
+	if len(rest) == 0 {
+		return 0, nil
+	}
+
+	set, err := parseField(strings.Join(rest, ","), dowField)
+	if err != nil {
+		return 0, err
+	}
+	// Fold 7 into 0, both are Sunday.
+	if set&(1<<7) != 0 {
+		set = set&^(1<<7) | 1
+	}
+	return set, nil
+}
+
+// parseField parses a comma-separated list of values, ranges, and steps into a bit set.
+func parseField(s string, f field) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(s, ",") {
+		if part == "" {
+			return 0, fmt.Errorf("invalid %s %q: empty list item.", f.name, s)
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid %s %q: the step must be a positive number.", f.name, part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			lo, hi = f.min, f.max
+			if f.name == dowField.name {
+				hi = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(from, f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(to, f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid %s %q: the start of the range is after its end.", f.name, part)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rangePart, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			// A single value with a step, like 5/15, runs from the value to the end.
+			if hasStep {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q.", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid %s %d: must be between %d and %d.", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<v) != 0
+}
+
+func daysIn(year int, month time.Month, loc *time.Location) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+}
+
+func (e *Expression) matchesDay(t time.Time) bool {
+	day, weekday := t.Day(), int(t.Weekday())
+	last := daysIn(t.Year(), t.Month(), t.Location())
+
+	domMatch := has(e.dom, day) || (e.lastDom && day == last)
+
+	dowMatch := has(e.dow, weekday)
+	for _, n := range e.nthDow[weekday] {
+		if (n == -1 && day+7 > last) || (n > 0 && (day-1)/7+1 == n) {
+			dowMatch = true
+		}
+	}
+
+	switch {
+	case e.domRestricted && e.dowRestricted:
+		return domMatch || dowMatch
+	case e.domRestricted:
+		return domMatch
+	case e.dowRestricted:
+		return dowMatch
+	default:
+		return true
+	}
+}
+
+// Next returns the first time after t, in the location of t, at which the
+// expression matches. It returns the zero time if the expression doesn't
+// match within the next five years.
+func (e *Expression) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(searchLimit)
+
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(limit) {
+		if !has(e.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !has(e.hour, t.Hour()) {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			// When the clocks go back, the same wall clock hour happens twice.
+			if !next.After(t) {
+				next = t.Add(time.Hour).Truncate(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if !has(e.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// NextN returns the next n times after t at which the expression matches.
+func (e *Expression) NextN(t time.Time, n int) []time.Time {
+	var times []time.Time
+	for range n {
+		t = e.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// MinInterval returns the shortest time between two runs, or zero if the
+// expression runs less than twice. It checks the runs in the day after t, which
+// is enough to find patterns that run too often.
+func (e *Expression) MinInterval(t time.Time) time.Duration {
+	// A schedule that runs at most once per hour has at most 25 runs per day,
+	// counting the extra hour when the clocks go back.
+	runs := e.NextN(t, 25)
+	if len(runs) < 2 {
+		return 0
+	}
+
+	min := runs[1].Sub(runs[0])
+	for i := 2; i < len(runs); i++ {
+		if d := runs[i].Sub(runs[i-1]); d < min {
+			min = d
+		}
+	}
+	return min
+}
+
+// Warning returns a warning if the expression runs more often than once per hour.
+// That is usually a typo, like * 3 * * * instead of 0 3 * * *, which runs a pipeline
+// every minute from 3:00 to 3:59.
+func (e *Expression) Warning(t time.Time) string {
+	interval := e.MinInterval(t)
+	if interval == 0 || interval >= time.Hour {
+		return ""
+	}
+
+	every := "every minute"
+	if m := int(interval.Minutes()); m > 1 {
+		every = fmt.Sprintf("every %d minutes", m)
+	}
+	return fmt.Sprintf("The schedule runs %s. Check that this is intended, each run uses CI/CD minutes.", every)
+}
+
+// TimeLayout is the layout used to print run times.
+const TimeLayout = "2006-01-02 15:04 MST"
+
+// LoadLocation loads a cron timezone: an IANA name like Europe/Berlin, or a
+// Rails name like "Pacific Time (US & Canada)", which GitLab accepts too.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	iana := name
+	if mapped, ok := railsTimezones[name]; ok {
+		iana = mapped
+	}
+	loc, err := time.LoadLocation(iana)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q. Use an IANA timezone name, like UTC or Europe/Berlin.", name)
+	}
+	return loc, nil
+}
@@ -0,0 +1,156 @@
+//go:build !integration
+
+package cronexpr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{"*0 * * * *", `invalid cron expression "*0 * * * *": invalid minute "*0".`},
+		{"0 * * *", `invalid cron expression "0 * * *": expected 5 fields (minute, hour, day of month, month, day of week), got 4.`},
+		{"60 * * * *", `invalid cron expression "60 * * * *": invalid minute 60: must be between 0 and 59.`},
+		{"0 24 * * *", `invalid cron expression "0 24 * * *": invalid hour 24: must be between 0 and 23.`},
+		{"0 0 0 * *", `invalid cron expression "0 0 0 * *": invalid day of month 0: must be between 1 and 31.`},
+		{"0 0 * 13 *", `invalid cron expression "0 0 * 13 *": invalid month 13: must be between 1 and 12.`},
+		{"0 0 * * 8", `invalid cron expression "0 0 * * 8": invalid day of week 8: must be between 0 and 7.`},
+		{"0 0 * * mon#6", `invalid cron expression "0 0 * * mon#6": invalid day of week "mon#6": the number after # must be between 1 and 5, or L.`},
+		{"*/0 * * * *", `invalid cron expression "*/0 * * * *": invalid minute "*/0": the step must be a positive number.`},
+		{"30-10 * * * *", `invalid cron expression "30-10 * * * *": invalid minute "30-10": the start of the range is after its end.`},
+		{"1,,2 * * * *", `invalid cron expression "1,,2 * * * *": invalid minute "1,,2": empty list item.`},
+		{"@often", `invalid cron expression "@often": unknown macro @often.`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			_, err := Parse(tc.expr)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr, err.Error())
+		})
+	}
+}
+
+func TestNextN(t *testing.T) {
+	// A Wednesday.
+	start := time.Date(2025, time.January, 1, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"0 * * * *", []string{"2025-01-01 11:00", "2025-01-01 12:00", "2025-01-01 13:00"}},
+		{"*/20 * * * *", []string{"2025-01-01 10:40", "2025-01-01 11:00", "2025-01-01 11:20"}},
+		{"5/30 9-11 * * *", []string{"2025-01-01 10:35", "2025-01-01 11:05", "2025-01-01 11:35"}},
+		{"@daily", []string{"2025-01-02 00:00", "2025-01-03 00:00", "2025-01-04 00:00"}},
+		{"0 9 * * mon-fri", []string{"2025-01-02 09:00", "2025-01-03 09:00", "2025-01-06 09:00"}},
+		{"0 0 * * 7", []string{"2025-01-05 00:00", "2025-01-12 00:00", "2025-01-19 00:00"}},
+		{"0 0 L * *", []string{"2025-01-31 00:00", "2025-02-28 00:00", "2025-03-31 00:00"}},
+		{"0 0 * * fri#L", []string{"2025-01-31 00:00", "2025-02-28 00:00", "2025-03-28 00:00"}},
+		{"0 0 * * mon#2", []string{"2025-01-13 00:00", "2025-02-10 00:00", "2025-03-10 00:00"}},
+		{"0 0 29 feb *", []string{"2028-02-29 00:00", "2032-02-29 00:00", "2036-02-29 00:00"}},
+		// When both the day of month and the day of week are set, either matches.
+		{"0 0 1 * sun", []string{"2025-01-05 00:00", "2025-01-12 00:00", "2025-01-19 00:00", "2025-01-26 00:00", "2025-02-01 00:00"}},
+		{"0 0 30 2 *", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := Parse(tc.expr)
+			require.NoError(t, err)
+
+			var got []string
+			for _, run := range e.NextN(start, max(len(tc.want), 3)) {
+				got = append(got, run.Format("2006-01-02 15:04"))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc, err := LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	e, err := Parse("30 2 * * *")
+	require.NoError(t, err)
+
+	// 2:30 doesn't exist on March 30, when the clocks go forward, so that day is skipped.
+	start := time.Date(2025, time.March, 29, 12, 0, 0, 0, loc)
+	runs := e.NextN(start, 2)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "2025-03-29 02:30 CET", e.Next(start.Add(-12*time.Hour)).Format(TimeLayout))
+	assert.Equal(t, "2025-03-31 02:30 CEST", runs[0].Format(TimeLayout))
+	assert.Equal(t, "2025-04-01 02:30 CEST", runs[1].Format(TimeLayout))
+}
+
+func TestMinInterval(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Duration
+	}{
+		{"* * * * *", time.Minute},
+		{"0,5 * * * *", 5 * time.Minute},
+		{"0 * * * *", time.Hour},
+		{"0 3 * * *", 24 * time.Hour},
+		{"0 0 30 2 *", 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, e.MinInterval(start))
+		})
+	}
+}
+
+func TestWarning(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"* 3 * * *", "The schedule runs every minute. Check that this is intended, each run uses CI/CD minutes."},
+		{"*/15 * * * *", "The schedule runs every 15 minutes. Check that this is intended, each run uses CI/CD minutes."},
+		{"0 * * * *", ""},
+		{"0 3 * * *", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			e, err := Parse(tc.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, e.Warning(start))
+		})
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	loc, err := LoadLocation("")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	loc, err = LoadLocation("Pacific Time (US & Canada)")
+	require.NoError(t, err)
+	assert.Equal(t, "America/Los_Angeles", loc.String())
+
+	for rails, iana := range railsTimezones {
+		_, err := LoadLocation(rails)
+		assert.NoError(t, err, "%s (%s)", rails, iana)
+	}
+
+	_, err = LoadLocation("Mars/Olympus")
+	require.Error(t, err)
+	assert.Equal(t, `unknown timezone "Mars/Olympus". Use an IANA timezone name, like UTC or Europe/Berlin.`, err.Error())
+}
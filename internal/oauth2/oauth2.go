@@ -2,6 +2,7 @@ package oauth2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -45,3 +46,55 @@ func StartFlow(ctx context.Context, cfg config.Config, out io.Writer, httpClient
 
 	return token.AccessToken, nil
 }
+
+// StartDeviceFlow signs in with the OAuth 2.0 device authorization grant, for
+// environments where a browser can't reach the callback server of StartFlow,
+// like SSH sessions and containers. It prints a code for the user to enter at
+// a verification URL on any device, and polls for the token until the user
+// authorizes glab, denies access, or the code expires.
+func StartDeviceFlow(ctx context.Context, cfg config.Config, out io.Writer, httpClient *http.Client, hostname string) (string, error) {
+	clientID, err := oauthClientID(cfg, hostname)
+	if err != nil {
+		return "", err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+	baseURL := fmt.Sprintf("%s://%s", glinstance.DefaultProtocol, hostname)
+	oauth2Config := gitlaboauth2.NewOAuth2Config(baseURL, clientID, "", scopes)
+
+	deviceAuth, err := oauth2Config.DeviceAuth(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to start the device authorization: %w", err)
+	}
+
+	fmt.Fprintf(out, "First, copy your one-time code: %s\n", deviceAuth.UserCode)
+	fmt.Fprintf(out, "Then open %s in a browser on any device, and enter the code.\n", deviceAuth.VerificationURI)
+	if deviceAuth.VerificationURIComplete != "" {
+		fmt.Fprintf(out, "Or open %s to skip entering the code.\n", deviceAuth.VerificationURIComplete)
+	}
+	fmt.Fprint(out, "Waiting for authorization...\n")
+
+	token, err := oauth2Config.DeviceAccessToken(ctx, deviceAuth)
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) {
+		switch retrieveErr.ErrorCode {
+		case "access_denied":
+			return "", errors.New("the authorization was denied. Sign in again to get a new code.")
+		case "expired_token":
+			err = context.DeadlineExceeded
+		}
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "", errors.New("the code expired before it was entered. Sign in again to get a new code.")
+	}
+	if err != nil {
+		return "", fmt.Errorf("device authorization failed: %w", err)
+	}
+
+	err = marshal(hostname, cfg, token)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
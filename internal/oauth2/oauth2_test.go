@@ -3,9 +3,17 @@
 package oauth2
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gitlab.com/gitlab-org/cli/internal/glinstance"
 )
@@ -57,3 +65,79 @@ func TestClientID(t *testing.T) {
 		assert.Empty(t, clientID)
 	})
 }
+
+func TestStartDeviceFlow(t *testing.T) {
+	var polls int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "321", r.Form.Get("client_id"))
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/oauth/authorize_device":
+			assert.Equal(t, strings.Join(scopes, " "), r.Form.Get("scope"))
+			fmt.Fprint(w, `{
+				"device_code": "device-code",
+				"user_code": "ABCD-1234",
+				"verification_uri": "https://gitlab.example.com/oauth/device",
+				"verification_uri_complete": "https://gitlab.example.com/oauth/device?user_code=ABCD-1234",
+				"expires_in": 300,
+				"interval": 1
+			}`)
+		case "/oauth/token":
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.Form.Get("grant_type"))
+			assert.Equal(t, "device-code", r.Form.Get("device_code"))
+			polls++
+			if polls == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "authorization_pending"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token": "access-token", "refresh_token": "refresh-token", "token_type": "Bearer", "expires_in": 7200}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	hostname := strings.TrimPrefix(server.URL, "https://")
+	cfg := stubConfig{
+		hosts: map[string]map[string]string{
+			hostname: {"client_id": "321"},
+		},
+	}
+	out := &bytes.Buffer{}
+
+	token, err := StartDeviceFlow(context.Background(), cfg, out, server.Client(), hostname)
+	require.NoError(t, err)
+
+	assert.Equal(t, "access-token", token)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, heredoc.Doc(`
+		First, copy your one-time code: ABCD-1234
+		Then open https://gitlab.example.com/oauth/device in a browser on any device, and enter the code.
+		Or open https://gitlab.example.com/oauth/device?user_code=ABCD-1234 to skip entering the code.
+		Waiting for authorization...
+	`), out.String())
+
+	assert.Equal(t, "true", cfg.hosts[hostname]["is_oauth2"])
+	assert.Equal(t, "access-token", cfg.hosts[hostname]["token"])
+	assert.Equal(t, "refresh-token", cfg.hosts[hostname]["oauth2_refresh_token"])
+	assert.NotEmpty(t, cfg.hosts[hostname]["oauth2_expiry_date"])
+}
+
+func TestStartDeviceFlow_unsupported(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	hostname := strings.TrimPrefix(server.URL, "https://")
+	cfg := stubConfig{
+		hosts: map[string]map[string]string{
+			hostname: {"client_id": "321"},
+		},
+	}
+
+	_, err := StartDeviceFlow(context.Background(), cfg, &bytes.Buffer{}, server.Client(), hostname)
+	assert.ErrorContains(t, err, "failed to start the device authorization")
+	assert.Empty(t, cfg.hosts[hostname]["token"])
+}
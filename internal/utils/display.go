@@ -81,3 +81,27 @@ func (opts *ListTitleOptions) Describe() string {
 func pluralizeName(num int, thing string) string {
 	return strings.TrimPrefix(Pluralize(num, thing), fmt.Sprintf("%d ", num))
 }
+
+// TreeNode is a node of a tree rendered by RenderTree.
+type TreeNode struct {
+	Label    string
+	Children []*TreeNode
+}
+
+// RenderTree renders the children of a node as a tree, like the tree command.
+func RenderTree(node *TreeNode) string {
+	var sb strings.Builder
+	renderTreeChildren(&sb, node, "")
+	return sb.String()
+}
+
+func renderTreeChildren(sb *strings.Builder, node *TreeNode, prefix string) {
+	for i, child := range node.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(node.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		sb.WriteString(prefix + branch + child.Label + "\n")
+		renderTreeChildren(sb, child, prefix+indent)
+	}
+}
@@ -5,6 +5,7 @@ package utils
 import (
 	"testing"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -219,3 +220,23 @@ func Test_Describe(t *testing.T) {
 		assert.Equal(t, "No tests match your search in glab.\n", got)
 	})
 }
+
+func Test_RenderTree(t *testing.T) {
+	tree := &TreeNode{
+		Label: "root",
+		Children: []*TreeNode{
+			{Label: "a", Children: []*TreeNode{{Label: "a1"}, {Label: "a2", Children: []*TreeNode{{Label: "a2x"}}}}},
+			{Label: "b", Children: []*TreeNode{{Label: "b1"}}},
+		},
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+		├── a
+		│   ├── a1
+		│   └── a2
+		│       └── a2x
+		└── b
+		    └── b1
+	`), RenderTree(tree))
+	assert.Equal(t, "", RenderTree(&TreeNode{Label: "empty"}))
+}
@@ -134,6 +134,36 @@ func FmtDuration(d time.Duration) string {
 	return fmt.Sprintf("%02dm %02ds", m, s)
 }
 
+// FormatTimeTracking formats seconds of time tracking the way GitLab does,
+// like 1w 2d 3h 30m, with 8 hours in a day and 5 days in a week.
+func FormatTimeTracking(seconds int64) string {
+	if seconds <= 0 {
+		return "0h"
+	}
+
+	units := []struct {
+		suffix  string
+		seconds int64
+	}{
+		{"w", 5 * 8 * 3600},
+		{"d", 8 * 3600},
+		{"h", 3600},
+		{"m", 60},
+	}
+
+	var parts []string
+	for _, u := range units {
+		if n := seconds / u.seconds; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.suffix))
+			seconds -= n * u.seconds
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return strings.Join(parts, " ")
+}
+
 func Humanize(s string) string {
 	// Replaces - and _ with spaces.
 	replace := "_-"
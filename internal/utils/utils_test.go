@@ -239,3 +239,16 @@ func TestIsValidURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_FormatTimeTracking(t *testing.T) {
+	tests := map[int64]string{
+		0:                      "0h",
+		30:                     "0m",
+		90 * 60:                "1h 30m",
+		8 * 3600:               "1d",
+		(5*8+8+3)*3600 + 30*60: "1w 1d 3h 30m",
+	}
+	for seconds, want := range tests {
+		require.Equal(t, want, FormatTimeTracking(seconds))
+	}
+}